@@ -0,0 +1,144 @@
+package main
+
+import (
+	"crypto/rand"
+	"flag"
+	"fmt"
+	"io"
+	mathrand "math/rand"
+	"os"
+
+	"github.com/glvnst/snakeeyes/internal/stats"
+	"github.com/glvnst/snakeeyes/passphrase"
+)
+
+const auditHelpText = `usage: %s audit [-draws n] [-bytes n] [-list name] [-list-file path] [-seed n]
+
+Checks this host's passphrase generation for bias and its random number
+generator for failure, so that the checks can be repeated on every machine
+snakeeyes is deployed to.
+
+For each word list, the audit draws words through the same code as
+generating passphrases and runs a chi-squared test of whether every word was
+equally likely, reporting the p-value and the fewest and most times any word
+was drawn. A list fails if its p-value is below 0.001 divided by the number of
+lists audited. With -seed, words are drawn from a seeded pseudorandom
+generator instead of crypto/rand, so the results can be reproduced exactly on
+any machine.
+
+The audit then reads raw bytes from crypto/rand and runs the repetition count
+and adaptive proportion health tests of NIST SP 800-90B on them, assuming
+8 bits of min-entropy per byte and allowing a false positive probability of
+2^-40 per byte. These catch a generator which has failed outright.
+
+The exit status is 1 if any check fails.
+
+Command line options:
+
+`
+
+// auditAlpha is the significance level below which the words drawn from the audited lists fail the chi-squared test
+const auditAlpha = 0.001
+
+// auditHealthAlpha is the false positive probability per byte, as a power of two, of the health tests
+const auditHealthAlpha = 40
+
+// auditMain implements the audit command, which checks the choice of words for bias and crypto/rand for failure
+func auditMain(args []string) {
+	flags := flag.NewFlagSet("audit", flag.ExitOnError)
+	draws := flags.Int("draws", 1000000, "the number of words to draw from each list")
+	nBytes := flags.Int("bytes", 1<<20, "the number of bytes of crypto/rand to health test")
+	seed := flags.Int64("seed", 0, "draw words from a pseudorandom generator with this seed, for reproducible results")
+	options := addListFlags(flags, "", "audit only the named word list")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), auditHelpText, os.Args[0])
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	checkNoArgs(flags)
+
+	if *draws < 1 || *nBytes < 1 {
+		dieWith(exitUsage, "The -draws and -bytes counts must be at least 1.\n")
+	}
+	lists := options.load()
+	names := options.chosen(lists)
+
+	var source io.Reader
+	if flagWasSet(flags, "seed") {
+		source = mathrand.New(mathrand.NewSource(*seed))
+		fmt.Printf("Drawing %d words from each list with the pseudorandom seed %d.\n\n", *draws, *seed)
+	} else {
+		fmt.Printf("Drawing %d words from each list with crypto/rand.\n\n", *draws)
+	}
+
+	passed := true
+	nameWidth := len("list")
+	for _, name := range names {
+		if len(name) > nameWidth {
+			nameWidth = len(name)
+		}
+	}
+	fmt.Printf("%-*s  %5s  %11s  %5s  %8s  %6s  %6s  %s\n", nameWidth, "list", "words", "chi-squared", "df", "p-value", "min", "max", "result")
+	for _, name := range names {
+		wordList, _ := lists.lookup(name)
+		counts, err := drawCounts(wordList, *draws, source)
+		if err != nil {
+			dieOnGenError(err, name)
+		}
+
+		statistic, df := stats.ChiSquaredUniform(counts)
+		p := stats.ChiSquaredPValue(statistic, df)
+		least, most := counts[0], counts[0]
+		for _, count := range counts {
+			if count < least {
+				least = count
+			}
+			if count > most {
+				most = count
+			}
+		}
+		result := "ok"
+		if p < auditAlpha/float64(len(names)) {
+			result = "FAIL"
+			passed = false
+		}
+		fmt.Printf("%-*s  %5d  %11.1f  %5d  %8.4f  %6d  %6d  %s\n", nameWidth, name, len(wordList), statistic, df, p, least, most, result)
+	}
+
+	health := stats.NewHealthTests(8, auditHealthAlpha)
+	if _, err := io.CopyN(health, rand.Reader, int64(*nBytes)); err != nil {
+		dieWith(exitRandomness, "Unable to read crypto/rand: %s\n", err)
+	}
+	fmt.Printf("\nNIST SP 800-90B health tests on %d bytes of crypto/rand:\n", health.Samples)
+	fmt.Printf("repetition count     longest run %d, failing at %d: %s\n",
+		health.LongestRun, health.RepetitionCutoff, healthResult(health.RepetitionFailures))
+	fmt.Printf("adaptive proportion  most frequent %d of %d, failing at %d: %s\n",
+		health.MostFrequent, health.ProportionWindow, health.ProportionCutoff, healthResult(health.ProportionFailures))
+
+	if !passed || !health.Passed() {
+		os.Exit(exitFailure)
+	}
+}
+
+// drawCounts draws words from wordList one at a time through a passphrase.Generator, reading source or, when it is
+// nil, crypto/rand, and returns how many times each word was drawn
+func drawCounts(wordList []string, draws int, source io.Reader) ([]int, error) {
+	generator := passphrase.NewGenerator(wordList, 1, "")
+	generator.Rand = source
+	counts := make([]int, len(wordList))
+	for i := 0; i < draws; i++ {
+		indices, err := generator.Indices()
+		if err != nil {
+			return nil, err
+		}
+		counts[indices[0]]++
+	}
+	return counts, nil
+}
+
+func healthResult(failures int) string {
+	if failures > 0 {
+		return fmt.Sprintf("FAIL (%d times)", failures)
+	}
+	return "ok"
+}
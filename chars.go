@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/glvnst/snakeeyes/passphrase"
+)
+
+// charsOptions holds the command line options of -mode chars
+type charsOptions struct {
+	length           int
+	classes          string
+	excludeAmbiguous bool
+	groupSize        int
+	delimiter        string
+	phrases          int
+	showEntropy      bool
+}
+
+// generateChars implements -mode chars, printing random strings of characters instead of passphrases
+func generateChars(opts charsOptions, targetBits float64, bitsSet bool) {
+	alphabet, err := passphrase.Alphabet(strings.Split(opts.classes, ","), opts.excludeAmbiguous)
+	if err != nil {
+		dieWith(exitUsage, "Unable to choose characters: %s.\n", err)
+	}
+	generator := &passphrase.CharGenerator{
+		Alphabet:       alphabet,
+		Length:         opts.length,
+		GroupSize:      opts.groupSize,
+		GroupDelimiter: opts.delimiter,
+	}
+	if bitsSet {
+		if generator.Length, err = generator.LengthForBits(targetBits); err != nil {
+			dieWith(exitUsage, "No number of characters reaches the -bits target: %s.\n", err)
+		}
+		warn("Using %d characters from an alphabet of %d, for %.1f bits of entropy per password.\n", generator.Length, len([]rune(alphabet)), generator.Entropy())
+	}
+	if generator.Length < 1 {
+		dieWith(exitUsage, "The -length must be at least 1.\n")
+	}
+
+	for p := 0; p < opts.phrases; p++ {
+		line, err := generator.Password()
+		if err != nil {
+			dieOnGenError(err, "chars")
+		}
+		if opts.showEntropy {
+			line += fmt.Sprintf("\t(%.1f bits)", generator.Entropy())
+		}
+		fmt.Println(line)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/glvnst/snakeeyes/passphrase"
+)
+
+const checkHelpText = `usage: %s check [-all | -list name] [-list-file path]
+
+Checks word lists for the properties EFF designed its lists around and prints
+a JSON report for each one: whether every word is unique, whether any word is
+the beginning of another (prefix-free) and how many leading characters tell
+the words apart, the smallest edit distance between two words, and whether
+passphrases joined with an empty -delimiter can only be split into words one
+way (uniquely decodable).
+
+This command used to be called validate-list, and still runs by that name.
+
+Command line options:
+
+`
+
+// checkMain implements the check command, which reports on the quality of word lists
+func checkMain(args []string) {
+	flags := flag.NewFlagSet("check", flag.ExitOnError)
+	options := addListFlags(flags, "eff", "the word list to check")
+	all := flags.Bool("all", false, "check every word list")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), checkHelpText, os.Args[0])
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	checkNoArgs(flags)
+
+	lists := options.load()
+	var names []string
+	if *all {
+		names = lists.names()
+	} else {
+		names = options.chosen(lists)
+	}
+
+	reports := make(map[string]*passphrase.ListReport, len(names))
+	for _, name := range names {
+		wordList, _ := lists.lookup(name)
+		reports[name] = passphrase.ValidateList(wordList)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(reports); err != nil {
+		die("Unable to write the report: %s\n", err)
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/glvnst/snakeeyes/passphrase"
+)
+
+// readDiceIndices prompts for and reads physical dice rolls from in, one line per word, and returns the word list
+// positions they name. Malformed rolls and rolls which land beyond the end of the list are asked for again.
+func readDiceIndices(in *bufio.Scanner, prompt io.Writer, listLen int, nWords int) ([]int, error) {
+	digits := passphrase.DiceDigits(listLen)
+	indices := make([]int, 0, nWords)
+
+	for len(indices) < nWords {
+		fmt.Fprintf(prompt, "Roll %d dice for word %d of %d: ", digits, len(indices)+1, nWords)
+		if !in.Scan() {
+			if err := in.Err(); err != nil {
+				return nil, err
+			}
+			return nil, io.ErrUnexpectedEOF
+		}
+
+		index, err := passphrase.DiceIndex(in.Text(), listLen)
+		switch {
+		case errors.Is(err, passphrase.ErrReroll):
+			fmt.Fprintf(prompt, "That roll doesn't name a word in this list; roll all %d dice again.\n", digits)
+			continue
+		case err != nil:
+			fmt.Fprintf(prompt, "%s; try again.\n", err)
+			continue
+		}
+		indices = append(indices, index)
+	}
+	return indices, nil
+}
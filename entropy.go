@@ -0,0 +1,81 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/glvnst/snakeeyes/passphrase"
+)
+
+const entropyHelpText = `usage: %s entropy [-bits n,n,...] [-list name] [-list-file path]
+
+Shows the bits of entropy each word of each list adds to a passphrase, and how
+many words from each list give a passphrase at least the -bits strengths.
+
+Command line options:
+
+`
+
+// entropyTargets are the strengths (in bits) reported in the per-list entropy summary
+var entropyTargets = []float64{64, 80, 128}
+
+// entropyMain implements the entropy command, which summarizes the strength of each word list
+func entropyMain(args []string) {
+	flags := flag.NewFlagSet("entropy", flag.ExitOnError)
+	bits := flags.String("bits", "64,80,128", "the comma separated strengths, in bits, to count the words needed for")
+	options := addListFlags(flags, "", "show only the named word list")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), entropyHelpText, os.Args[0])
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	checkNoArgs(flags)
+
+	var targets []float64
+	for _, field := range strings.Split(*bits, ",") {
+		target, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+		if err != nil || !(target > 0) || math.IsInf(target, 0) {
+			dieWith(exitUsage, "The -bits strengths must be positive numbers, not \"%s\".\n", field)
+		}
+		targets = append(targets, target)
+	}
+	lists := options.load()
+	printEntropySummary(os.Stdout, lists, options.chosen(lists), targets)
+}
+
+// printEntropySummary writes a table describing the strength of each of the named word lists, with the number of
+// words from each needed to reach the target strengths
+func printEntropySummary(w io.Writer, lists wordLists, names []string, targets []float64) {
+	nameWidth := len("list")
+	for _, name := range names {
+		if len(name) > nameWidth {
+			nameWidth = len(name)
+		}
+	}
+
+	fmt.Fprintf(w, "%-*s  %5s  %9s", nameWidth, "list", "words", "bits/word")
+	for _, target := range targets {
+		fmt.Fprintf(w, "  %8s", fmt.Sprintf("%g bits", target))
+	}
+	fmt.Fprintln(w)
+
+	for _, name := range names {
+		words, _ := lists.lookup(name)
+		listLen := len(words)
+		fmt.Fprintf(w, "%-*s  %5d  %9.3f", nameWidth, name, listLen, passphrase.BitsPerWord(listLen))
+		for _, target := range targets {
+			// no number of words reaches the target
+			if count := passphrase.WordsForBits(listLen, target); count < 0 {
+				fmt.Fprintf(w, "  %8s", "-")
+			} else {
+				fmt.Fprintf(w, "  %8d", count)
+			}
+		}
+		fmt.Fprintln(w)
+	}
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/glvnst/snakeeyes/passphrase"
+)
+
+func TestEntropySummary(t *testing.T) {
+	lists := wordLists{"zebra": {"one", "two"}, "a_rather_long_name": {"one", "two"}}
+	names := lists.names()
+	if want := append(passphrase.ListNames(), "a_rather_long_name", "zebra"); !reflect.DeepEqual(names, want) {
+		t.Errorf("expected the built-in lists in order, then the loaded lists sorted: %v, got: %v", want, names)
+	}
+
+	var out bytes.Buffer
+	printEntropySummary(&out, lists, names, []float64{64})
+	rows := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if len(rows) != len(names)+1 {
+		t.Fatalf("expected a header and %d rows, got:\n%s", len(names), out.String())
+	}
+	// every column lines up with the longest name
+	for _, row := range rows {
+		if len(row) != len(rows[0]) {
+			t.Errorf("misaligned row %q in:\n%s", row, out.String())
+		}
+	}
+	if !strings.HasPrefix(rows[len(rows)-2], "a_rather_long_name  ") {
+		t.Errorf("unexpected row %q", rows[len(rows)-2])
+	}
+
+	out.Reset()
+	printEntropySummary(&out, lists, []string{"zebra"}, []float64{64})
+	if want := "list   words  bits/word   64 bits\nzebra      2      1.000        64\n"; out.String() != want {
+		t.Errorf("want:\n%s\ngot:\n%s", want, out.String())
+	}
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// phraseRecord describes a generated passphrase for the structured output formats, so that scripts don't have to
+// split passphrases apart themselves (which fails when the delimiter appears inside words like "t-shirt")
+type phraseRecord struct {
+	Phrase  string   `json:"phrase"`
+	Words   []string `json:"words"`
+	Indices []int    `json:"indices"`
+	List    string   `json:"list"`
+	// Delimiter is the delimiter between the words, unless the -symbol rule chose a different one for each gap, in
+	// which case they are in Delimiters
+	Delimiter  string   `json:"delimiter"`
+	Delimiters []string `json:"delimiters,omitempty"`
+	Entropy    float64  `json:"entropy"`
+	DiceCodes  []string `json:"dice_codes,omitempty"`
+}
+
+// phraseWriter writes passphrases in one of the -format output formats. Close must be called after the last record.
+type phraseWriter interface {
+	Write(record phraseRecord) error
+	Close() error
+}
+
+// outputFormats maps each -format name to a constructor for its writer. Dice codes are only written with
+// -dice-codes, and entropy is only written in the text format with -entropy.
+var outputFormats = map[string]func(w io.Writer, diceCodes, showEntropy bool) phraseWriter{
+	"text": func(w io.Writer, diceCodes, showEntropy bool) phraseWriter {
+		return &textWriter{w: w, showEntropy: showEntropy}
+	},
+	"json": func(w io.Writer, diceCodes, showEntropy bool) phraseWriter {
+		return &jsonWriter{w: w, records: []phraseRecord{}}
+	},
+	"csv": func(w io.Writer, diceCodes, showEntropy bool) phraseWriter {
+		return &delimitedWriter{csv: csv.NewWriter(w), diceCodes: diceCodes}
+	},
+	"tsv": func(w io.Writer, diceCodes, showEntropy bool) phraseWriter {
+		return &delimitedWriter{tsv: w, diceCodes: diceCodes}
+	},
+	"yaml": func(w io.Writer, diceCodes, showEntropy bool) phraseWriter {
+		return &yamlWriter{w: w}
+	},
+}
+
+// formatNames returns the names of the output formats in alphabetical order
+func formatNames() []string {
+	names := make([]string, 0, len(outputFormats))
+	for name := range outputFormats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// textWriter writes one passphrase per line, followed by its dice codes and entropy if they were asked for
+type textWriter struct {
+	w           io.Writer
+	showEntropy bool
+}
+
+func (t *textWriter) Write(record phraseRecord) error {
+	line := record.Phrase
+	if record.DiceCodes != nil {
+		line += "\t[" + strings.Join(record.DiceCodes, " ") + "]"
+	}
+	if t.showEntropy {
+		line += fmt.Sprintf("\t(%.1f bits)", record.Entropy)
+	}
+	_, err := fmt.Fprintln(t.w, line)
+	return err
+}
+
+func (t *textWriter) Close() error {
+	return nil
+}
+
+// jsonWriter writes the passphrases as a JSON array of objects once they have all been generated
+type jsonWriter struct {
+	w       io.Writer
+	records []phraseRecord
+}
+
+func (j *jsonWriter) Write(record phraseRecord) error {
+	j.records = append(j.records, record)
+	return nil
+}
+
+func (j *jsonWriter) Close() error {
+	encoder := json.NewEncoder(j.w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(j.records)
+}
+
+// delimitedWriter writes the passphrases as CSV or TSV with a header row. Lists of words, indices, delimiters and
+// dice codes are joined with spaces, which never appear in words. CSV fields are quoted as needed; TSV fields can't
+// be, so tabs, newlines and backslashes in them are escaped as \t, \n and \\ instead.
+type delimitedWriter struct {
+	csv       *csv.Writer
+	tsv       io.Writer
+	diceCodes bool
+	header    bool
+}
+
+var tsvEscaper = strings.NewReplacer("\\", "\\\\", "\t", "\\t", "\n", "\\n", "\r", "\\r")
+
+func (d *delimitedWriter) writeRow(fields []string) error {
+	if d.csv != nil {
+		return d.csv.Write(fields)
+	}
+	for i, field := range fields {
+		fields[i] = tsvEscaper.Replace(field)
+	}
+	_, err := fmt.Fprintln(d.tsv, strings.Join(fields, "\t"))
+	return err
+}
+
+func (d *delimitedWriter) Write(record phraseRecord) error {
+	if !d.header {
+		d.header = true
+		header := []string{"phrase", "words", "indices", "list", "delimiter", "entropy"}
+		if d.diceCodes {
+			header = append(header, "dice_codes")
+		}
+		if err := d.writeRow(header); err != nil {
+			return err
+		}
+	}
+
+	indices := make([]string, len(record.Indices))
+	for i, index := range record.Indices {
+		indices[i] = strconv.Itoa(index)
+	}
+	delimiter := record.Delimiter
+	if record.Delimiters != nil {
+		delimiter = strings.Join(record.Delimiters, " ")
+	}
+	row := []string{
+		record.Phrase,
+		strings.Join(record.Words, " "),
+		strings.Join(indices, " "),
+		record.List,
+		delimiter,
+		strconv.FormatFloat(record.Entropy, 'f', -1, 64),
+	}
+	if d.diceCodes {
+		row = append(row, strings.Join(record.DiceCodes, " "))
+	}
+	return d.writeRow(row)
+}
+
+func (d *delimitedWriter) Close() error {
+	if d.csv == nil {
+		return nil
+	}
+	d.csv.Flush()
+	return d.csv.Error()
+}
+
+// yamlWriter writes the passphrases as a YAML sequence of mappings. Values are written as JSON, which YAML reads
+// the same way, so that strings are always quoted and escaped.
+type yamlWriter struct {
+	w       io.Writer
+	written bool
+}
+
+func (y *yamlWriter) Write(record phraseRecord) error {
+	y.written = true
+	fields := []struct {
+		key   string
+		value interface{}
+	}{
+		{"phrase", record.Phrase},
+		{"words", record.Words},
+		{"indices", record.Indices},
+		{"list", record.List},
+		{"delimiter", record.Delimiter},
+		{"delimiters", record.Delimiters},
+		{"entropy", record.Entropy},
+		{"dice_codes", record.DiceCodes},
+	}
+	prefix := "- "
+	for _, field := range fields {
+		if list, ok := field.value.([]string); ok && list == nil {
+			continue
+		}
+		value, err := json.Marshal(field.value)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(y.w, "%s%s: %s\n", prefix, field.key, value); err != nil {
+			return err
+		}
+		prefix = "  "
+	}
+	return nil
+}
+
+func (y *yamlWriter) Close() error {
+	if !y.written {
+		_, err := fmt.Fprintln(y.w, "[]")
+		return err
+	}
+	return nil
+}
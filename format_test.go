@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+// formatRecords exercise the awkward cases of each format: a delimiter inside a word, a tab, newline, backslash and
+// quote in the delimiter, the separate delimiters of the -symbol rule, and dice codes
+var formatRecords = []phraseRecord{
+	{Phrase: "t-shirt x-ray", Words: []string{"t-shirt", "x-ray"}, Indices: []int{5, 7}, List: "eff", Delimiter: " ", Entropy: 25.5, DiceCodes: []string{"11116", "11122"}},
+	{Phrase: "a\t\"b\\\nc", Words: []string{"a", "c"}, Indices: []int{0, 2}, List: "tiny", Delimiter: "\t\"b\\\n", Entropy: 3.17},
+	{Phrase: "one#two!three", Words: []string{"one", "two", "three"}, Indices: []int{1, 2, 3}, List: "tiny", Delimiters: []string{"#", "!"}, Entropy: 10},
+}
+
+func TestOutputFormats(t *testing.T) {
+	tests := []struct {
+		format      string
+		diceCodes   bool
+		showEntropy bool
+		want        string
+		// empty is what the format writes for no passphrases
+		empty string
+	}{
+		{"text", true, true, "t-shirt x-ray\t[11116 11122]\t(25.5 bits)\n" +
+			"a\t\"b\\\nc\t(3.2 bits)\n" +
+			"one#two!three\t(10.0 bits)\n", ""},
+		{"json", true, false, `[
+  {
+    "phrase": "t-shirt x-ray",
+    "words": [
+      "t-shirt",
+      "x-ray"
+    ],
+    "indices": [
+      5,
+      7
+    ],
+    "list": "eff",
+    "delimiter": " ",
+    "entropy": 25.5,
+    "dice_codes": [
+      "11116",
+      "11122"
+    ]
+  },
+  {
+    "phrase": "a\t\"b\\\nc",
+    "words": [
+      "a",
+      "c"
+    ],
+    "indices": [
+      0,
+      2
+    ],
+    "list": "tiny",
+    "delimiter": "\t\"b\\\n",
+    "entropy": 3.17
+  },
+  {
+    "phrase": "one#two!three",
+    "words": [
+      "one",
+      "two",
+      "three"
+    ],
+    "indices": [
+      1,
+      2,
+      3
+    ],
+    "list": "tiny",
+    "delimiter": "",
+    "delimiters": [
+      "#",
+      "!"
+    ],
+    "entropy": 10
+  }
+]
+`, "[]\n"},
+		{"csv", true, false, "phrase,words,indices,list,delimiter,entropy,dice_codes\n" +
+			"t-shirt x-ray,t-shirt x-ray,5 7,eff,\" \",25.5,11116 11122\n" +
+			"\"a\t\"\"b\\\nc\",a c,0 2,tiny,\"\t\"\"b\\\n\",3.17,\n" +
+			"one#two!three,one two three,1 2 3,tiny,# !,10,\n", ""},
+		{"tsv", false, false, "phrase\twords\tindices\tlist\tdelimiter\tentropy\n" +
+			"t-shirt x-ray\tt-shirt x-ray\t5 7\teff\t \t25.5\n" +
+			"a\\t\"b\\\\\\nc\ta c\t0 2\ttiny\t\\t\"b\\\\\\n\t3.17\n" +
+			"one#two!three\tone two three\t1 2 3\ttiny\t# !\t10\n", ""},
+		{"yaml", true, false, `- phrase: "t-shirt x-ray"
+  words: ["t-shirt","x-ray"]
+  indices: [5,7]
+  list: "eff"
+  delimiter: " "
+  entropy: 25.5
+  dice_codes: ["11116","11122"]
+- phrase: "a\t\"b\\\nc"
+  words: ["a","c"]
+  indices: [0,2]
+  list: "tiny"
+  delimiter: "\t\"b\\\n"
+  entropy: 3.17
+- phrase: "one#two!three"
+  words: ["one","two","three"]
+  indices: [1,2,3]
+  list: "tiny"
+  delimiter: ""
+  delimiters: ["#","!"]
+  entropy: 10
+`, "[]\n"},
+	}
+	for _, test := range tests {
+		var got bytes.Buffer
+		out := outputFormats[test.format](&got, test.diceCodes, test.showEntropy)
+		for _, record := range formatRecords {
+			if err := out.Write(record); err != nil {
+				t.Fatalf("%s: unable to write a record: %s", test.format, err)
+			}
+		}
+		if err := out.Close(); err != nil {
+			t.Fatalf("%s: unable to close the writer: %s", test.format, err)
+		}
+		if got.String() != test.want {
+			t.Errorf("%s: unexpected output.\nwant:\n%q\ngot:\n%q", test.format, test.want, got.String())
+		}
+
+		got.Reset()
+		if err := outputFormats[test.format](&got, test.diceCodes, test.showEntropy).Close(); err != nil {
+			t.Fatalf("%s: unable to close an empty writer: %s", test.format, err)
+		}
+		if got.String() != test.empty {
+			t.Errorf("%s: unexpected output for no passphrases. want: %q, got: %q", test.format, test.empty, got.String())
+		}
+	}
+}
@@ -0,0 +1,349 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/glvnst/snakeeyes/passphrase"
+)
+
+const genHelpText = `usage: %s [gen] [-words n | -bits n] [-phrases n] [-list {%s}] [-min-length n] [-max-length n] [-entropy] [-dice] [-dice-codes] [-list-file path] [-policy path] [-upper] [-digit] [-symbol] [-forbid chars] [-format text|json|csv|tsv|yaml]
+       %s [gen] -mode chars [-length n | -bits n] [-classes lower,upper,digits,symbols] [-no-ambiguous] [-group n] [-delimiter d] [-phrases n] [-entropy]
+       %s [gen] -mode pin [-length n | -bits n] [-no-repeats] [-no-runs] [-no-common] [-phrases n] [-entropy]
+
+Generates passphrases from words chosen uniformly at random from a word list
+with the operating system's random number generator. This is what snakeeyes
+does when no command is given.
+
+Rather than counting words, -bits asks for the fewest words which give each
+passphrase at least the given strength from whichever list is chosen; for
+example -bits 77 chooses six words from eff but eight from memorable. The
+strength chosen is reported on standard error.
+
+Password rules can be met without hand-editing passphrases, which would lose
+track of their strength. -upper capitalizes a randomly chosen word, -digit
+appends a random digit to a randomly chosen word, -symbol replaces each
+delimiter with a random symbol and -forbid drops words containing the given
+characters from the list. Each random choice adds to the strength -entropy
+reports (-bits only counts the words). The same rules, along with min_length,
+max_length and symbols, can be read from a JSON file with -policy, such as
+{"upper": true, "digit": true, "forbidden": "\"'", "max_length": 64}.
+
+Scripts which read the passphrases can use -format json, csv, tsv or yaml to
+get each passphrase along with its words, their positions in the list, the
+list, the delimiter and the entropy, rather than splitting passphrases apart
+(which goes wrong when the delimiter also appears inside words like "t-shirt").
+
+With -mode chars, snakeeyes prints dense random strings for API secrets and
+service accounts instead of passphrases. Each character is chosen uniformly
+from the -classes given, the same way words are chosen, leaving out I, l, 1, O
+and 0 with -no-ambiguous. -group 4 splits the strings up like xxxx-xxxx-xxxx
+(with -delimiter, or - by default), and -bits chooses the length.
+
+With -mode pin, snakeeyes prints numeric PINs for cards and door locks, chosen
+the same way. -no-repeats forbids a digit following itself, -no-runs forbids
+three digits counting up or down in a row and -no-common forbids the most
+common PINs. PINs are chosen uniformly from those following the rules, and
+-entropy reports the strength that is left by counting them.
+
+Use -min-length and -max-length to fit passphrases into password prompts with
+length rules. Lengths count every character including delimiters. Phrases which
+don't fit are thrown away and redrawn, which keeps the output uniform but leaves
+fewer possible phrases; -entropy reports the reduced strength exactly.
+
+With -dice, snakeeyes never uses the computer's random number generator.
+Instead it asks you to roll real six-sided dice and type in the results, one
+line per word, reading the dice left to right (e.g. "35642"). Each word takes
+five dice from the eff list and four from memorable or touchscreen, exactly as
+in EFF's printed lists. The fandom lists take five dice, and rolls landing past
+the end of those lists must be rolled again.
+
+With -dice-codes, each passphrase is followed by the dice codes of its words so
+that it can be checked against a printed copy of the list. The lookup command
+translates dice codes into words and words into dice codes.
+
+-verify-lists checks that each built in word list still has the words it was
+built with, comparing its size and SHA-256 with those recorded when the lists
+were generated. A damaged or patched binary with a shortened list would quietly
+produce weaker passphrases; -verify-lists exits with status 3 if it finds one.
+
+Command line options:
+
+`
+
+// maxRejectionBits limits how unlikely (as a power of two) it may be for a random phrase to meet the length
+// requirements; beyond this the rejection sampling loop would take too long to find phrases
+const maxRejectionBits = 20
+
+// modeFlags lists the flags which only apply to each mode; the rest apply to both
+var modeFlags = map[string][]string{
+	"words": {"words", "delimiter", "list", "list-file", "dice", "dice-codes", "min-length", "max-length", "policy", "upper", "digit", "symbol", "forbid", "symbols", "format"},
+	"chars": {"length", "delimiter", "classes", "no-ambiguous", "group"},
+	"pin":   {"length", "no-repeats", "no-runs", "no-common"},
+}
+
+// checkModeFlags exits with a usage error if a flag which only applies to other modes was set
+func checkModeFlags(flags *flag.FlagSet, mode string) {
+	applies := make(map[string]bool)
+	for _, name := range modeFlags[mode] {
+		applies[name] = true
+	}
+	for _, names := range modeFlags {
+		for _, name := range names {
+			if !applies[name] && flagWasSet(flags, name) {
+				dieWith(exitUsage, "The -%s option doesn't apply to -mode %s.\n", name, mode)
+			}
+		}
+	}
+}
+
+// verifyLists checks every built in word list, writing a line about each to w, and reports whether they all passed
+func verifyLists(w io.Writer) bool {
+	ok := true
+	for _, list := range passphrase.WordLists {
+		digest, err := list.Verify()
+		if err != nil {
+			fmt.Fprintf(w, "%s: FAILED, %s\n", list.Name, err)
+			ok = false
+			continue
+		}
+		fmt.Fprintf(w, "%s: ok, %s words, SHA-256 %s\n", list.Name, thousands(list.Len()), digest)
+	}
+	return ok
+}
+
+// genMain implements the gen command, which generates passphrases, random strings or PINs. It is also what runs when
+// no command is given, in which case -h prints the main help instead of gen's.
+func genMain(args []string, defaultCommand bool) {
+	flags := flag.NewFlagSet("gen", flag.ExitOnError)
+	var (
+		wordCount     = flags.Int("words", 6, "the number of words to include in each generated passphrase")
+		targetBits    = flags.Float64("bits", 0, "use the fewest words which give each passphrase at least this many bits of entropy, instead of -words")
+		phraseCount   = flags.Int("phrases", 3, "the number of passphrases to generate")
+		delimiter     = flags.String("delimiter", " ", "the delimiter between words in a passphrase")
+		options       = addListFlags(flags, "eff", "the word list to choose words from")
+		reportVersion = flags.Bool("version", false, "report version number and exit")
+		showEntropy   = flags.Bool("entropy", false, "report the bits of entropy in each passphrase and a summary of each word list")
+		useDice       = flags.Bool("dice", false, "choose words by typing in rolls of physical dice instead of using the computer's random number generator")
+		showDiceCodes = flags.Bool("dice-codes", false, "print the dice code of each word after each passphrase")
+		minLength     = flags.Int("min-length", 0, "the minimum number of characters in each passphrase, delimiters included")
+		maxLength     = flags.Int("max-length", 0, "the maximum number of characters in each passphrase, delimiters included (0 means no limit)")
+		policyFile    = flags.String("policy", "", "read password rules from the given JSON policy file")
+		requireUpper  = flags.Bool("upper", false, "require an uppercase letter by capitalizing a randomly chosen word")
+		requireDigit  = flags.Bool("digit", false, "require a digit by appending a random digit to a randomly chosen word")
+		requireSymbol = flags.Bool("symbol", false, "require a symbol by replacing each delimiter with a random symbol")
+		forbid        = flags.String("forbid", "", "characters which must not appear in passphrases")
+		symbols       = flags.String("symbols", "", "the symbols -symbol chooses from (default \""+passphrase.DefaultSymbols+"\")")
+		mode          = flags.String("mode", "words", "generate passphrases of words, random strings of characters with chars, or numeric PINs with pin")
+		length        = flags.Int("length", 0, "the number of characters in each random string with -mode chars (20 by default), or of digits in each PIN with -mode pin (4 by default)")
+		charClasses   = flags.String("classes", "lower,upper,digits", "the comma separated character classes to choose from with -mode chars: lower, upper, digits and symbols")
+		noAmbiguous   = flags.Bool("no-ambiguous", false, "leave out characters which are easily confused ("+passphrase.Ambiguous+") with -mode chars")
+		groupSize     = flags.Int("group", 0, "split each random string into groups of this many characters joined by the delimiter, with -mode chars")
+		noRepeats     = flags.Bool("no-repeats", false, "forbid a digit from following itself in PINs, as in 1123, with -mode pin")
+		noRuns        = flags.Bool("no-runs", false, "forbid three digits counting up or down in a row in PINs, as in 1235 or 8761, with -mode pin")
+		noCommon      = flags.Bool("no-common", false, "forbid the most common PINs, such as 1234 and 0000, with -mode pin")
+		format        = flags.String("format", "text", "print passphrases as text, or as json, csv, tsv or yaml records of their words, list indices, list, delimiter and entropy")
+		verify        = flags.Bool("verify-lists", false, "check each built in word list against the size and SHA-256 recorded when it was generated, and exit")
+	)
+	flags.Usage = func() {
+		if defaultCommand {
+			mainUsage(flags)
+			return
+		}
+		fmt.Fprintf(flags.Output(), genHelpText, os.Args[0], strings.Join(passphrase.ListNames(), ","), os.Args[0], os.Args[0])
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if defaultCommand && flags.NArg() > 0 && flags.Arg(0) == args[0] {
+		// a misspelt or unknown command, rather than passphrase options
+		dieWith(exitUsage, "No such command \"%s\"; run \"%s help\" for the list of commands.\n", args[0], os.Args[0])
+	}
+	checkNoArgs(flags)
+
+	if *reportVersion {
+		die("%s %s\n %s\n %s\n", product, version, commit, date)
+	}
+	if *verify {
+		if !verifyLists(os.Stdout) {
+			dieWith(exitBadList, "The built in word lists are damaged; reinstall snakeeyes.\n")
+		}
+		return
+	}
+
+	if flagWasSet(flags, "bits") && (math.IsNaN(*targetBits) || math.IsInf(*targetBits, 0) || *targetBits <= 0) {
+		dieWith(exitUsage, "The -bits target must be a positive number of bits.\n")
+	}
+
+	switch *mode {
+	case "words":
+		checkModeFlags(flags, *mode)
+	case "chars":
+		checkModeFlags(flags, *mode)
+		if flagWasSet(flags, "bits") && flagWasSet(flags, "length") {
+			dieWith(exitUsage, "Use either -length or -bits, not both.\n")
+		}
+		groupDelimiter := "-"
+		if flagWasSet(flags, "delimiter") {
+			groupDelimiter = *delimiter
+		}
+		charLength := 20
+		if flagWasSet(flags, "length") {
+			charLength = *length
+		}
+		generateChars(charsOptions{
+			length:           charLength,
+			classes:          *charClasses,
+			excludeAmbiguous: *noAmbiguous,
+			groupSize:        *groupSize,
+			delimiter:        groupDelimiter,
+			phrases:          *phraseCount,
+			showEntropy:      *showEntropy,
+		}, *targetBits, flagWasSet(flags, "bits"))
+		return
+	case "pin":
+		checkModeFlags(flags, *mode)
+		if flagWasSet(flags, "bits") && flagWasSet(flags, "length") {
+			dieWith(exitUsage, "Use either -length or -bits, not both.\n")
+		}
+		generator := &passphrase.PINGenerator{Length: 4, NoRepeats: *noRepeats, NoRuns: *noRuns, NoCommon: *noCommon}
+		if flagWasSet(flags, "length") {
+			generator.Length = *length
+		}
+		if flagWasSet(flags, "bits") {
+			var err error
+			if generator.Length, err = generator.LengthForBits(*targetBits); err != nil {
+				dieWith(exitUsage, "No number of digits reaches the -bits target: %s.\n", err)
+			}
+			warn("Using %d digits, for %.1f bits of entropy per PIN.\n", generator.Length, generator.Entropy())
+		}
+		generatePINs(generator, *phraseCount, *showEntropy)
+		return
+	default:
+		dieWith(exitUsage, "No such mode \"%s\"; choose words, chars or pin.\n", *mode)
+	}
+
+	newWriter, ok := outputFormats[*format]
+	if !ok {
+		dieWith(exitUsage, "No such format \"%s\"; choose from %s.\n", *format, strings.Join(formatNames(), ", "))
+	}
+
+	// parsing
+	lists := options.load()
+	listName := options.chosen(lists)[0]
+	wordList, _ := lists.lookup(listName)
+
+	var policy passphrase.Policy
+	if *policyFile != "" {
+		var err error
+		if policy, err = passphrase.LoadPolicy(*policyFile); err != nil {
+			dieWith(exitUsage, "Unable to load the policy. %s\n", err)
+		}
+	}
+	policy.Upper = policy.Upper || *requireUpper
+	policy.Digit = policy.Digit || *requireDigit
+	policy.Symbol = policy.Symbol || *requireSymbol
+	policy.Forbidden += *forbid
+	if *symbols != "" {
+		policy.Symbols = *symbols
+	}
+	if policy.Transforms() && (*useDice || *showDiceCodes) {
+		dieWith(exitUsage, "The -dice and -dice-codes options can't be combined with password rules.\n")
+	}
+
+	generator := passphrase.NewGenerator(wordList, *wordCount, *delimiter)
+	generator.Lengths = passphrase.LengthRange{Min: *minLength, Max: *maxLength}
+	transformer, err := policy.Prepare(generator)
+	if err != nil {
+		dieOnGenError(err, listName)
+	}
+	if len(generator.Words) < len(wordList) {
+		warn("The password rules leave %d of the %d words in the \"%s\" list.\n", len(generator.Words), len(wordList), listName)
+	}
+	if flagWasSet(flags, "bits") {
+		if flagWasSet(flags, "words") {
+			dieWith(exitUsage, "Use either -words or -bits, not both.\n")
+		}
+		*wordCount, err = generator.CountForBits(*targetBits)
+		if err != nil {
+			dieOnGenError(err, listName)
+		}
+		generator.Count = *wordCount
+		warn("Using %d words from the \"%s\" list, for %.1f bits of entropy per passphrase.\n", *wordCount, listName, transformer.Entropy())
+	}
+	if err := generator.Validate(); err != nil {
+		dieOnGenError(err, listName)
+	}
+	if policy.Symbol && generator.Count < 2 {
+		dieWith(exitUsage, "The -symbol rule replaces delimiters, so it needs at least two words.\n")
+	}
+	if *useDice && !generator.Lengths.Unbounded() {
+		dieWith(exitUsage, "The -dice option can't be combined with -min-length or -max-length.\n")
+	}
+	if !generator.Lengths.Unbounded() {
+		// each draw is accepted with probability 2^(bits - unconstrained bits); refuse to spin for ages
+		if passphrase.Entropy(len(generator.Words), *wordCount)-generator.Entropy() > maxRejectionBits {
+			dieWith(exitUsage, "The requested length is too restrictive for %d words from the \"%s\" list; try a different -words count or -bits target.\n", *wordCount, listName)
+		}
+	}
+	bits := transformer.Entropy()
+
+	var rolls *bufio.Scanner
+	if *useDice {
+		rolls = bufio.NewScanner(os.Stdin)
+	}
+
+	out := newWriter(os.Stdout, *showDiceCodes, *showEntropy)
+	for p := 0; p < *phraseCount; p++ {
+		var indices []int
+		var err error
+		if *useDice {
+			indices, err = readDiceIndices(rolls, os.Stderr, len(wordList), *wordCount)
+			if err != nil {
+				die("\nUnable to read dice rolls: %s\n", err)
+			}
+		} else {
+			indices, err = generator.Indices()
+			if err != nil {
+				dieOnGenError(err, listName)
+			}
+		}
+
+		words, delimiters, err := transformer.ApplyWords(indices)
+		if err != nil {
+			dieOnGenError(err, listName)
+		}
+		// the records give each word's position in the list it was named after, not in the list the rules filtered
+		record := phraseRecord{Words: words, Indices: transformer.OriginalIndices(indices), List: listName, Delimiter: generator.Delimiter, Entropy: bits}
+		for i, word := range words {
+			if i > 0 {
+				record.Phrase += delimiters[i-1]
+			}
+			record.Phrase += word
+		}
+		if policy.Symbol {
+			record.Delimiter, record.Delimiters = "", delimiters
+		}
+		if *showDiceCodes {
+			record.DiceCodes = make([]string, len(indices))
+			for i, index := range record.Indices {
+				record.DiceCodes[i] = passphrase.DiceCode(index, len(wordList))
+			}
+		}
+		if err := out.Write(record); err != nil {
+			die("Unable to write a passphrase: %s\n", err)
+		}
+	}
+	if err := out.Close(); err != nil {
+		die("Unable to write the passphrases: %s\n", err)
+	}
+
+	if *showEntropy && *format == "text" {
+		fmt.Println()
+		printEntropySummary(os.Stdout, lists, lists.names(), entropyTargets)
+	}
+}
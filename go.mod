@@ -1,3 +1,3 @@
-module snakeeyes
+module github.com/glvnst/snakeeyes
 
 go 1.20
@@ -0,0 +1,148 @@
+//go:build ignore
+// +build ignore
+
+// cmplists measures what the front coded, embedded word lists cost against the map literal of []string which
+// wordlists.go used to be, by building a small program each way and comparing the binaries' sizes, how long they
+// take to start and look up a list, and how much they allocate doing so. The map literal is generated from the
+// embedded lists, so the two programs hold exactly the same words. Run it from the root of the repository with
+// go run helpers/cmplists.go.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/glvnst/snakeeyes/passphrase"
+)
+
+// program is the main function both programs share; only the way they get their words differs
+const program = `package main
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/glvnst/snakeeyes/passphrase"
+)
+
+func main() {
+	words := lookup(%q)
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	fmt.Println(len(words), passphrase.BitsPerWord(len(words)), m.TotalAlloc)
+}
+`
+
+const embeddedLookup = `package main
+
+import "github.com/glvnst/snakeeyes/passphrase"
+
+func lookup(name string) []string {
+	words, _ := passphrase.Lookup(name)
+	return words
+}
+`
+
+// mapLiteral returns the source of the map literal wordlists.go used to hold, with a lookup function using it
+func mapLiteral() []byte {
+	var f bytes.Buffer
+	f.WriteString("package main\n\nvar WordLists map[string][]string = map[string][]string{\n")
+	for _, list := range passphrase.WordLists {
+		fmt.Fprintf(&f, "\t%q: {\n", list.Name)
+		for _, word := range list.Words() {
+			fmt.Fprintf(&f, "\t\t%q,\n", word)
+		}
+		f.WriteString("\t},\n")
+	}
+	f.WriteString("}\n\nfunc lookup(name string) []string {\n\treturn WordLists[name]\n}\n")
+	return f.Bytes()
+}
+
+// measurement is what was measured of one program
+type measurement struct {
+	name    string
+	size    int64
+	startup time.Duration
+	alloc   string
+}
+
+// measure builds the program in dir and runs it the given number of times
+func measure(name, dir string, runs int) measurement {
+	binary := filepath.Join(dir, "program")
+	build := exec.Command("go", "build", "-o", binary, ".")
+	build.Dir = dir
+	build.Stderr = os.Stderr
+	if err := build.Run(); err != nil {
+		log.Fatalf("Unable to build the %s program. error: %s", name, err)
+	}
+	info, err := os.Stat(binary)
+	if err != nil {
+		log.Fatalf("Unable to measure the %s program. error: %s", name, err)
+	}
+
+	m := measurement{name: name, size: info.Size()}
+	start := time.Now()
+	var output []byte
+	for i := 0; i < runs; i++ {
+		if output, err = exec.Command(binary).Output(); err != nil {
+			log.Fatalf("Unable to run the %s program. error: %s", name, err)
+		}
+	}
+	m.startup = time.Since(start) / time.Duration(runs)
+	fields := strings.Fields(string(output))
+	m.alloc = fields[len(fields)-1]
+	return m
+}
+
+// writeProgram writes a module holding the program and its lookup function to a new directory in parent
+func writeProgram(parent, name, listName string, lookup []byte) string {
+	root, err := filepath.Abs(".")
+	if err != nil {
+		log.Fatalf("Unable to find the repository. error: %s", err)
+	}
+	dir := filepath.Join(parent, name)
+	files := map[string][]byte{
+		"go.mod":    []byte(fmt.Sprintf("module %s\n\ngo 1.20\n\nrequire github.com/glvnst/snakeeyes v0.0.0\n\nreplace github.com/glvnst/snakeeyes => %s\n", name, root)),
+		"main.go":   []byte(fmt.Sprintf(program, listName)),
+		"lookup.go": lookup,
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		log.Fatalf("Unable to create %s. error: %s", dir, err)
+	}
+	for file, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, file), data, 0644); err != nil {
+			log.Fatalf("Unable to write %s. error: %s", file, err)
+		}
+	}
+	return dir
+}
+
+func main() {
+	runs := flag.Int("runs", 100, "the number of times to run each program when timing its start up")
+	listName := flag.String("list", "eff", "the word list each program looks up")
+	flag.Parse()
+
+	parent, err := os.MkdirTemp("", "cmplists")
+	if err != nil {
+		log.Fatalf("Unable to create a temporary directory. error: %s", err)
+	}
+	defer os.RemoveAll(parent)
+
+	results := []measurement{
+		measure("embedded", writeProgram(parent, "embedded", *listName, []byte(embeddedLookup)), *runs),
+		measure("map literal", writeProgram(parent, "mapliteral", *listName, mapLiteral()), *runs),
+	}
+	fmt.Printf("%-12s  %12s  %12s  %15s\n", "storage", "binary bytes", "start up", "bytes allocated")
+	for _, m := range results {
+		fmt.Printf("%-12s  %12d  %12s  %15s\n", m.name, m.size, m.startup.Round(time.Microsecond), m.alloc)
+	}
+	fmt.Printf("\nThe embedded lists save %d bytes of binary. Start up is the mean wall time of %d runs, each looking up the %s list.\n",
+		results[1].size-results[0].size, *runs, *listName)
+}
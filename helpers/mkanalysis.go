@@ -0,0 +1,294 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"html"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/glvnst/snakeeyes/internal/stats"
+	"github.com/glvnst/snakeeyes/internal/wordsource"
+)
+
+// listDir holds the preprocessed word lists named in the manifest, the same files helpers/mkwordlists.go reads
+const listDir = "wordlists"
+
+// analysisDir holds the generated report, its JSON data and the histograms
+const analysisDir = "wordlists/analysis"
+
+// listStats holds the statistics of one list, with the field names analyze.py used in analysis.json
+type listStats struct {
+	ListName     string  `json:"List Name"`
+	WordCount    int     `json:"Word Count"`
+	UniqueWords  int     `json:"Unique Word Count"`
+	MinLength    int     `json:"Min word length"`
+	MaxLength    int     `json:"Max word length"`
+	MeanLength   float64 `json:"Mean word length"`
+	MedianLength float64 `json:"Median word length"`
+	StdDevLength float64 `json:"Word length standard deviation"`
+	Q1Length     float64 `json:"First quartile of word length"`
+	Q3Length     float64 `json:"Third quartile of word length"`
+}
+
+// analysis is the analysis of one list
+type analysis struct {
+	Stats    listStats `json:"stats"`
+	PlotFile string    `json:"plot_file"`
+
+	name string
+	// lengthCounts counts the words of each length
+	lengthCounts []int
+}
+
+// analyze computes the statistics of the words of the named list, which was read from file
+func analyze(name, file string, words []string) analysis {
+	unique := make(map[string]bool, len(words))
+	lengths := make([]float64, len(words))
+	for i, word := range words {
+		unique[word] = true
+		lengths[i] = float64(len(word))
+	}
+	summary := stats.Describe(lengths)
+
+	a := analysis{
+		Stats: listStats{
+			ListName:     file,
+			WordCount:    len(words),
+			UniqueWords:  len(unique),
+			MinLength:    int(summary.Min),
+			MaxLength:    int(summary.Max),
+			MeanLength:   summary.Mean,
+			MedianLength: summary.Median,
+			StdDevLength: summary.StdDev,
+			Q1Length:     summary.Q1,
+			Q3Length:     summary.Q3,
+		},
+		PlotFile:     "histogram_word_lengths_" + name + ".svg",
+		name:         name,
+		lengthCounts: make([]int, int(summary.Max)+1),
+	}
+	for _, length := range lengths {
+		a.lengthCounts[int(length)]++
+	}
+	return a
+}
+
+// num formats a coordinate for the SVG, to two decimal places without trailing zeros
+func num(x float64) string {
+	return strconv.FormatFloat(math.Round(x*100)/100, 'f', -1, 64)
+}
+
+// tickStep returns a round step between the ticks of an axis from zero to max, giving at most about eight ticks
+func tickStep(max int) int {
+	for magnitude := 1; ; magnitude *= 10 {
+		for _, step := range []int{1, 2, 5} {
+			if max/(step*magnitude) <= 8 {
+				return step * magnitude
+			}
+		}
+	}
+}
+
+// histogram draws a bar chart of the number of words of each length as an SVG image
+func histogram(a analysis) []byte {
+	const (
+		width, height            = 640.0, 400.0
+		left, right, top, bottom = 70.0, 20.0, 40.0, 50.0
+		plotWidth, plotHeight    = width - left - right, height - top - bottom
+		// gap is the space on each side of a bar
+		gap        = 1.0
+		textStyle  = `font-family="sans-serif" font-size="12"`
+		titleStyle = `font-family="sans-serif" font-size="14"`
+		barColor   = "#1f77b4"
+		axisColor  = "#000000"
+		gridColor  = "#dddddd"
+	)
+	minLength, maxLength := a.Stats.MinLength, a.Stats.MaxLength
+	mostCommon := 0
+	for _, count := range a.lengthCounts {
+		if count > mostCommon {
+			mostCommon = count
+		}
+	}
+	step := tickStep(mostCommon)
+	// the count axis runs up to the first tick at or above the most common length
+	yMax := (mostCommon + step - 1) / step * step
+	if yMax == 0 {
+		yMax = step
+	}
+	band := plotWidth / float64(maxLength-minLength+1)
+	y := func(count int) float64 { return top + plotHeight - float64(count)/float64(yMax)*plotHeight }
+
+	var svg bytes.Buffer
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s">`+"\n", num(width), num(height), num(width), num(height))
+	fmt.Fprintf(&svg, `<rect width="%s" height="%s" fill="#ffffff"/>`+"\n", num(width), num(height))
+	fmt.Fprintf(&svg, `<text x="%s" y="%s" text-anchor="middle" %s>Histogram: Word Lengths in %s</text>`+"\n", num(left+plotWidth/2), num(top/2+5), titleStyle, html.EscapeString(a.Stats.ListName))
+
+	for count := 0; count <= yMax; count += step {
+		fmt.Fprintf(&svg, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="%s"/>`+"\n", num(left), num(y(count)), num(left+plotWidth), num(y(count)), gridColor)
+		fmt.Fprintf(&svg, `<text x="%s" y="%s" text-anchor="end" %s>%d</text>`+"\n", num(left-6), num(y(count)+4), textStyle, count)
+	}
+	for length := minLength; length <= maxLength; length++ {
+		x := left + float64(length-minLength)*band
+		count := a.lengthCounts[length]
+		fmt.Fprintf(&svg, `<rect x="%s" y="%s" width="%s" height="%s" fill="%s"><title>%d words of length %d</title></rect>`+"\n",
+			num(x+gap), num(y(count)), num(band-2*gap), num(top+plotHeight-y(count)), barColor, count, length)
+		fmt.Fprintf(&svg, `<text x="%s" y="%s" text-anchor="middle" %s>%d</text>`+"\n", num(x+band/2), num(top+plotHeight+16), textStyle, length)
+	}
+	fmt.Fprintf(&svg, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="%s"/>`+"\n", num(left), num(top+plotHeight), num(left+plotWidth), num(top+plotHeight), axisColor)
+	fmt.Fprintf(&svg, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="%s"/>`+"\n", num(left), num(top), num(left), num(top+plotHeight), axisColor)
+	fmt.Fprintf(&svg, `<text x="%s" y="%s" text-anchor="middle" %s>Word Length</text>`+"\n", num(left+plotWidth/2), num(height-12), textStyle)
+	fmt.Fprintf(&svg, `<text x="%s" y="%s" text-anchor="middle" transform="rotate(-90 %s %s)" %s>Count</text>`+"\n", num(18), num(top+plotHeight/2), num(18), num(top+plotHeight/2), textStyle)
+	svg.WriteString("</svg>\n")
+	return svg.Bytes()
+}
+
+// markdownTable formats rows as a markdown table whose columns are padded to line up. Columns whose alignment is
+// "right" are right aligned.
+func markdownTable(header []string, alignments []string, rows [][]string) string {
+	widths := make([]int, len(header))
+	for _, row := range append([][]string{header}, rows...) {
+		for i, cell := range row {
+			if len(cell) > widths[i] {
+				widths[i] = len(cell)
+			}
+		}
+	}
+	var b strings.Builder
+	line := func(cells []string) {
+		for i, cell := range cells {
+			if i > 0 {
+				b.WriteString(" | ")
+			}
+			if alignments[i] == "right" {
+				fmt.Fprintf(&b, "%*s", widths[i], cell)
+			} else if i < len(cells)-1 {
+				fmt.Fprintf(&b, "%-*s", widths[i], cell)
+			} else {
+				b.WriteString(cell)
+			}
+		}
+		b.WriteString("\n")
+	}
+	line(header)
+	rule := make([]string, len(header))
+	for i := range rule {
+		rule[i] = strings.Repeat("-", widths[i])
+		if alignments[i] == "right" {
+			rule[i] = rule[i][:widths[i]-1] + ":"
+		}
+	}
+	line(rule)
+	for _, row := range rows {
+		line(row)
+	}
+	return b.String()
+}
+
+// thousands formats n with commas between groups of three digits
+func thousands(n int) string {
+	s := strconv.Itoa(n)
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return s
+}
+
+// report writes analysis.md
+func report(analyses []analysis) []byte {
+	var rows, plots [][]string
+	var duplicates []string
+	for i, a := range analyses {
+		s := a.Stats
+		rows = append(rows, []string{
+			"`" + a.name + "`", "`" + s.ListName + "`", thousands(s.UniqueWords), strconv.Itoa(s.MinLength), strconv.Itoa(s.MaxLength),
+			strconv.FormatFloat(s.MedianLength, 'f', -1, 64), fmt.Sprintf("%.3f", s.MeanLength), fmt.Sprintf("%.3f", s.StdDevLength),
+			strconv.FormatFloat(s.Q1Length, 'f', -1, 64), strconv.FormatFloat(s.Q3Length, 'f', -1, 64),
+		})
+		plots = append(plots, []string{"`" + a.name + "`", fmt.Sprintf("[![][fig%d]][fig%d]", i+1, i+1)})
+		if s.UniqueWords != s.WordCount {
+			duplicates = append(duplicates, fmt.Sprintf("`%s` has %d duplicate words", a.name, s.WordCount-s.UniqueWords))
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("<!-- Code generated by helpers/mkanalysis.go DO NOT EDIT. -->\n")
+	b.WriteString("# Analysis of Word Lists\n\n## Word Length\n\n")
+	b.WriteString(markdownTable(
+		[]string{"List Name", "File", "Unique Words", "Min WL", "Max WL", "Median WL", "Mean WL", "WL Std. Dev.", "WL Q1", "WL Q3"},
+		[]string{"", "", "right", "right", "right", "right", "right", "right", "right", "right"},
+		rows))
+	b.WriteString("\nNotes:\n\n")
+	if len(duplicates) == 0 {
+		b.WriteString("* All words in a wordlist are unique\n")
+	}
+	for _, duplicate := range duplicates {
+		fmt.Fprintf(&b, "* %s\n", duplicate)
+	}
+	b.WriteString("* WL refers to \"Word Length\" in the table above\n")
+	b.WriteString("* `snakeeyes lists` prints the same statistics, with a histogram, for any list\n")
+	b.WriteString("\n### Distribution Plots\n\n")
+	b.WriteString(markdownTable([]string{"List Name", "Plot"}, []string{"", ""}, plots))
+	b.WriteString("\n")
+	for i, a := range analyses {
+		fmt.Fprintf(&b, "[fig%d]: %s\n", i+1, a.PlotFile)
+	}
+	return []byte(b.String())
+}
+
+func main() {
+	checkOnly := flag.Bool("check", false, "regenerate in memory and exit with an error if the files in "+analysisDir+" differ, instead of writing them")
+	flag.Parse()
+
+	sources, err := wordsource.LoadManifest(wordsource.ManifestFile)
+	if err != nil {
+		log.Fatalf("Unable to load the manifest. error: %s", err)
+	}
+	var analyses []analysis
+	files := make(map[string][]byte)
+	for _, source := range sources {
+		words, _, err := wordsource.LoadList(filepath.Join(listDir, source.File))
+		if err != nil {
+			log.Fatalf("Unable to load the %s list. error: %s", source.Name, err)
+		}
+		a := analyze(source.Name, source.File, words)
+		analyses = append(analyses, a)
+		files[filepath.Join(analysisDir, a.PlotFile)] = histogram(a)
+	}
+	data, err := json.MarshalIndent(analyses, "", "  ")
+	if err != nil {
+		log.Fatalf("Unable to encode the analysis. error: %s", err)
+	}
+	files[filepath.Join(analysisDir, "analysis.json")] = append(data, '\n')
+	files[filepath.Join(analysisDir, "analysis.md")] = report(analyses)
+
+	if *checkOnly {
+		matches := true
+		for path, want := range files {
+			if got, err := os.ReadFile(path); err != nil || !bytes.Equal(got, want) {
+				log.Printf("%s is out of date", path)
+				matches = false
+			}
+		}
+		if !matches {
+			log.Fatalf("The word list analysis does not match the lists; run go generate")
+		}
+		log.Printf("The word list analysis matches the lists")
+		return
+	}
+	for path, data := range files {
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			log.Fatalf("Unable to write %s. error: %s", path, err)
+		}
+	}
+}
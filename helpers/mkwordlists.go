@@ -4,75 +4,282 @@
 package main
 
 import (
-	"bufio"
+	"bytes"
+	"flag"
 	"fmt"
+	"go/format"
 	"log"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
+
+	"github.com/glvnst/snakeeyes/internal/wordsource"
 )
 
-const outputFilename = "wordlists.go"
+const outputFilename = "passphrase/wordlists.go"
 
-var sources = map[string]string{
-	// original
-	"eff":         "wordlists/eff.txt",
-	"memorable":   "wordlists/effshort1.txt",
-	"touchscreen": "wordlists/effshort2.txt",
+// blobDir holds the front coded word lists which passphrase/embed.go embeds into the binary
+const blobDir = "passphrase/wordlists"
 
-	// later
-	"got":    "wordlists/got.txt",
-	"potter": "wordlists/potter.txt",
-	"trek":   "wordlists/startrek.txt",
-	"wars":   "wordlists/starwars.txt",
-}
+// listDir holds the preprocessed word lists named in the manifest
+const listDir = "wordlists"
 
 const outputHeader = `// Code generated by helpers/mkwordlists.go DO NOT EDIT.
-package main
+package passphrase
 
 `
 
-func loadWordlist(filename string) (result []string) {
-	srcFile, err := os.Open(filename)
+// verifySources refuses to continue unless every downloaded source file matches its pinned digest in the manifest, or
+// its pinned words when it has no digest yet, and every preprocessed list is exactly what preprocessing that download
+// produces
+func verifySources(sources []wordsource.Source) {
+	for _, source := range sources {
+		raw, _, err := source.ReadCheckedDownload(listDir)
+		if err != nil {
+			log.Fatalf("Refusing to build the %s list. error: %s", source.Name, err)
+		}
+		want, err := wordsource.Preprocess(source.Format, raw)
+		if err != nil {
+			log.Fatalf("Unable to preprocess the %s list. error: %s", source.Name, err)
+		}
+		path := filepath.Join(listDir, source.File)
+		got, err := os.ReadFile(path)
+		if err != nil {
+			log.Fatalf("Unable to read %s. error: %s", path, err)
+		}
+		if !bytes.Equal(got, want) {
+			log.Fatalf("Refusing to build the %s list: %s doesn't match its verified download %s; rerun helpers/mkwordsources.go", source.Name, path, source.Download)
+		}
+		words, _, err := wordsource.ParseList(got)
+		if err != nil {
+			log.Fatalf("Unable to load the %s list. error: %s", source.Name, err)
+		}
+		if err := source.VerifyWords(words); err != nil {
+			log.Fatalf("Refusing to build the %s list. error: %s", source.Name, err)
+		}
+	}
+}
+
+// generated holds everything the generator writes
+type generated struct {
+	source []byte
+	// blobs maps the path of each front coded list to its contents
+	blobs map[string][]byte
+	// lists maps each list name to its words
+	lists map[string][]string
+}
+
+// blobPath returns the path of the front coded copy of the named list
+func blobPath(name string) string {
+	return filepath.Join(blobDir, name+".fc")
+}
+
+// compress front codes the words: each is written as the number of leading bytes it shares with the word before it,
+// the number of bytes which follow, and then those bytes. Since the lists are sorted, neighbouring words share a
+// lot and this shrinks them by about a third, while decoding stays much cheaper than with a general purpose compressor.
+func compress(words []string) []byte {
+	var buf bytes.Buffer
+	previous := ""
+	for _, word := range words {
+		shared := 0
+		for shared < len(previous) && shared < len(word) && shared < 255 && previous[shared] == word[shared] {
+			shared++
+		}
+		if len(word)-shared > 255 {
+			log.Fatalf("The word %q is too long to store", word)
+		}
+		buf.WriteByte(byte(shared))
+		buf.WriteByte(byte(len(word) - shared))
+		buf.WriteString(word[shared:])
+		previous = word
+	}
+	return buf.Bytes()
+}
+
+// decompress reverses compress
+func decompress(blob []byte) ([]string, error) {
+	var words []string
+	previous := ""
+	for len(blob) > 0 {
+		if len(blob) < 2 || int(blob[0]) > len(previous) || len(blob) < 2+int(blob[1]) {
+			return nil, fmt.Errorf("malformed word list after %q", previous)
+		}
+		word := previous[:blob[0]] + string(blob[2:2+blob[1]])
+		words = append(words, word)
+		blob = blob[2+blob[1]:]
+		previous = word
+	}
+	return words, nil
+}
+
+// generate returns the formatted source of the word list index and the front coded lists. The lists appear in
+// manifest order so the output only changes when the words do.
+func generate(sources []wordsource.Source) generated {
+	out := generated{blobs: make(map[string][]byte), lists: make(map[string][]string)}
+	var f bytes.Buffer
+
+	f.WriteString(outputHeader)
+	f.WriteString("// WordLists describes the word lists shipped with snakeeyes, in the order the help shows them. Their words are\n")
+	f.WriteString("// front coded in the wordlists directory.\n")
+	f.WriteString("var WordLists = []*WordList{\n")
+	for _, source := range sources {
+		log.Printf("Loading %s", source.Name)
+		words, indexed, err := wordsource.LoadList(filepath.Join(listDir, source.File))
+		if err != nil {
+			log.Fatalf("Unable to load the %s list. error: %s", source.Name, err)
+		}
+		out.lists[source.Name] = words
+		out.blobs[blobPath(source.Name)] = compress(words)
+		f.WriteString("\t{\n")
+		fmt.Fprintf(&f, "\t\tName: %q,\n", source.Name)
+		if len(source.Aliases) > 0 {
+			fmt.Fprintf(&f, "\t\tAliases: %#v,\n", source.Aliases)
+		}
+		fmt.Fprintf(&f, "\t\tDescription: %q,\n", source.Description)
+		fmt.Fprintf(&f, "\t\tURL: %q,\n", source.URL)
+		fmt.Fprintf(&f, "\t\tLicense: %q,\n", source.License)
+		fmt.Fprintf(&f, "\t\tSHA256: %q,\n", source.SHA256)
+		if source.ForkedFromEFF {
+			f.WriteString("\t\tForkedFromEFF: true,\n")
+		}
+		if indexed {
+			f.WriteString("\t\tDiceIndexed: true,\n")
+		}
+		fmt.Fprintf(&f, "\t\tsize: %d,\n", len(words))
+		fmt.Fprintf(&f, "\t\tdigest: %q,\n", wordsource.WordsDigest(words))
+		f.WriteString("\t},\n")
+	}
+	f.WriteString("}\n")
+
+	source, err := format.Source(f.Bytes())
 	if err != nil {
-		log.Fatalf("Unable to load the wordlist source file %s. error: %s", filename, err)
+		log.Fatalf("Unable to format the generated source. error: %s", err)
 	}
-	defer srcFile.Close()
+	out.source = source
+	return out
+}
 
-	scanner := bufio.NewScanner(srcFile)
-	for scanner.Scan() {
-		line := scanner.Text()
-		fields := strings.Fields(line)
-		if len(fields) != 1 {
-			// log.Printf("skipping input line: %s", line)
+// checkedInLists returns the words of each list in the checked-in front coded files. Lists which are missing or
+// can't be decoded are left out.
+func checkedInLists(names []string) map[string][]string {
+	lists := make(map[string][]string)
+	for _, name := range names {
+		blob, err := os.ReadFile(blobPath(name))
+		if err != nil {
 			continue
 		}
-		// fmt.Println(fields[1])
-		result = append(result, fields[0])
+		if words, err := decompress(blob); err == nil {
+			lists[name] = words
+		}
+	}
+	return lists
+}
+
+// describeDifferences logs how the words of each list differ between the checked-in and regenerated lists, so
+// that a reviewer can tell changed words from any other change to the files
+func describeDifferences(before, after map[string][]string) {
+	var names []string
+	for name := range before {
+		names = append(names, name)
+	}
+	for name := range after {
+		if _, ok := before[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changed := false
+	for _, name := range names {
+		oldWords, newWords := before[name], after[name]
+		oldSet, newSet := make(map[string]bool), make(map[string]bool)
+		for _, word := range oldWords {
+			oldSet[word] = true
+		}
+		for _, word := range newWords {
+			newSet[word] = true
+		}
+		added, removed := 0, 0
+		for word := range newSet {
+			if !oldSet[word] {
+				added++
+			}
+		}
+		for word := range oldSet {
+			if !newSet[word] {
+				removed++
+			}
+		}
+		switch {
+		case added > 0 || removed > 0:
+			log.Printf("The %s list has %d words added and %d removed (%d words before, %d after)", name, added, removed, len(oldWords), len(newWords))
+			changed = true
+		case strings.Join(oldWords, "\n") != strings.Join(newWords, "\n"):
+			log.Printf("The %s list has the same words in a different order", name)
+			changed = true
+		}
 	}
-	if err := scanner.Err(); err != nil {
-		log.Fatalf("error scanning %s: %s", filename, err)
+	if !changed {
+		log.Printf("Every list has the same words in the same order; only the layout of the files differs")
+	}
+}
+
+// check compares the checked-in files with freshly generated ones byte for byte, returning false if any differ. When
+// they do, the words of the front coded lists are compared too, so that the differences can be described.
+func check(out generated, names []string) bool {
+	matches := true
+	checkedIn, err := os.ReadFile(outputFilename)
+	if err != nil || !bytes.Equal(checkedIn, out.source) {
+		log.Printf("%s differs from its regenerated version", outputFilename)
+		matches = false
+	}
+	for _, name := range names {
+		path := blobPath(name)
+		if checkedIn, err := os.ReadFile(path); err != nil || !bytes.Equal(checkedIn, out.blobs[path]) {
+			log.Printf("%s differs from its regenerated version", path)
+			matches = false
+		}
 	}
 
-	return result
+	if !matches {
+		describeDifferences(checkedInLists(names), out.lists)
+	}
+	return matches
 }
 
 func main() {
-	f, err := os.Create(outputFilename)
+	checkOnly := flag.Bool("check", false, "regenerate in memory and exit with an error if "+outputFilename+" or the front coded lists differ, instead of writing them")
+	flag.Parse()
+
+	sources, err := wordsource.LoadManifest(wordsource.ManifestFile)
 	if err != nil {
-		log.Fatalf("Unable to open output file %s. error: %s", outputFilename, err)
+		log.Fatalf("Unable to load the manifest. error: %s", err)
 	}
-	defer f.Close()
+	verifySources(sources)
+	out := generate(sources)
 
-	f.WriteString(outputHeader)
-	f.WriteString("var WordLists map[string][]string = map[string][]string{\n")
-	for name, path := range sources {
-		log.Printf("Loading %s", name)
-		words := loadWordlist(path)
-		f.WriteString(fmt.Sprintf("	\"%s\": {\n", name))
-		for _, word := range words {
-			f.WriteString(fmt.Sprintf("\t\t\"%s\",\n", word))
+	if *checkOnly {
+		names := make([]string, len(sources))
+		for i, source := range sources {
+			names[i] = source.Name
 		}
-		f.WriteString("\t},\n")
+		if !check(out, names) {
+			log.Fatalf("The generated word list files do not match their sources; run go generate")
+		}
+		log.Printf("The generated word list files match their sources")
+		return
+	}
+
+	if err := os.MkdirAll(blobDir, 0755); err != nil {
+		log.Fatalf("Unable to create %s. error: %s", blobDir, err)
+	}
+	for path, blob := range out.blobs {
+		if err := os.WriteFile(path, blob, 0644); err != nil {
+			log.Fatalf("Unable to write %s. error: %s", path, err)
+		}
+	}
+	if err := os.WriteFile(outputFilename, out.source, 0644); err != nil {
+		log.Fatalf("Unable to write output file %s. error: %s", outputFilename, err)
 	}
-	f.WriteString("}\n")
 }
@@ -0,0 +1,72 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/glvnst/snakeeyes/internal/wordsource"
+)
+
+func main() {
+	var (
+		dir     = flag.String("dir", "wordlists", "the directory holding the downloaded and preprocessed word lists")
+		offline = flag.Bool("offline", false, "only use previously downloaded files, never fetch them")
+		pin     = flag.Bool("pin", false, "record the SHA-256 digest and size of downloads which have none in the manifest yet")
+	)
+	flag.Parse()
+
+	sources, err := wordsource.LoadManifest(wordsource.ManifestFile)
+	if err != nil {
+		log.Fatalf("Unable to load the manifest. error: %s", err)
+	}
+
+	pinned := false
+	for i := range sources {
+		source := &sources[i]
+		download := filepath.Join(*dir, source.Download)
+		if _, err := os.Stat(download); os.IsNotExist(err) {
+			if *offline {
+				log.Fatalf("%s has not been downloaded and -offline was given", download)
+			}
+			log.Printf("Fetching %s", source.URL)
+			if err := wordsource.Fetch(source.URL, download); err != nil {
+				log.Fatalf("Unable to download the %s list. error: %s", source.Name, err)
+			}
+		}
+
+		raw, digestPinned, err := source.ReadCheckedDownload(*dir)
+		if err != nil {
+			log.Fatalf("Refusing to use the %s list. error: %s", source.Name, err)
+		}
+		switch {
+		case !digestPinned && *pin:
+			source.Pin(raw)
+			pinned = true
+			log.Printf("Pinned %s to SHA-256 %s (%d bytes); its words match the ones snakeeyes ships", source.Download, source.SHA256, source.Size)
+		case !digestPinned:
+			log.Printf("Using %s, which has no pinned digest but has the words snakeeyes ships; pin it with -pin", download)
+		}
+
+		processed, err := wordsource.Preprocess(source.Format, raw)
+		if err != nil {
+			log.Fatalf("Unable to preprocess %s. error: %s", download, err)
+		}
+
+		output := filepath.Join(*dir, source.File)
+		log.Printf("Writing %s", output)
+		if err := os.WriteFile(output, processed, 0644); err != nil {
+			log.Fatalf("Unable to write %s. error: %s", output, err)
+		}
+	}
+
+	if pinned {
+		if err := wordsource.SaveManifest(wordsource.ManifestFile, sources); err != nil {
+			log.Fatalf("Unable to save the manifest. error: %s", err)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// Summary describes the distribution of a set of values, such as the lengths of the words in a list
+type Summary struct {
+	Count  int
+	Min    float64
+	Max    float64
+	Mean   float64
+	StdDev float64
+	// Q1, Median and Q3 are the 25th, 50th and 75th percentiles; see Percentile
+	Q1     float64
+	Median float64
+	Q3     float64
+}
+
+// Describe summarizes the values. StdDev is the population standard deviation. The summary of no values is all zeros.
+func Describe(values []float64) Summary {
+	if len(values) == 0 {
+		return Summary{}
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	s := Summary{Count: len(sorted), Min: sorted[0], Max: sorted[len(sorted)-1]}
+	for _, v := range sorted {
+		s.Mean += v
+	}
+	s.Mean /= float64(len(sorted))
+	for _, v := range sorted {
+		s.StdDev += (v - s.Mean) * (v - s.Mean)
+	}
+	s.StdDev = math.Sqrt(s.StdDev / float64(len(sorted)))
+	s.Q1 = Percentile(sorted, 25)
+	s.Median = Percentile(sorted, 50)
+	s.Q3 = Percentile(sorted, 75)
+	return s
+}
+
+// Percentile returns the pth percentile of the sorted values, interpolating linearly between the two values nearest
+// to it as numpy's percentile function does by default
+func Percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	below := math.Floor(rank)
+	i := int(below)
+	if i+1 >= len(sorted) {
+		return sorted[len(sorted)-1]
+	}
+	return sorted[i] + (rank-below)*(sorted[i+1]-sorted[i])
+}
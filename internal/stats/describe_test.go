@@ -0,0 +1,26 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	// numpy.percentile and numpy.std give the same for these values
+	s := Describe([]float64{7, 3, 9, 5, 5, 4})
+	want := Summary{Count: 6, Min: 3, Max: 9, Mean: 5.5, StdDev: math.Sqrt(23.5 / 6), Q1: 4.25, Median: 5, Q3: 6.5}
+	if math.Abs(s.StdDev-want.StdDev) > 1e-12 {
+		t.Errorf("unexpected standard deviation. want: %f, got: %f", want.StdDev, s.StdDev)
+	}
+	s.StdDev = want.StdDev
+	if s != want {
+		t.Errorf("unexpected summary. want: %+v, got: %+v", want, s)
+	}
+
+	if s := Describe([]float64{2}); s != (Summary{Count: 1, Min: 2, Max: 2, Mean: 2, Q1: 2, Median: 2, Q3: 2}) {
+		t.Errorf("unexpected summary of one value: %+v", s)
+	}
+	if s := Describe(nil); s != (Summary{}) {
+		t.Errorf("expected an empty summary of no values, got: %+v", s)
+	}
+}
@@ -0,0 +1,110 @@
+package stats
+
+import "math"
+
+// HealthTests runs the two continuous health tests of NIST SP 800-90B section 4.4 over a stream of byte samples:
+// the repetition count test, which looks for a value repeated many times in a row, and the adaptive proportion test,
+// which looks for a value making up too much of a window of samples. They're designed to catch a source of
+// randomness which has failed outright, not subtle bias. Write the samples to it, then read the results.
+type HealthTests struct {
+	// MinEntropy is the min-entropy per sample, in bits, which the cutoffs assume. Alpha is the false positive
+	// probability per sample the cutoffs allow, as a power of two: 40 means 2^-40.
+	MinEntropy float64
+	Alpha      int
+
+	// RepetitionCutoff is the length of a run of one value which fails the repetition count test, and LongestRun
+	// the longest run seen
+	RepetitionCutoff   int
+	LongestRun         int
+	RepetitionFailures int
+
+	// ProportionWindow is the number of samples in each window of the adaptive proportion test, and
+	// ProportionCutoff the number of times its first sample must occur in it to fail. MostFrequent is the most times
+	// a window's first sample occurred in it.
+	ProportionWindow   int
+	ProportionCutoff   int
+	MostFrequent       int
+	ProportionFailures int
+
+	// Samples is the number of bytes tested
+	Samples int
+
+	last        byte
+	run         int
+	windowFirst byte
+	windowSeen  int
+	windowCount int
+}
+
+// NewHealthTests returns health tests for samples with the given min-entropy, with cutoffs allowing a false positive
+// probability of 2^-alpha per sample. SP 800-90B recommends an alpha between 20 and 40.
+func NewHealthTests(minEntropy float64, alpha int) *HealthTests {
+	const window = 512 // SP 800-90B's window for samples which aren't single bits
+	return &HealthTests{
+		MinEntropy:       minEntropy,
+		Alpha:            alpha,
+		RepetitionCutoff: 1 + int(math.Ceil(float64(alpha)/minEntropy)),
+		ProportionWindow: window,
+		ProportionCutoff: 1 + criticalBinomial(window, math.Exp2(-minEntropy), math.Exp2(-float64(alpha))),
+	}
+}
+
+// Write feeds samples to the tests. It never fails.
+func (h *HealthTests) Write(p []byte) (int, error) {
+	for _, sample := range p {
+		if h.Samples > 0 && sample == h.last {
+			h.run++
+		} else {
+			h.last, h.run = sample, 1
+		}
+		if h.run > h.LongestRun {
+			h.LongestRun = h.run
+		}
+		if h.run == h.RepetitionCutoff {
+			h.RepetitionFailures++
+		}
+
+		if h.windowSeen == 0 {
+			h.windowFirst, h.windowCount = sample, 0
+		}
+		if sample == h.windowFirst {
+			h.windowCount++
+			if h.windowCount > h.MostFrequent {
+				h.MostFrequent = h.windowCount
+			}
+			if h.windowCount == h.ProportionCutoff {
+				h.ProportionFailures++
+			}
+		}
+		if h.windowSeen++; h.windowSeen == h.ProportionWindow {
+			h.windowSeen = 0
+		}
+		h.Samples++
+	}
+	return len(p), nil
+}
+
+// Passed reports whether neither test has failed
+func (h *HealthTests) Passed() bool {
+	return h.RepetitionFailures == 0 && h.ProportionFailures == 0
+}
+
+// criticalBinomial returns the smallest k for which a binomially distributed variable with n trials of probability
+// p exceeds k with probability at most alpha (Excel's CRITBINOM(n, p, 1 - alpha), as SP 800-90B puts it)
+func criticalBinomial(n int, p, alpha float64) int {
+	lnFactorialN, _ := math.Lgamma(float64(n) + 1)
+	pmf := func(j int) float64 {
+		lnJ, _ := math.Lgamma(float64(j) + 1)
+		lnRest, _ := math.Lgamma(float64(n-j) + 1)
+		return math.Exp(lnFactorialN - lnJ - lnRest + float64(j)*math.Log(p) + float64(n-j)*math.Log1p(-p))
+	}
+	// add up the upper tail until it's too heavy
+	tail := 0.0
+	for k := n - 1; k >= 0; k-- {
+		tail += pmf(k + 1)
+		if tail > alpha {
+			return k + 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,54 @@
+package stats
+
+import (
+	"bytes"
+	mathrand "math/rand"
+	"testing"
+)
+
+func TestHealthTestCutoffs(t *testing.T) {
+	// SP 800-90B table 2 gives the adaptive proportion cutoffs for a window of 512 and alpha = 2^-20
+	tests := []struct {
+		minEntropy             float64
+		repetition, proportion int
+	}{
+		{0.5, 41, 410},
+		{1, 21, 311},
+		{2, 11, 177},
+		{4, 6, 62},
+		{8, 4, 13},
+	}
+	for _, test := range tests {
+		h := NewHealthTests(test.minEntropy, 20)
+		if h.RepetitionCutoff != test.repetition || h.ProportionCutoff != test.proportion {
+			t.Errorf("cutoffs for min-entropy %g want: %d and %d, got: %d and %d",
+				test.minEntropy, test.repetition, test.proportion, h.RepetitionCutoff, h.ProportionCutoff)
+		}
+	}
+}
+
+func TestHealthTests(t *testing.T) {
+	random := make([]byte, 1<<20)
+	mathrand.New(mathrand.NewSource(1)).Read(random)
+	h := NewHealthTests(8, 40)
+	h.Write(random)
+	if !h.Passed() || h.Samples != len(random) {
+		t.Errorf("expected random bytes to pass, got: %+v", h)
+	}
+
+	// a stuck source fails both tests
+	h = NewHealthTests(8, 40)
+	h.Write(bytes.Repeat([]byte{0x42}, 1024))
+	if h.RepetitionFailures != 1 || h.ProportionFailures != 2 || h.LongestRun != 1024 || h.MostFrequent != 512 {
+		t.Errorf("expected a stuck source to fail each window, got: %+v", h)
+	}
+
+	// a source which favours one value without repeating it fails only the adaptive proportion test
+	h = NewHealthTests(8, 40)
+	for i := 0; i < 512; i++ {
+		h.Write([]byte{0, byte(i%255 + 1)})
+	}
+	if h.RepetitionFailures != 0 || h.ProportionFailures == 0 || h.LongestRun != 1 {
+		t.Errorf("expected only the adaptive proportion test to fail, got: %+v", h)
+	}
+}
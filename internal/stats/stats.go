@@ -0,0 +1,132 @@
+// Package stats has the statistics snakeeyes uses to check its randomness: Pearson's chi-squared tests and their
+// p-values, to show that words are chosen uniformly, and the continuous health tests of NIST SP 800-90B, to show
+// that the operating system's random number generator hasn't failed. It also summarizes distributions, such as the
+// lengths of the words in a list.
+package stats
+
+import "math"
+
+// ChiSquaredUniform returns Pearson's chi-squared statistic for the hypothesis that counts were drawn uniformly from
+// len(counts) categories, and its degrees of freedom
+func ChiSquaredUniform(counts []int) (statistic float64, df int) {
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+	if len(counts) < 2 || total == 0 {
+		return 0, 0
+	}
+	expected := float64(total) / float64(len(counts))
+	for _, count := range counts {
+		d := float64(count) - expected
+		statistic += d * d / expected
+	}
+	return statistic, len(counts) - 1
+}
+
+// ChiSquaredIndependence returns Pearson's chi-squared statistic for the hypothesis that the row and column of each
+// observation in the contingency table are independent, and its degrees of freedom. Rows and columns which are
+// entirely empty are left out.
+func ChiSquaredIndependence(table [][]int) (statistic float64, df int) {
+	if len(table) == 0 {
+		return 0, 0
+	}
+	rowTotals := make([]float64, len(table))
+	colTotals := make([]float64, len(table[0]))
+	total := 0.0
+	for i, row := range table {
+		for j, count := range row {
+			rowTotals[i] += float64(count)
+			colTotals[j] += float64(count)
+			total += float64(count)
+		}
+	}
+	rows, cols := 0, 0
+	for _, t := range rowTotals {
+		if t > 0 {
+			rows++
+		}
+	}
+	for _, t := range colTotals {
+		if t > 0 {
+			cols++
+		}
+	}
+	if rows < 2 || cols < 2 {
+		return 0, 0
+	}
+
+	for i, row := range table {
+		for j, count := range row {
+			if rowTotals[i] == 0 || colTotals[j] == 0 {
+				continue
+			}
+			expected := rowTotals[i] * colTotals[j] / total
+			d := float64(count) - expected
+			statistic += d * d / expected
+		}
+	}
+	return statistic, (rows - 1) * (cols - 1)
+}
+
+// ChiSquaredPValue returns the probability that a chi-squared distributed variable with df degrees of freedom is at
+// least statistic: the chance that data drawn from the hypothesised distribution fits it at least this badly
+func ChiSquaredPValue(statistic float64, df int) float64 {
+	if df < 1 {
+		return 1
+	}
+	if statistic <= 0 {
+		return 1
+	}
+	return upperRegularizedGamma(float64(df)/2, statistic/2)
+}
+
+// upperRegularizedGamma returns Q(a, x) = Γ(a, x) / Γ(a), using the series for P(a, x) when x < a+1 and the
+// continued fraction for Q(a, x) otherwise, as in Numerical Recipes §6.2. Both converge quickly on their side.
+func upperRegularizedGamma(a, x float64) float64 {
+	const (
+		epsilon  = 1e-15
+		maxSteps = 100000
+		tiny     = 1e-300
+	)
+	lgamma, _ := math.Lgamma(a)
+	// x^a e^-x / Γ(a), the factor both expansions share
+	prefix := math.Exp(a*math.Log(x) - x - lgamma)
+
+	if x < a+1 {
+		term, sum := 1/a, 1/a
+		for n := 1; n < maxSteps; n++ {
+			term *= x / (a + float64(n))
+			sum += term
+			if math.Abs(term) < math.Abs(sum)*epsilon {
+				break
+			}
+		}
+		return math.Max(0, 1-sum*prefix)
+	}
+
+	// the modified Lentz method
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for n := 1; n < maxSteps; n++ {
+		an := -float64(n) * (float64(n) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < epsilon {
+			break
+		}
+	}
+	return prefix * h
+}
@@ -0,0 +1,64 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestChiSquaredPValue(t *testing.T) {
+	tests := []struct {
+		statistic float64
+		df        int
+		want      float64
+	}{
+		// textbook critical values
+		{3.841459, 1, 0.05},
+		{6.634897, 1, 0.01},
+		{18.307038, 10, 0.05},
+		{29.588298, 10, 0.001},
+		{124.342113, 100, 0.05},
+		// with 2 degrees of freedom the tail is exactly exp(-x/2)
+		{10, 2, math.Exp(-5)},
+		{0.5, 2, math.Exp(-0.25)},
+		// the median of a chi-squared distribution with many degrees of freedom is close to df(1 - 2/9df)^3
+		{7775 * math.Pow(1-2/(9*7775.0), 3), 7775, 0.5},
+		{0, 5, 1},
+	}
+	for _, test := range tests {
+		got := ChiSquaredPValue(test.statistic, test.df)
+		if math.Abs(got-test.want) > 1e-4*math.Max(test.want, 0.01) {
+			t.Errorf("ChiSquaredPValue(%g, %d) want: %g, got: %g", test.statistic, test.df, test.want, got)
+		}
+	}
+}
+
+func TestChiSquaredUniform(t *testing.T) {
+	statistic, df := ChiSquaredUniform([]int{10, 10, 10, 10})
+	if statistic != 0 || df != 3 {
+		t.Errorf("expected a perfect fit with 3 degrees of freedom, got: %g, %d", statistic, df)
+	}
+	// expected 10 each: (5^2 + 5^2) / 10
+	statistic, df = ChiSquaredUniform([]int{5, 15, 10, 10})
+	if statistic != 5 || df != 3 {
+		t.Errorf("want: 5 with 3 degrees of freedom, got: %g, %d", statistic, df)
+	}
+	if _, df := ChiSquaredUniform([]int{7}); df != 0 {
+		t.Errorf("expected no degrees of freedom for one category, got: %d", df)
+	}
+}
+
+func TestChiSquaredIndependence(t *testing.T) {
+	statistic, df := ChiSquaredIndependence([][]int{{10, 20}, {30, 60}})
+	if math.Abs(statistic) > 1e-12 || df != 1 {
+		t.Errorf("expected proportional rows to be independent with 1 degree of freedom, got: %g, %d", statistic, df)
+	}
+	// perfectly dependent: every total is 50 and every expected count 25
+	statistic, df = ChiSquaredIndependence([][]int{{50, 0}, {0, 50}})
+	if statistic != 100 || df != 1 {
+		t.Errorf("want: 100 with 1 degree of freedom, got: %g, %d", statistic, df)
+	}
+	// an empty column is left out
+	if _, df := ChiSquaredIndependence([][]int{{1, 0, 2}, {3, 0, 4}}); df != 1 {
+		t.Errorf("expected 1 degree of freedom without the empty column, got: %d", df)
+	}
+}
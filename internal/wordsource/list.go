@@ -0,0 +1,123 @@
+package wordsource
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// Entry is one line of a word list, split into its dice code, if it has one, and its word
+type Entry struct {
+	// Line is the line number, counting from 1
+	Line int
+	// Text is the whole line, without its line ending
+	Text string
+	// Code is the dice code before the word, or "" on a plain line
+	Code string
+	// Word is the word after the dice code, or else the line without surrounding whitespace; it's "" on a blank line
+	// and holds every word of a plain line with more than one
+	Word string
+	// Err says why the entry's dice code, or its lack of one, doesn't follow the entries before it, or is nil
+	Err error
+}
+
+// IsWord reports whether the entry is one of the list's words: a dice code and a word, or a plain line of a single
+// word. Blank lines and plain lines of several words aren't, and don't count towards the positions dice codes name.
+func (e Entry) IsWord() bool {
+	return e.Code != "" || (e.Word != "" && strings.IndexFunc(e.Word, unicode.IsSpace) < 0)
+}
+
+// ScanList reads a word list which has either one word per line or, as in EFF's original lists, a dice code and a
+// word per line, calling fn with each of its lines in order. It checks that dice codes all have the same length and
+// number the words in order, and that the list doesn't mix dice-indexed and plain lines, reporting any problem in the
+// entry's Err rather than stopping. ScanList stops at the first error fn returns and returns it.
+func ScanList(r io.Reader, fn func(Entry) error) error {
+	var (
+		words       int
+		diceIndexed bool
+		codeLen     int
+	)
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		text := strings.TrimSuffix(scanner.Text(), "\r")
+		e := Entry{Line: lineNumber, Text: text, Word: strings.TrimSpace(text)}
+		position := -1
+		if fields := strings.Fields(text); len(fields) == 2 {
+			if p, ok := diceCodePosition(fields[0]); ok {
+				e.Code, e.Word, position = fields[0], fields[1], p
+			}
+		}
+
+		if e.IsWord() {
+			switch {
+			case e.Code != "" && words == 0:
+				diceIndexed, codeLen = true, len(e.Code)
+				if position != 0 {
+					e.Err = fmt.Errorf("dice code %s is out of sequence", e.Code)
+				}
+			case e.Code != "" && !diceIndexed:
+				e.Err = fmt.Errorf("dice code %s on a list which started without them", e.Code)
+			case e.Code == "" && diceIndexed:
+				e.Err = fmt.Errorf("missing dice code")
+			case e.Code != "" && (len(e.Code) != codeLen || position != words):
+				e.Err = fmt.Errorf("dice code %s is out of sequence", e.Code)
+			}
+			words++
+		}
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// ParseList reads a preprocessed word list with ScanList and reports whether the list was dice-indexed. Blank lines
+// and plain lines of several words are skipped, but the first problem with the dice codes is returned as an error. The
+// dice codes aren't returned because they follow from each word's position in the list.
+func ParseList(data []byte) (words []string, diceIndexed bool, err error) {
+	err = ScanList(bytes.NewReader(data), func(e Entry) error {
+		if !e.IsWord() {
+			return nil
+		}
+		if e.Err != nil {
+			return fmt.Errorf("line %d: %w: %s", e.Line, e.Err, e.Text)
+		}
+		if len(words) == 0 {
+			diceIndexed = e.Code != ""
+		}
+		words = append(words, e.Word)
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return words, diceIndexed, nil
+}
+
+// LoadList reads the preprocessed word list in the named file; see ParseList
+func LoadList(filename string) (words []string, diceIndexed bool, err error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, false, err
+	}
+	words, diceIndexed, err = ParseList(data)
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: %w", filename, err)
+	}
+	return words, diceIndexed, nil
+}
+
+// diceCodePosition returns the list position named by a dice code such as 11111 (the first word) or 66666
+func diceCodePosition(code string) (position int, ok bool) {
+	for _, r := range code {
+		if r < '1' || r > '6' {
+			return 0, false
+		}
+		position = position*6 + int(r-'1')
+	}
+	return position, true
+}
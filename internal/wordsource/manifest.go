@@ -0,0 +1,139 @@
+package wordsource
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ManifestFile is the manifest's path relative to the root of the repository, where go generate runs the helpers
+const ManifestFile = "wordlists/sources.json"
+
+var (
+	// ErrUnpinned is returned when verifying a source whose manifest entry has no SHA-256 digest
+	ErrUnpinned = errors.New("no SHA-256 digest has been pinned for this source")
+	// ErrMismatch is returned when a source file doesn't have the size or SHA-256 digest recorded in the manifest
+	ErrMismatch = errors.New("the source file doesn't match the manifest")
+)
+
+// manifest is the layout of the manifest file
+type manifest struct {
+	Sources []Source `json:"sources"`
+}
+
+// LoadManifest reads the list of sources from the named manifest file
+func LoadManifest(filename string) ([]Source, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var m manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return m.Sources, nil
+}
+
+// SaveManifest writes the list of sources to the named manifest file
+func SaveManifest(filename string, sources []Source) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(manifest{Sources: sources}); err != nil {
+		return err
+	}
+	return os.WriteFile(filename, buf.Bytes(), 0644)
+}
+
+// Digest returns the hex encoded SHA-256 digest of data
+func Digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// WordsDigest returns the hex encoded SHA-256 digest of the words, each followed by a newline. passphrase/wordlists.go
+// records the same digest of each built in list.
+func WordsDigest(words []string) string {
+	digest := sha256.New()
+	for _, word := range words {
+		digest.Write([]byte(word + "\n"))
+	}
+	return hex.EncodeToString(digest.Sum(nil))
+}
+
+// Verify returns nil if data has the size and SHA-256 digest pinned for the source, or else ErrUnpinned or
+// ErrMismatch
+func (s Source) Verify(data []byte) error {
+	if s.SHA256 == "" {
+		return fmt.Errorf("%s: %w", s.Download, ErrUnpinned)
+	}
+	if int64(len(data)) != s.Size {
+		return fmt.Errorf("%s: %w: expected %d bytes, got %d", s.Download, ErrMismatch, s.Size, len(data))
+	}
+	if digest := Digest(data); digest != s.SHA256 {
+		return fmt.Errorf("%s: %w: expected SHA-256 %s, got %s", s.Download, ErrMismatch, s.SHA256, digest)
+	}
+	return nil
+}
+
+// VerifyWords returns nil if the words of the preprocessed list have the digest pinned for the source, or else
+// ErrUnpinned or ErrMismatch
+func (s Source) VerifyWords(words []string) error {
+	if s.WordsSHA256 == "" {
+		return fmt.Errorf("%s: %w", s.File, ErrUnpinned)
+	}
+	if digest := WordsDigest(words); digest != s.WordsSHA256 {
+		return fmt.Errorf("%s: %w: expected the words to have SHA-256 %s, got %s", s.File, ErrMismatch, s.WordsSHA256, digest)
+	}
+	return nil
+}
+
+// ReadDownload reads the source's downloaded file from the given directory and verifies it against the manifest
+func (s Source) ReadDownload(dir string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(dir, s.Download))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Verify(data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ReadCheckedDownload reads the source's downloaded file from the given directory. A download with a digest pinned in
+// the manifest must match it. One without a digest is only returned if preprocessing it gives exactly the words pinned
+// for the list, which are the words snakeeyes already ships, so that nothing is trusted on first use. It reports
+// whether the download's own digest was pinned.
+func (s Source) ReadCheckedDownload(dir string) (data []byte, pinned bool, err error) {
+	data, err = s.ReadDownload(dir)
+	if !errors.Is(err, ErrUnpinned) {
+		return data, err == nil, err
+	}
+	if data, err = os.ReadFile(filepath.Join(dir, s.Download)); err != nil {
+		return nil, false, err
+	}
+	processed, err := Preprocess(s.Format, data)
+	if err != nil {
+		return nil, false, err
+	}
+	words, _, err := ParseList(processed)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := s.VerifyWords(words); err != nil {
+		return nil, false, fmt.Errorf("%s has no pinned digest and its words differ from the ones snakeeyes ships. %w", s.Download, err)
+	}
+	return data, false, nil
+}
+
+// Pin records the size and SHA-256 digest of data as the expected content of the source
+func (s *Source) Pin(data []byte) {
+	s.SHA256 = Digest(data)
+	s.Size = int64(len(data))
+}
@@ -0,0 +1,58 @@
+package wordsource
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// fandomEntry matches a fandom list line: three d20 rolls and then the word
+	fandomEntry = regexp.MustCompile(`^\d+-\d+-\d+\s+(.+?)\s*$`)
+	// notAllowed matches the characters which disqualify a fandom word; entering accented characters like the é
+	// in "café" into password prompts has proven difficult to do reliably
+	notAllowed = regexp.MustCompile(`[^a-zA-Z0-9\s\-]`)
+)
+
+// Preprocess converts the contents of a downloaded EFF source file into a list helpers/mkwordlists.go can read.
+// EFFDice files keep their dice codes, which helpers/mkwordlists.go checks, and only lose their carriage returns.
+// Fandom files are decoded from MacRoman, their d20 rolls are dropped along with any entry containing a character
+// other than an ASCII letter, digit, hyphen or space, and the words are sorted and deduplicated.
+func Preprocess(format Format, raw []byte) ([]byte, error) {
+	switch format {
+	case EFFDice:
+		return bytes.ReplaceAll(raw, []byte("\r"), nil), nil
+	case Fandom:
+		return preprocessFandom(raw), nil
+	default:
+		return nil, fmt.Errorf("unknown source format %d", format)
+	}
+}
+
+func preprocessFandom(raw []byte) []byte {
+	text := DecodeMacRoman(bytes.ReplaceAll(raw, []byte("\r"), []byte("\n")))
+
+	seen := make(map[string]bool)
+	var words []string
+	for _, line := range strings.Split(text, "\n") {
+		if notAllowed.MatchString(line) {
+			continue
+		}
+		match := fandomEntry.FindStringSubmatch(line)
+		if match == nil || seen[match[1]] {
+			continue
+		}
+		seen[match[1]] = true
+		words = append(words, match[1])
+	}
+	sort.Strings(words)
+
+	var out bytes.Buffer
+	for _, word := range words {
+		out.WriteString(word)
+		out.WriteByte('\n')
+	}
+	return out.Bytes()
+}
@@ -0,0 +1,74 @@
+// Package wordsource downloads EFF's word list files and preprocesses them into the lists helpers/mkwordlists.go
+// builds into snakeeyes, and reads those lists back for the helpers and for passphrase.ReadWordList. It replaces a
+// Makefile pipeline of curl, iconv, perl and sort.
+package wordsource
+
+import "fmt"
+
+// Format identifies how an EFF source file is laid out
+type Format int
+
+const (
+	// EFFDice files have a dice code and a word on each line, like "11111	abacus"
+	EFFDice Format = iota
+	// Fandom files are EFF's FANDOM Wikia-based lists: MacRoman text with classic Mac line endings and three d20 rolls
+	// and a word on each line, like "1-1-1	abandon"
+	Fandom
+)
+
+var formatNames = map[Format]string{
+	EFFDice: "eff-dice",
+	Fandom:  "fandom",
+}
+
+func (f Format) String() string {
+	if name, ok := formatNames[f]; ok {
+		return name
+	}
+	return fmt.Sprintf("Format(%d)", int(f))
+}
+
+// MarshalText encodes the format by name in the manifest
+func (f Format) MarshalText() ([]byte, error) {
+	name, ok := formatNames[f]
+	if !ok {
+		return nil, fmt.Errorf("unknown source format %d", int(f))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText decodes a format name from the manifest
+func (f *Format) UnmarshalText(text []byte) error {
+	for format, name := range formatNames {
+		if name == string(text) {
+			*f = format
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown source format %q", text)
+}
+
+// Source describes one of the upstream word list files, as recorded in the manifest
+type Source struct {
+	// Name is the list name users choose with -list, and Aliases are other names -list accepts for it
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases,omitempty"`
+	// Description is the list's entry in the help's table of lists
+	Description string `json:"description"`
+	// ForkedFromEFF marks lists which started as one of EFF's lists but were filtered further by snakeeyes
+	ForkedFromEFF bool `json:"forked_from_eff,omitempty"`
+	// File is the name of the preprocessed list in the word list directory
+	File string `json:"file"`
+	// Download is the name of the file as downloaded from URL
+	Download string `json:"download"`
+	URL      string `json:"url"`
+	Format   Format `json:"format"`
+	License  string `json:"license"`
+	// SHA256 and Size pin the exact content expected from URL. An empty SHA256 means the source hasn't been pinned
+	// yet and can't be used.
+	SHA256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+	// WordsSHA256 pins the words of the preprocessed list, as shipped in snakeeyes, so that a download can be checked
+	// against them before its own digest is pinned
+	WordsSHA256 string `json:"words_sha256"`
+}
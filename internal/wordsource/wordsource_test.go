@@ -0,0 +1,201 @@
+package wordsource
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestPreprocess(t *testing.T) {
+	tests := []struct {
+		format Format
+		input  string
+		golden string
+	}{
+		{EFFDice, "eff_sample.txt", "eff_sample.golden"},
+		{Fandom, "fandom_sample.txt", "fandom_sample.golden"},
+	}
+	for _, test := range tests {
+		raw, err := os.ReadFile(filepath.Join("testdata", test.input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := os.ReadFile(filepath.Join("testdata", test.golden))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := Preprocess(test.format, raw)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.input, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s: preprocessed output doesn't match %s.\nwant:\n%s\ngot:\n%s", test.input, test.golden, want, got)
+		}
+	}
+}
+
+func TestDecodeMacRoman(t *testing.T) {
+	if got := DecodeMacRoman([]byte("caf\x8e na\x95ve \xd2quoted\xd3")); got != "café naïve “quoted”" {
+		t.Errorf("unexpected decoding: %q", got)
+	}
+}
+
+func TestParseList(t *testing.T) {
+	tests := []struct {
+		input       string
+		words       []string
+		diceIndexed bool
+	}{
+		{"11111\tabacus\n11112\tabdomen\n", []string{"abacus", "abdomen"}, true},
+		{"Jedi\nahch-to\n\nbantha\n", []string{"Jedi", "ahch-to", "bantha"}, false},
+		// two word entries are skipped
+		{"one\ntwo words\nthree\n", []string{"one", "three"}, false},
+	}
+	for _, test := range tests {
+		words, diceIndexed, err := ParseList([]byte(test.input))
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", test.input, err)
+			continue
+		}
+		if !reflect.DeepEqual(words, test.words) || diceIndexed != test.diceIndexed {
+			t.Errorf("%q: want: %q (dice-indexed %t), got: %q (dice-indexed %t)", test.input, test.words, test.diceIndexed, words, diceIndexed)
+		}
+	}
+
+	for _, input := range []string{"11111\tabacus\n11113\tabdominal\n", "abacus\n11112\tabdomen\n", "11111\tabacus\nabdomen\n", "11\tabacus\n112\tabdomen\n"} {
+		if _, _, err := ParseList([]byte(input)); err == nil {
+			t.Errorf("%q: expected an error", input)
+		}
+	}
+}
+
+func TestManifest(t *testing.T) {
+	sources, err := LoadManifest(filepath.Join("..", "..", ManifestFile))
+	if err != nil {
+		t.Fatalf("unable to load the manifest: %s", err)
+	}
+	if len(sources) != 7 {
+		t.Errorf("expected 7 sources in the manifest, got: %d", len(sources))
+	}
+
+	seen := make(map[string]bool)
+	for _, source := range sources {
+		for _, name := range []string{source.Name, source.File, source.Download} {
+			if seen[name] {
+				t.Errorf("%s is used by more than one source", name)
+			}
+			seen[name] = true
+		}
+		if filepath.Base(source.URL) != source.Download {
+			t.Errorf("%s: the download name %s doesn't match the URL %s", source.Name, source.Download, source.URL)
+		}
+		if source.License == "" {
+			t.Errorf("%s: no license recorded", source.Name)
+		}
+		if source.SHA256 != "" && len(source.SHA256) != 64 {
+			t.Errorf("%s: malformed SHA-256 digest %s", source.Name, source.SHA256)
+		}
+		if len(source.WordsSHA256) != 64 {
+			t.Errorf("%s: missing or malformed SHA-256 digest of the words %q", source.Name, source.WordsSHA256)
+		}
+	}
+
+	// saving and loading again must not lose anything
+	saved := filepath.Join(t.TempDir(), "sources.json")
+	if err := SaveManifest(saved, sources); err != nil {
+		t.Fatalf("unable to save the manifest: %s", err)
+	}
+	reloaded, err := LoadManifest(saved)
+	if err != nil {
+		t.Fatalf("unable to reload the manifest: %s", err)
+	}
+	if !reflect.DeepEqual(reloaded, sources) {
+		t.Errorf("the manifest changed when saved and reloaded.\nwant: %+v\ngot: %+v", sources, reloaded)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "eff_sample.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source := Source{Name: "sample", Download: "eff_sample.txt", Format: EFFDice}
+	if _, err := source.ReadDownload("testdata"); !errors.Is(err, ErrUnpinned) {
+		t.Errorf("expected ErrUnpinned before pinning, got: %v", err)
+	}
+
+	source.Pin(data)
+	if got, err := source.ReadDownload("testdata"); err != nil || !bytes.Equal(got, data) {
+		t.Errorf("expected the pinned file to verify, got error: %v", err)
+	}
+
+	tampered := bytes.Replace(data, []byte("abacus"), []byte("abacas"), 1)
+	if err := source.Verify(tampered); !errors.Is(err, ErrMismatch) {
+		t.Errorf("expected ErrMismatch for a changed file, got: %v", err)
+	}
+	if err := source.Verify(append(data, '\n')); !errors.Is(err, ErrMismatch) {
+		t.Errorf("expected ErrMismatch for a longer file, got: %v", err)
+	}
+
+	words, _, err := ParseList(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := source.VerifyWords(words); !errors.Is(err, ErrUnpinned) {
+		t.Errorf("expected ErrUnpinned before the words are pinned, got: %v", err)
+	}
+	source.WordsSHA256 = WordsDigest(words)
+	if err := source.VerifyWords(words); err != nil {
+		t.Errorf("expected the pinned words to verify, got error: %v", err)
+	}
+	if err := source.VerifyWords(words[1:]); !errors.Is(err, ErrMismatch) {
+		t.Errorf("expected ErrMismatch for a shortened list, got: %v", err)
+	}
+
+	// a download without a digest is checked by its words instead
+	if got, pinned, err := source.ReadCheckedDownload("testdata"); err != nil || !pinned || !bytes.Equal(got, data) {
+		t.Errorf("expected the pinned file to be read, got pinned %t, error: %v", pinned, err)
+	}
+	source.SHA256, source.Size = "", 0
+	if got, pinned, err := source.ReadCheckedDownload("testdata"); err != nil || pinned || !bytes.Equal(got, data) {
+		t.Errorf("expected the file to be read by its words, got pinned %t, error: %v", pinned, err)
+	}
+	source.WordsSHA256 = WordsDigest(words[1:])
+	if _, _, err := source.ReadCheckedDownload("testdata"); !errors.Is(err, ErrMismatch) {
+		t.Errorf("expected ErrMismatch for a download with other words, got: %v", err)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/list.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("11111\tabacus\n"))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "list.txt")
+	if err := Fetch(server.URL+"/list.txt", filename); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if data, _ := os.ReadFile(filename); string(data) != "11111\tabacus\n" {
+		t.Errorf("unexpected downloaded content: %q", data)
+	}
+
+	missing := filepath.Join(dir, "missing.txt")
+	if err := Fetch(server.URL+"/missing.txt", missing); err == nil {
+		t.Errorf("expected an error for a missing file")
+	}
+	if _, err := os.Stat(missing); !os.IsNotExist(err) {
+		t.Errorf("a failed download must not leave a file behind")
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/glvnst/snakeeyes/passphrase"
+)
+
+// listFiles collects the paths given with each use of the repeatable -list-file option
+type listFiles []string
+
+func (l *listFiles) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFiles) Set(path string) error {
+	*l = append(*l, path)
+	return nil
+}
+
+// listOptions holds the -list and -list-file options which every command working with word lists shares
+type listOptions struct {
+	flags *flag.FlagSet
+	// name is the list chosen with -list
+	name  *string
+	files listFiles
+	// every is set for commands whose -list is empty by default, meaning every list
+	every bool
+}
+
+// addListFlags adds -list, with the given default and description, and -list-file to flags. An empty default makes
+// the command work with every list unless -list names one; otherwise it works with a single list.
+func addListFlags(flags *flag.FlagSet, defaultName, usage string) *listOptions {
+	o := &listOptions{flags: flags, every: defaultName == ""}
+	o.name = flags.String("list", defaultName, usage)
+	flags.Var(&o.files, "list-file", "load a word list from the given file, named after the file without its extension (may be repeated)")
+	return o
+}
+
+// load loads the lists given with -list-file, exiting if one can't be loaded. A command which works with a single
+// list uses the list from the file when just one file is given without -list.
+func (o *listOptions) load() wordLists {
+	lists, err := loadLists(o.files)
+	if err != nil {
+		dieWith(exitBadList, "Unable to load a word list. %s\n", err)
+	}
+	if len(o.files) == 1 && !o.every && !flagWasSet(o.flags, "list") {
+		*o.name = listFileName(o.files[0])
+	}
+	return lists
+}
+
+// chosen returns the names of the lists chosen with -list: the one it names, or every list when it's empty and the
+// command works with every list by default. It exits with a usage error if there is no such list.
+func (o *listOptions) chosen(lists wordLists) []string {
+	if *o.name == "" && o.every {
+		return lists.names()
+	}
+	if _, ok := lists.lookup(*o.name); !ok {
+		dieWith(exitUsage, "No such list \"%s\".\n", *o.name)
+	}
+	return []string{*o.name}
+}
+
+// listFileName returns the name a word list loaded from the given path is selected by: its file name without the
+// extension, so "/etc/company-words.txt" becomes "company-words"
+func listFileName(path string) string {
+	base := filepath.Base(path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+// wordLists holds the word lists loaded with -list-file. The built-in lists are looked up through it too, but are
+// only decoded when they are actually used.
+type wordLists map[string][]string
+
+// loadLists loads the word lists in the given files
+func loadLists(paths []string) (wordLists, error) {
+	lists := make(wordLists, len(paths))
+	for _, path := range paths {
+		name := listFileName(path)
+		if _, exists := lists[name]; exists || isBuiltIn(name) {
+			return nil, fmt.Errorf("%s: there is already a list named \"%s\"", path, name)
+		}
+		words, err := passphrase.LoadWordList(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		lists[name] = words
+	}
+	return lists, nil
+}
+
+// lookup returns the words of the named list, whether it was loaded from a file or built in
+func (l wordLists) lookup(name string) ([]string, bool) {
+	if words, ok := l[name]; ok {
+		return words, true
+	}
+	return passphrase.Lookup(name)
+}
+
+// names returns the names of every list: the built-in lists in the order snakeeyes ships them, followed by the lists
+// loaded from files, sorted
+func (l wordLists) names() []string {
+	loaded := make([]string, 0, len(l))
+	for name := range l {
+		loaded = append(loaded, name)
+	}
+	sort.Strings(loaded)
+	return append(passphrase.ListNames(), loaded...)
+}
+
+// isBuiltIn reports whether name is the name or alias of one of the word lists shipped with snakeeyes
+func isBuiltIn(name string) bool {
+	_, ok := passphrase.FindList(name)
+	return ok
+}
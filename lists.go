@@ -0,0 +1,146 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/glvnst/snakeeyes/internal/stats"
+	"github.com/glvnst/snakeeyes/passphrase"
+)
+
+const listsHelpText = `usage: %s lists [-list name] [-list-file path] [-width n]
+
+Describes each word list: how many words it has and how many of them are
+unique, the bits of entropy each word adds to a passphrase, where the list came
+from, and the distribution of its word lengths as a summary and a histogram.
+The statistics are computed from the words themselves each time, so they are
+always up to date.
+
+Command line options:
+
+`
+
+// listsMain implements the lists command, which describes the available word lists
+func listsMain(args []string) {
+	flags := flag.NewFlagSet("lists", flag.ExitOnError)
+	width := flags.Int("width", 50, "the width of the longest bar of each histogram")
+	options := addListFlags(flags, "", "describe only the named word list")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), listsHelpText, os.Args[0])
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	checkNoArgs(flags)
+
+	if *width < 1 {
+		dieWith(exitUsage, "The -width must be at least 1.\n")
+	}
+	lists := options.load()
+	for i, name := range options.chosen(lists) {
+		if i > 0 {
+			fmt.Println()
+		}
+		words, _ := lists.lookup(name)
+		describeList(os.Stdout, name, words, listProvenance(name, options.files), *width)
+	}
+}
+
+// listProvenance describes where the named list came from
+func listProvenance(name string, files listFiles) string {
+	if list, ok := passphrase.FindList(name); ok {
+		provenance := fmt.Sprintf("built in, %s; downloaded from %s (%s)", list.Description, list.URL, list.License)
+		if list.SHA256 != "" {
+			provenance += ", SHA-256 " + list.SHA256
+		}
+		if list.ForkedFromEFF {
+			provenance += "; forked from EFF, without words containing non-ASCII characters"
+		}
+		if list.DiceIndexed {
+			provenance += "; numbered with EFF's dice codes"
+		}
+		if len(list.Aliases) > 0 {
+			provenance += "; also called " + strings.Join(list.Aliases, ", ")
+		}
+		return provenance
+	}
+	for _, path := range files {
+		if listFileName(path) == name {
+			return "loaded from " + path
+		}
+	}
+	return "unknown"
+}
+
+// listTable describes each built in word list on a line of its own, for the main help
+func listTable() string {
+	nameWidth := 0
+	for _, list := range passphrase.WordLists {
+		if len(list.Name) > nameWidth {
+			nameWidth = len(list.Name)
+		}
+	}
+	var table strings.Builder
+	for i, list := range passphrase.WordLists {
+		if i > 0 {
+			table.WriteString("\n")
+		}
+		fmt.Fprintf(&table, "%-*s - %s words, ", nameWidth, list.Name, thousands(list.Len()))
+		if list.ForkedFromEFF {
+			table.WriteString("forked from EFF, ")
+		}
+		table.WriteString(list.Description)
+	}
+	return table.String()
+}
+
+// thousands formats n with commas between groups of three digits
+func thousands(n int) string {
+	s := strconv.Itoa(n)
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return s
+}
+
+// describeList writes the statistics of a word list, ending with a histogram of its word lengths whose longest bar
+// is width characters long
+func describeList(w io.Writer, name string, words []string, provenance string, width int) {
+	unique := make(map[string]bool, len(words))
+	lengths := make([]float64, len(words))
+	for i, word := range words {
+		unique[word] = true
+		lengths[i] = float64(len(word))
+	}
+	summary := stats.Describe(lengths)
+
+	fmt.Fprintf(w, "%s: %d words (%d unique), %.3f bits per word\n", name, len(words), len(unique), passphrase.BitsPerWord(len(words)))
+	fmt.Fprintf(w, "source: %s\n", provenance)
+	if len(words) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "word length: min %g, first quartile %g, median %g, mean %.3f, third quartile %g, max %g, standard deviation %.3f\n",
+		summary.Min, summary.Q1, summary.Median, summary.Mean, summary.Q3, summary.Max, summary.StdDev)
+	fmt.Fprintln(w)
+
+	counts := make([]int, int(summary.Max)+1)
+	mostCommon := 0
+	for _, length := range lengths {
+		counts[int(length)]++
+		if counts[int(length)] > mostCommon {
+			mostCommon = counts[int(length)]
+		}
+	}
+	lengthWidth := len(fmt.Sprint(summary.Max))
+	for length := int(summary.Min); length <= int(summary.Max); length++ {
+		bar := int(math.Round(float64(counts[length]) / float64(mostCommon) * float64(width)))
+		if bar == 0 && counts[length] > 0 {
+			bar = 1
+		}
+		fmt.Fprintf(w, "%*d | %-*s %d\n", lengthWidth, length, width, strings.Repeat("#", bar), counts[length])
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/glvnst/snakeeyes/passphrase"
+)
+
+const lookupHelpText = `usage: %s lookup [-list name] [-list-file path] {code|word} ...
+
+Converts dice codes (such as 35642) into the words they name, and words back
+into their dice codes, so that a passphrase can be checked against a printed
+copy of the word list.
+
+Command line options:
+
+`
+
+// lookupMain implements the lookup command, which translates between dice codes and words
+func lookupMain(args []string) {
+	flags := flag.NewFlagSet("lookup", flag.ExitOnError)
+	options := addListFlags(flags, "eff", "the word list to look up words in")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), lookupHelpText, os.Args[0])
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	lists := options.load()
+	listName := options.chosen(lists)[0]
+	wordList, _ := lists.lookup(listName)
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(exitUsage)
+	}
+	if list, ok := passphrase.FindList(listName); ok && !list.DiceIndexed {
+		warn("Note: EFF's printed \"%s\" list uses different dice; these codes are the ones snakeeyes -dice uses.\n", listName)
+	}
+
+	positions := make(map[string]int, len(wordList))
+	for i, word := range wordList {
+		positions[word] = i
+	}
+
+	failed := false
+	for _, arg := range flags.Args() {
+		if strings.Trim(arg, "0123456789 ") == "" {
+			index, err := passphrase.DiceIndex(arg, len(wordList))
+			if errors.Is(err, passphrase.ErrReroll) {
+				err = fmt.Errorf("no word in the \"%s\" list has this code", listName)
+			}
+			if err != nil {
+				warn("%s: %s\n", arg, err)
+				failed = true
+				continue
+			}
+			fmt.Printf("%s\t%s\n", passphrase.DiceCode(index, len(wordList)), wordList[index])
+			continue
+		}
+
+		index, ok := positions[arg]
+		if !ok {
+			warn("%s: no such word in the \"%s\" list\n", arg, listName)
+			failed = true
+			continue
+		}
+		fmt.Printf("%s\t%s\n", passphrase.DiceCode(index, len(wordList)), arg)
+	}
+
+	if failed {
+		os.Exit(exitFailure)
+	}
+}
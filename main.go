@@ -15,15 +15,18 @@
 package main
 
 import (
-	"crypto/rand"
+	"errors"
 	"flag"
 	"fmt"
-	"math/big"
 	"os"
 	"strings"
+
+	"github.com/glvnst/snakeeyes/passphrase"
 )
 
+//go:generate go run helpers/mkwordsources.go
 //go:generate go run helpers/mkwordlists.go
+//go:generate go run helpers/mkanalysis.go
 
 // filled at build time with ldflags by GoReleaser (part of build action)
 var (
@@ -33,7 +36,8 @@ var (
 	date    = "No build date recorded."
 )
 
-const helpText = `usage: %s [ [-h|--help] | [-version] | [-words n] [-phrases n] [-list {eff,memorable,touchscreen,got,potter,trek,wars}] ]
+const mainHelpText = `usage: %s [gen] [options]
+       %s <command> [options]
 
 This command-line utility generates random passphrases using the Electronic
 Frontier Foundation's passphrase word lists. For more info visit these articles:
@@ -53,15 +57,17 @@ terms of the GNU Affero General Public License 3.0. Visit the following URL for
 more information:
 https://www.gnu.org/licenses/agpl-3.0.html/
 
+Commands:
+
+%s
+Run "%s help <command>" for the options of a command.
+
 Available Word Lists:
 
-eff         - 7,776 words, like Arnold Reinhold's Diceware, but tweaked by EFF
-memorable   - 1,296 words, the most memorable and distinct words per EFF
-touchscreen - 1,296 words, EFF experiment optimized for typing on software keyboards
-got         - 3,996 words, forked from EFF, contains hyphenated words, inspired by Game of Thrones
-potter      - 3,998 words, forked from EFF, contains hyphenated words, inspired by Harry Potter
-trek        - 3,998 words, forked from EFF, contains hyphenated words, inspired by Star Trek
-wars        - 3,993 words, forked from EFF, contains hyphenated words, inspired by Star Wars
+%s
+
+The lists command describes each list in more detail, including the
+distribution of its word lengths.
 
 The designation "forked from EFF" indicates that a list started with one of EFF's
 FANDOM Wikia-based lists and had additional filtering applied to remove words
@@ -80,63 +86,142 @@ to the long list—for example, using eight words from the short will provide a
 strength of about 82 bits, slightly stronger than six words from the long
 list."
 
-Command line options:
+Options of gen, which runs when no command is given:
 
 `
 
-// GenPassphrase randomly chooses nWords from the given dictionary and returns them joined by the given delimiter
-func GenPassphrase(dictionary []string, nWords int, delimiter string) string {
-	phrase := make([]string, nWords)
-	dictLen := big.NewInt(int64(len(dictionary)))
-
-	for w := 0; w < nWords; w++ {
-		wordIndexBig, err := rand.Int(rand.Reader, dictLen)
-		if err != nil {
-			panic(err)
-		}
-		wordIndex := wordIndexBig.Int64()
-		phrase[w] = dictionary[wordIndex]
-	}
-
-	return strings.Join(phrase, delimiter)
-}
+// exit statuses, so scripts can tell the kinds of failure apart
+const (
+	exitFailure    = 1 // general failure
+	exitUsage      = 2 // bad command line arguments (the flag package also uses 2)
+	exitBadList    = 3 // the chosen word list is empty or invalid
+	exitRandomness = 4 // the operating system's random number generator failed
+)
 
 func warn(warning string, a ...interface{}) {
 	fmt.Fprintf(os.Stderr, warning, a...)
 }
 
 func die(message string, a ...interface{}) {
+	dieWith(exitFailure, message, a...)
+}
+
+func dieWith(code int, message string, a ...interface{}) {
 	warn(message, a...)
-	os.Exit(1)
+	os.Exit(code)
 }
 
-func usage() {
-	fmt.Fprintf(os.Stderr, helpText, os.Args[0])
-	flag.PrintDefaults()
+// dieOnGenError explains a passphrase generation error and exits with a status specific to the kind of error
+func dieOnGenError(err error, listName string) {
+	switch {
+	case errors.Is(err, passphrase.ErrEmptyList):
+		dieWith(exitBadList, "The \"%s\" list contains no words.\n", listName)
+	case errors.Is(err, passphrase.ErrBadWordCount):
+		dieWith(exitUsage, "The -words count must be at least 1.\n")
+	case errors.Is(err, passphrase.ErrBadBits):
+		dieWith(exitBadList, "No number of words from the \"%s\" list reaches the -bits target: %s.\n", listName, err)
+	case errors.Is(err, passphrase.ErrBadTarget):
+		dieWith(exitUsage, "The -bits target can't be reached with the \"%s\" list: %s.\n", listName, err)
+	case errors.Is(err, passphrase.ErrBadPolicy):
+		dieWith(exitUsage, "Unable to follow the password rules with the \"%s\" list: %s.\n", listName, err)
+	case errors.Is(err, passphrase.ErrBadLength):
+		dieWith(exitUsage, "Unable to generate a passphrase from the \"%s\" list: %s.\n", listName, err)
+	case errors.Is(err, passphrase.ErrRandomness):
+		dieWith(exitRandomness, "Unable to generate a passphrase securely: %s\n", err)
+	default:
+		die("Unable to generate a passphrase: %s\n", err)
+	}
 }
 
-func main() {
-	var (
-		wordCount     = flag.Int("words", 6, "the number of words to include in each generated passphrase")
-		phraseCount   = flag.Int("phrases", 3, "the number of passphrases to generate")
-		delimiter     = flag.String("delimiter", " ", "the delimiter between words in a passphrase")
-		listName      = flag.String("list", "eff", "the word list to choose words from")
-		reportVersion = flag.Bool("version", false, "report version number and exit")
-	)
-	flag.Usage = usage
-	flag.Parse()
-
-	if *reportVersion {
-		die("%s %s\n %s\n %s\n", product, version, commit, date)
+// command is one of the commands snakeeyes runs, named by its first argument
+type command struct {
+	name    string
+	aliases []string
+	summary string
+	run     func(args []string)
+}
+
+// commands lists the commands in the order the help shows them. It is filled in by init, since the help of the gen
+// command lists the commands.
+var commands []command
+
+func init() {
+	commands = []command{
+		{name: "gen", summary: "generate passphrases, random strings or PINs (the default)", run: func(args []string) { genMain(args, false) }},
+		{name: "lists", summary: "describe the available word lists", run: listsMain},
+		{name: "entropy", summary: "show how many words from each list reach common strengths", run: entropyMain},
+		{name: "check", aliases: []string{"validate-list"}, summary: "check word lists for the properties EFF designed its lists around", run: checkMain},
+		{name: "lookup", summary: "translate between dice codes and words", run: lookupMain},
+		{name: "audit", summary: "check the choice of words for bias and the random number generator for failure", run: auditMain},
+		{name: "serve", summary: "serve passphrases over HTTP", run: serveMain},
 	}
+}
 
-	// parsing
-	wordList, ok := WordLists[*listName]
-	if !ok {
-		die("No such list \"%s\".\n", *listName)
+// findCommand returns the command with the given name or alias
+func findCommand(name string) (command, bool) {
+	for _, c := range commands {
+		if c.name == name {
+			return c, true
+		}
+		for _, alias := range c.aliases {
+			if alias == name {
+				return c, true
+			}
+		}
+	}
+	return command{}, false
+}
+
+// mainUsage prints the main help, followed by the options of gen from the given flag set
+func mainUsage(genFlags *flag.FlagSet) {
+	var list strings.Builder
+	for _, c := range commands {
+		fmt.Fprintf(&list, "  %-8s %s\n", c.name, c.summary)
+	}
+	fmt.Fprintf(genFlags.Output(), mainHelpText, os.Args[0], os.Args[0], list.String(), os.Args[0], listTable())
+	genFlags.PrintDefaults()
+}
+
+// checkNoArgs exits with a usage error if arguments are left over after the flags of a command which takes none
+func checkNoArgs(flags *flag.FlagSet) {
+	if flags.NArg() > 0 {
+		dieWith(exitUsage, "Unexpected argument \"%s\"; run \"%s help %s\" for the options.\n", flags.Arg(0), os.Args[0], flags.Name())
 	}
+}
 
-	for p := 0; p < *phraseCount; p++ {
-		fmt.Println(GenPassphrase(wordList, *wordCount, *delimiter))
+// flagWasSet reports whether the named flag was given on the command line
+func flagWasSet(flags *flag.FlagSet, name string) (set bool) {
+	flags.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+func main() {
+	if len(os.Args) > 1 {
+		if os.Args[1] == "help" {
+			helpMain(os.Args[2:])
+			return
+		}
+		if c, ok := findCommand(os.Args[1]); ok {
+			c.run(os.Args[2:])
+			return
+		}
+	}
+	genMain(os.Args[1:], true)
+}
+
+// helpMain implements the help command, which prints the main help or the help of the named command
+func helpMain(args []string) {
+	if len(args) == 0 {
+		genMain([]string{"-h"}, true)
+		return
+	}
+	c, ok := findCommand(args[0])
+	if !ok {
+		dieWith(exitUsage, "No such command \"%s\".\n", args[0])
 	}
+	c.run([]string{"-h"})
 }
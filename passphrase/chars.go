@@ -0,0 +1,128 @@
+package passphrase
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+// ErrBadAlphabet is returned when asked to choose characters from an empty or unknown set of characters
+var ErrBadAlphabet = errors.New("no characters to choose from")
+
+// CharClasses are the named sets of characters a CharGenerator's alphabet can be built from
+var CharClasses = map[string]string{
+	"lower":   "abcdefghijklmnopqrstuvwxyz",
+	"upper":   "ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	"digits":  "0123456789",
+	"symbols": DefaultSymbols,
+}
+
+// Ambiguous lists the characters which are easily mistaken for one another in many fonts
+const Ambiguous = "Il1O0"
+
+// Alphabet returns the characters of the named classes (see CharClasses), without duplicates and, if
+// excludeAmbiguous is set, without the characters in Ambiguous. It returns ErrBadAlphabet for an unknown class or
+// if no characters are left.
+func Alphabet(classes []string, excludeAmbiguous bool) (string, error) {
+	var alphabet strings.Builder
+	for _, class := range classes {
+		chars, ok := CharClasses[class]
+		if !ok {
+			names := make([]string, 0, len(CharClasses))
+			for name := range CharClasses {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			return "", fmt.Errorf("%w (there is no character class \"%s\"; choose from %s)", ErrBadAlphabet, class, strings.Join(names, ", "))
+		}
+		for _, r := range chars {
+			if strings.ContainsRune(alphabet.String(), r) || (excludeAmbiguous && strings.ContainsRune(Ambiguous, r)) {
+				continue
+			}
+			alphabet.WriteRune(r)
+		}
+	}
+	if alphabet.Len() == 0 {
+		return "", ErrBadAlphabet
+	}
+	return alphabet.String(), nil
+}
+
+// CharGenerator produces random strings of Length characters chosen from Alphabet, for when a dense secret is needed
+// rather than a passphrase. Characters are chosen the same way a Generator chooses words.
+type CharGenerator struct {
+	// Alphabet holds the characters to choose from; see Alphabet
+	Alphabet string
+	// Length is the number of characters in each string
+	Length int
+	// GroupSize, if positive, splits each string into groups of that many characters joined by GroupDelimiter, like
+	// xxxx-xxxx-xxxx. The delimiters aren't random and add no entropy.
+	GroupSize      int
+	GroupDelimiter string
+	// Rand is the source of randomness, as in Generator
+	Rand io.Reader
+}
+
+// Validate returns ErrBadAlphabet or ErrBadLength if the generator cannot produce any strings
+func (c *CharGenerator) Validate() error {
+	if c.Alphabet == "" {
+		return ErrBadAlphabet
+	}
+	if c.Length < 1 {
+		return fmt.Errorf("%w (the length must be at least 1)", ErrBadLength)
+	}
+	return nil
+}
+
+// LengthForBits returns the smallest Length which gives each string at least the given bits of entropy. It returns
+// ErrBadBits if the alphabet has fewer than two characters and ErrBadTarget if bits is NaN or would need more than
+// MaxWordsForBits characters.
+func (c *CharGenerator) LengthForBits(bits float64) (int, error) {
+	if c.Alphabet == "" {
+		return 0, ErrBadAlphabet
+	}
+	length := WordsForBits(len([]rune(c.Alphabet)), bits)
+	if length < 0 {
+		if len([]rune(c.Alphabet)) < 2 {
+			return 0, ErrBadBits
+		}
+		return 0, fmt.Errorf("%w (%g bits)", ErrBadTarget, bits)
+	}
+	if length < 1 {
+		length = 1
+	}
+	return length, nil
+}
+
+// Password returns a new random string. Errors from the source of randomness are wrapped in ErrRandomness.
+func (c *CharGenerator) Password() (string, error) {
+	if err := c.Validate(); err != nil {
+		return "", err
+	}
+	alphabet := []rune(c.Alphabet)
+	src := sourceFor(c.Rand)
+
+	var password strings.Builder
+	for i := 0; i < c.Length; i++ {
+		if c.GroupSize > 0 && i > 0 && i%c.GroupSize == 0 {
+			password.WriteString(c.GroupDelimiter)
+		}
+		index, err := uniform(src, uint64(len(alphabet)))
+		if err != nil {
+			return "", fmt.Errorf("%w: %v", ErrRandomness, err)
+		}
+		password.WriteRune(alphabet[index])
+	}
+	return password.String(), nil
+}
+
+// Entropy returns the bits of entropy in each string the generator produces
+func (c *CharGenerator) Entropy() float64 {
+	if c.Length < 1 || c.Alphabet == "" {
+		return 0
+	}
+	return float64(c.Length) * math.Log2(float64(len([]rune(c.Alphabet))))
+}
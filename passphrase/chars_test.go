@@ -0,0 +1,85 @@
+package passphrase
+
+import (
+	"errors"
+	"math"
+	mathrand "math/rand"
+	"strings"
+	"testing"
+)
+
+func TestAlphabet(t *testing.T) {
+	tests := []struct {
+		classes          []string
+		excludeAmbiguous bool
+		want             string
+	}{
+		{[]string{"digits"}, false, "0123456789"},
+		{[]string{"digits"}, true, "23456789"},
+		{[]string{"digits", "digits"}, false, "0123456789"},
+		{[]string{"upper", "digits"}, true, "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"},
+	}
+	for _, test := range tests {
+		got, err := Alphabet(test.classes, test.excludeAmbiguous)
+		if err != nil {
+			t.Errorf("Alphabet(%v, %t) returned an error: %s", test.classes, test.excludeAmbiguous, err)
+		}
+		if got != test.want {
+			t.Errorf("Alphabet(%v, %t) want: %s, got: %s", test.classes, test.excludeAmbiguous, test.want, got)
+		}
+	}
+	if _, err := Alphabet([]string{"lower", "emoji"}, false); !errors.Is(err, ErrBadAlphabet) {
+		t.Errorf("expected ErrBadAlphabet for an unknown class, got: %v", err)
+	}
+	if _, err := Alphabet(nil, false); !errors.Is(err, ErrBadAlphabet) {
+		t.Errorf("expected ErrBadAlphabet for no classes, got: %v", err)
+	}
+}
+
+func TestCharGenerator(t *testing.T) {
+	alphabet, _ := Alphabet([]string{"lower", "digits"}, true)
+	c := &CharGenerator{Alphabet: alphabet, Length: 16, GroupSize: 4, GroupDelimiter: "-", Rand: mathrand.New(mathrand.NewSource(5))}
+
+	if bits, want := c.Entropy(), 16*math.Log2(float64(len(alphabet))); math.Abs(bits-want) > 1e-9 {
+		t.Errorf("unexpected entropy. want: %f, got: %f", want, bits)
+	}
+	counts := make([]int, len(alphabet))
+	for i := 0; i < 50*len(alphabet)/16; i++ {
+		password, err := c.Password()
+		if err != nil {
+			t.Fatalf("unexpected error generating a password: %s", err)
+		}
+		groups := strings.Split(password, "-")
+		if len(groups) != 4 {
+			t.Fatalf("expected four groups in %s", password)
+		}
+		for _, group := range groups {
+			if len(group) != 4 {
+				t.Fatalf("expected groups of four characters in %s", password)
+			}
+			for _, r := range group {
+				counts[strings.IndexRune(alphabet, r)]++
+			}
+		}
+	}
+	checkFit(t, "the characters", counts, 1)
+
+	if length, err := c.LengthForBits(128); err != nil || length != 26 {
+		t.Errorf("expected 26 characters of lower case letters and digits for 128 bits, got: %d, %v", length, err)
+	}
+	if _, err := c.LengthForBits(1e30); !errors.Is(err, ErrBadTarget) {
+		t.Errorf("expected ErrBadTarget for an absurd target, got: %v", err)
+	}
+	if _, err := (&CharGenerator{Alphabet: "x"}).LengthForBits(10); !errors.Is(err, ErrBadBits) {
+		t.Errorf("expected ErrBadBits for a one character alphabet, got: %v", err)
+	}
+	if _, err := (&CharGenerator{Alphabet: alphabet}).Password(); !errors.Is(err, ErrBadLength) {
+		t.Errorf("expected ErrBadLength for no characters, got: %v", err)
+	}
+	if _, err := (&CharGenerator{Length: 8}).Password(); !errors.Is(err, ErrBadAlphabet) {
+		t.Errorf("expected ErrBadAlphabet for an empty alphabet, got: %v", err)
+	}
+	if _, err := (&CharGenerator{Alphabet: alphabet, Length: 8, Rand: failingReader{}}).Password(); !errors.Is(err, ErrRandomness) {
+		t.Errorf("expected ErrRandomness from a failing reader, got: %v", err)
+	}
+}
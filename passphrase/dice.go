@@ -0,0 +1,54 @@
+package passphrase
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DiceDigits returns the number of six-sided dice to roll for each word chosen from a list of listLen words: the
+// smallest count whose 6^count outcomes cover the whole list. The EFF lists are sized as powers of six (7,776 is 6^5
+// and 1,296 is 6^4) so every outcome names a word; the other lists need some rolls to be rerolled.
+func DiceDigits(listLen int) int {
+	digits, outcomes := 1, 6
+	for outcomes < listLen {
+		digits++
+		outcomes *= 6
+	}
+	return digits
+}
+
+// DiceIndex returns the position in a list of listLen words named by a roll of DiceDigits(listLen) six-sided dice,
+// written as one digit 1-6 per die in the order they were read, as in EFF's printed lists ("11111" is the first
+// word, "11112" the second and so on). Spaces between the digits are ignored. It returns ErrBadRoll if the roll is
+// malformed and ErrReroll if it names a position beyond the end of the list, in which case the dice must be rolled
+// again; picking some other word instead would make some words more likely than others.
+func DiceIndex(roll string, listLen int) (int, error) {
+	roll = strings.Join(strings.Fields(roll), "")
+	digits := DiceDigits(listLen)
+	if len(roll) != digits {
+		return 0, fmt.Errorf("%w: expected %d dice, got %d", ErrBadRoll, digits, len(roll))
+	}
+
+	index := 0
+	for _, r := range roll {
+		if r < '1' || r > '6' {
+			return 0, fmt.Errorf("%w: %q is not a number from 1 to 6", ErrBadRoll, r)
+		}
+		index = index*6 + int(r-'1')
+	}
+	if index >= listLen {
+		return 0, ErrReroll
+	}
+	return index, nil
+}
+
+// DiceCode returns the roll of DiceDigits(listLen) six-sided dice which names the given position in a list of
+// listLen words, as printed in EFF's lists. It is the inverse of DiceIndex.
+func DiceCode(index int, listLen int) string {
+	code := make([]byte, DiceDigits(listLen))
+	for i := len(code) - 1; i >= 0; i-- {
+		code[i] = byte('1' + index%6)
+		index /= 6
+	}
+	return string(code)
+}
@@ -0,0 +1,84 @@
+package passphrase
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDiceDigits(t *testing.T) {
+	for name, want := range map[string]int{
+		"eff":         5,
+		"memorable":   4,
+		"touchscreen": 4,
+		"got":         5,
+		"wars":        5,
+	} {
+		if got := DiceDigits(len(builtIn(name))); got != want {
+			t.Errorf("expected %d dice per word for list \"%s\", got: %d", want, name, got)
+		}
+	}
+}
+
+func TestDiceIndex(t *testing.T) {
+	eff := builtIn("eff")
+	tests := []struct {
+		roll string
+		want string
+	}{
+		{"11111", "abacus"},
+		{"11112", "abdomen"},
+		{"66666", "zoom"},
+		{"6 6 6 6 6", "zoom"},
+	}
+	for _, test := range tests {
+		index, err := DiceIndex(test.roll, len(eff))
+		if err != nil {
+			t.Errorf("unexpected error for roll %s: %s", test.roll, err)
+			continue
+		}
+		if eff[index] != test.want {
+			t.Errorf("roll %s want: %s, got: %s", test.roll, test.want, eff[index])
+		}
+	}
+
+	memorable := builtIn("memorable")
+	if index, err := DiceIndex("6666", len(memorable)); err != nil || index != len(memorable)-1 {
+		t.Errorf("expected roll 6666 to name the last memorable word, got: %d (error: %v)", index, err)
+	}
+
+	for _, roll := range []string{"", "1111", "111111", "11117", "1111a", "01111"} {
+		if _, err := DiceIndex(roll, len(eff)); !errors.Is(err, ErrBadRoll) {
+			t.Errorf("expected ErrBadRoll for roll \"%s\", got: %v", roll, err)
+		}
+	}
+
+	// 3,993 words covers rolls 11111 through 41363 (3,992 in base six, plus one per digit)
+	wars := builtIn("wars")
+	if _, err := DiceIndex("41363", len(wars)); err != nil {
+		t.Errorf("unexpected error for the last word of the wars list: %s", err)
+	}
+	for _, roll := range []string{"41364", "66666"} {
+		if _, err := DiceIndex(roll, len(wars)); !errors.Is(err, ErrReroll) {
+			t.Errorf("expected ErrReroll for roll %s on the wars list, got: %v", roll, err)
+		}
+	}
+}
+
+func TestDiceCode(t *testing.T) {
+	for _, name := range ListNames() {
+		list := builtIn(name)
+		for index := range list {
+			code := DiceCode(index, len(list))
+			roundTrip, err := DiceIndex(code, len(list))
+			if err != nil || roundTrip != index {
+				t.Fatalf("list \"%s\" word %d: code %s maps back to %d (error: %v)", name, index, code, roundTrip, err)
+			}
+		}
+	}
+	if code := DiceCode(0, 7776); code != "11111" {
+		t.Errorf("expected the first eff word to have code 11111, got: %s", code)
+	}
+	if code := DiceCode(1295, 1296); code != "6666" {
+		t.Errorf("expected the last memorable word to have code 6666, got: %s", code)
+	}
+}
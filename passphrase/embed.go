@@ -0,0 +1,166 @@
+package passphrase
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// The word lists are embedded front coded, one file per list: each word is stored as the number of leading bytes it
+// shares with the word before it, the number of bytes which follow, and those bytes (see helpers/mkwordlists.go).
+// A list is only decoded the first time it is looked up, into a single string which all of its words are slices of;
+// this keeps the binary small and means running snakeeyes only pays to decode the one list it uses.
+//
+//go:embed wordlists/*.fc
+var embeddedFiles embed.FS
+
+// WordList is one of the word lists shipped with snakeeyes, with where it came from. WordLists in wordlists.go holds
+// them all, generated from wordlists/sources.json.
+type WordList struct {
+	// Name is the name -list chooses the list by, and Aliases are other names it accepts for it
+	Name    string
+	Aliases []string
+	// Description summarizes the list for the help
+	Description string
+	// URL and License record where the list was downloaded from and the terms it is distributed under. SHA256 is the
+	// digest of that download, or empty if it hasn't been pinned yet.
+	URL     string
+	License string
+	SHA256  string
+	// ForkedFromEFF marks lists which started as one of EFF's but had words with non-ASCII characters removed
+	ForkedFromEFF bool
+	// DiceIndexed marks lists whose source files numbered each word with EFF's dice codes
+	DiceIndexed bool
+
+	// size is the number of words in the list and digest the SHA-256 of its words, each followed by a newline, as
+	// recorded when the list was generated
+	size   int
+	digest string
+	once   sync.Once
+	words  []string
+}
+
+// Len returns the number of words in the list, without decoding it
+func (l *WordList) Len() int {
+	return l.size
+}
+
+// Words returns the words of the list, decoding them the first time. The returned slice is shared by every caller
+// and must not be modified. A failure to decode means the binary itself is damaged, so it panics.
+func (l *WordList) Words() []string {
+	l.once.Do(func() {
+		words, err := decodeList(embeddedFiles, l.Name, l.size)
+		if err != nil {
+			panic(err)
+		}
+		l.words = words
+	})
+	return l.words
+}
+
+// Verify decodes a fresh copy of the embedded list and checks it against the size and digest recorded when the list
+// was generated, so that a damaged or patched binary can't silently weaken passphrases. It returns the digest of the
+// decoded words.
+func (l *WordList) Verify() (string, error) {
+	words, err := decodeList(embeddedFiles, l.Name, l.size)
+	if err != nil {
+		return "", err
+	}
+	digest := wordsDigest(words)
+	if digest != l.digest {
+		return digest, fmt.Errorf("the embedded %s word list has the SHA-256 %s, expected %s", l.Name, digest, l.digest)
+	}
+	return digest, nil
+}
+
+// wordsDigest returns the hex SHA-256 of the words, each followed by a newline, the same way
+// internal/wordsource.WordsDigest does for helpers/mkwordlists.go
+func wordsDigest(words []string) string {
+	digest := sha256.New()
+	for _, word := range words {
+		digest.Write([]byte(word + "\n"))
+	}
+	return hex.EncodeToString(digest.Sum(nil))
+}
+
+// decodeList decodes the named list from files and checks that it holds the expected number of words
+func decodeList(files embed.FS, name string, size int) ([]string, error) {
+	blob, err := files.ReadFile("wordlists/" + name + ".fc")
+	if err != nil {
+		return nil, err
+	}
+
+	// a first pass checks the encoding and measures the words, so they can be decoded into one allocation
+	count, total, previous := 0, 0, 0
+	for i := 0; i < len(blob); count++ {
+		if len(blob)-i < 2 || int(blob[i]) > previous || len(blob)-i-2 < int(blob[i+1]) {
+			return nil, fmt.Errorf("the embedded %s word list is damaged at byte %d", name, i)
+		}
+		previous = int(blob[i]) + int(blob[i+1])
+		total += previous
+		i += 2 + int(blob[i+1])
+	}
+	if count != size {
+		return nil, fmt.Errorf("the embedded %s word list has %d words, expected %d", name, count, size)
+	}
+
+	var data strings.Builder
+	data.Grow(total)
+	ends := make([]int, 0, count)
+	start := 0
+	for i := 0; i < len(blob); i += 2 + int(blob[i+1]) {
+		shared, suffix := int(blob[i]), int(blob[i+1])
+		wordStart := data.Len()
+		data.WriteString(data.String()[start : start+shared])
+		data.Write(blob[i+2 : i+2+suffix])
+		start = wordStart
+		ends = append(ends, data.Len())
+	}
+
+	// the words are substrings of the one decoded string rather than copies of their own
+	all := data.String()
+	words := make([]string, count)
+	start = 0
+	for i, end := range ends {
+		words[i] = all[start:end]
+		start = end
+	}
+	return words, nil
+}
+
+// FindList returns the word list shipped with snakeeyes with the given name or alias
+func FindList(name string) (*WordList, bool) {
+	for _, list := range WordLists {
+		if list.Name == name {
+			return list, true
+		}
+		for _, alias := range list.Aliases {
+			if alias == name {
+				return list, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Lookup returns the words of the word list shipped with snakeeyes with the given name or alias. The returned slice
+// is shared by every caller and must not be modified.
+func Lookup(name string) ([]string, bool) {
+	list, ok := FindList(name)
+	if !ok {
+		return nil, false
+	}
+	return list.Words(), true
+}
+
+// ListNames returns the names of the word lists shipped with snakeeyes
+func ListNames() []string {
+	names := make([]string, len(WordLists))
+	for i, list := range WordLists {
+		names[i] = list.Name
+	}
+	return names
+}
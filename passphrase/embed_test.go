@@ -0,0 +1,126 @@
+package passphrase
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEmbeddedLists(t *testing.T) {
+	if got, want := strings.Join(ListNames(), ","), "eff,memorable,touchscreen,got,potter,trek,wars"; got != want {
+		t.Errorf("unexpected list names. want: %s, got: %s", want, got)
+	}
+	if _, ok := Lookup("nope"); ok {
+		t.Errorf("expected no list named \"nope\"")
+	}
+	if list, ok := FindList("starwars"); !ok || list.Name != "wars" {
+		t.Errorf("expected starwars to be an alias of the wars list")
+	}
+
+	for _, list := range WordLists {
+		words, err := decodeList(embeddedFiles, list.Name, list.size)
+		if err != nil {
+			t.Errorf("unable to decode the %s list: %s", list.Name, err)
+			continue
+		}
+		for i, word := range words {
+			if word == "" || strings.TrimSpace(word) != word {
+				t.Errorf("%s list word %d is malformed: %q", list.Name, i, word)
+			}
+		}
+		if _, err := decodeList(embeddedFiles, list.Name, list.size+1); err == nil {
+			t.Errorf("expected an error when the %s list is shorter than expected", list.Name)
+		}
+		if !strings.HasPrefix(list.URL, "https://") || list.License == "" || list.Description == "" {
+			t.Errorf("expected the %s list to record its source and description, got: %+v", list.Name, list)
+		}
+	}
+
+	// every lookup shares the one decoded copy
+	first, _ := Lookup("eff")
+	second, _ := Lookup("eff")
+	if &first[0] != &second[0] {
+		t.Errorf("expected repeated lookups to return the same decoded list")
+	}
+}
+
+// TestVerifyLists runs the check behind -verify-lists on every list, so that a list which no longer matches the size
+// and digest recorded when it was generated fails the tests as well as deployed binaries
+func TestVerifyLists(t *testing.T) {
+	for _, list := range WordLists {
+		if list.Len() != expectedListSizes[list.Name] {
+			t.Errorf("the %s list records %d words, expected %d", list.Name, list.Len(), expectedListSizes[list.Name])
+		}
+		if digest, err := list.Verify(); err != nil {
+			t.Errorf("the %s list failed verification: %s", list.Name, err)
+		} else if digest != list.digest {
+			t.Errorf("the %s list verified with the wrong digest. want: %s, got: %s", list.Name, list.digest, digest)
+		}
+	}
+
+	patched := &WordList{Name: "eff", size: 7776, digest: strings.Repeat("0", 64)}
+	if _, err := patched.Verify(); err == nil {
+		t.Errorf("expected a list whose digest doesn't match to fail verification")
+	}
+	shortened := &WordList{Name: "eff", size: 7775, digest: WordLists[0].digest}
+	if _, err := shortened.Verify(); err == nil {
+		t.Errorf("expected a list with the wrong number of words to fail verification")
+	}
+}
+
+// embeddedSize returns the total size of the front coded lists
+func embeddedSize(b *testing.B) int {
+	total := 0
+	for _, list := range WordLists {
+		blob, err := embeddedFiles.ReadFile("wordlists/" + list.Name + ".fc")
+		if err != nil {
+			b.Fatal(err)
+		}
+		total += len(blob)
+	}
+	return total
+}
+
+// BenchmarkDecodeList measures the start up cost of a typical run, which decodes just the one list it uses
+func BenchmarkDecodeList(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := decodeList(embeddedFiles, "eff", 7776); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkDecodeAll measures decoding every list, as the map literal this package used to have was built in full
+// at start up. It reports the size the lists add to the binary as embedded-bytes. helpers/cmplists.go compares the
+// binary size, start up time and allocations of whole programs built with the lists embedded and as a map literal.
+func BenchmarkDecodeAll(b *testing.B) {
+	b.ReportAllocs()
+	b.ReportMetric(float64(embeddedSize(b)), "embedded-bytes")
+	for i := 0; i < b.N; i++ {
+		for _, list := range WordLists {
+			if _, err := decodeList(embeddedFiles, list.Name, list.size); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkDecodeAllSeparateStrings decodes every list but copies each word into its own allocation, for
+// comparison with keeping the words as slices of one string per list
+func BenchmarkDecodeAllSeparateStrings(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		lists := make(map[string][]string, len(WordLists))
+		for _, list := range WordLists {
+			words, err := decodeList(embeddedFiles, list.Name, list.size)
+			if err != nil {
+				b.Fatal(err)
+			}
+			copies := make([]string, len(words))
+			for j, word := range words {
+				copies[j] = strings.Clone(word)
+			}
+			lists[list.Name] = copies
+		}
+	}
+}
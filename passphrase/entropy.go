@@ -0,0 +1,41 @@
+package passphrase
+
+import "math"
+
+// BitsPerWord returns the entropy contributed by each word chosen uniformly from a list of listLen words
+func BitsPerWord(listLen int) float64 {
+	if listLen < 1 {
+		return 0
+	}
+	return math.Log2(float64(listLen))
+}
+
+// Entropy returns the bits of entropy in a passphrase of nWords chosen uniformly from a list of listLen words
+func Entropy(listLen int, nWords int) float64 {
+	if nWords < 1 {
+		return 0
+	}
+	return BitsPerWord(listLen) * float64(nWords)
+}
+
+// MaxWordsForBits bounds the number of words WordsForBits returns; a target needing more is out of range
+const MaxWordsForBits = 1 << 16
+
+// WordsForBits returns the smallest number of words from a list of listLen words which provides at least the given
+// bits of entropy, or -1 if no number of words will do: the list has fewer than 2 words, bits is NaN, or more than
+// MaxWordsForBits words would be needed
+func WordsForBits(listLen int, bits float64) int {
+	perWord := BitsPerWord(listLen)
+	if perWord <= 0 {
+		return -1
+	}
+	// checking the float before converting it keeps huge targets from overflowing into negative counts
+	count := math.Ceil(bits / perWord)
+	switch {
+	case math.IsNaN(count) || count > MaxWordsForBits:
+		return -1
+	case count < 0:
+		return 0
+	}
+	return int(count)
+}
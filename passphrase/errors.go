@@ -0,0 +1,24 @@
+package passphrase
+
+import "errors"
+
+var (
+	// ErrEmptyList is returned when asked to choose words from a list with no words in it
+	ErrEmptyList = errors.New("the word list is empty")
+	// ErrBadWordCount is returned when asked for a passphrase with fewer than one word
+	ErrBadWordCount = errors.New("the number of words must be at least 1")
+	// ErrBadLength is returned when no passphrase can satisfy the requested length range
+	ErrBadLength = errors.New("no passphrase fits the requested length")
+	// ErrBadBits is returned when asked for a strength which no number of words from the list can provide, because the
+	// list has fewer than two words
+	ErrBadBits = errors.New("the word list is too short to provide any entropy")
+	// ErrBadTarget is returned when asked for a strength which isn't a finite number of bits, or which would need an
+	// absurd number of words or characters
+	ErrBadTarget = errors.New("the entropy target is out of range")
+	// ErrBadRoll is returned when a dice roll is malformed
+	ErrBadRoll = errors.New("invalid dice roll")
+	// ErrReroll is returned when a dice roll lands beyond the end of the word list and must be rolled again
+	ErrReroll = errors.New("the roll is past the end of the word list, roll again")
+	// ErrRandomness is returned (wrapping the underlying error) when the source of randomness fails
+	ErrRandomness = errors.New("unable to read from the source of randomness")
+)
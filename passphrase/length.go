@@ -0,0 +1,135 @@
+package passphrase
+
+import (
+	"math"
+	"math/big"
+	"unicode/utf8"
+)
+
+// LengthRange restricts the total length of a passphrase (in characters, delimiters included). A Max of zero means
+// there is no upper limit.
+type LengthRange struct {
+	Min int
+	Max int
+}
+
+// Unbounded reports whether the range admits passphrases of any length
+func (r LengthRange) Unbounded() bool {
+	return r.Min <= 0 && r.Max <= 0
+}
+
+// Contains reports whether a passphrase of the given length falls inside the range
+func (r LengthRange) Contains(length int) bool {
+	if length < r.Min {
+		return false
+	}
+	return r.Max <= 0 || length <= r.Max
+}
+
+// MaxWordsInRange bounds the number of words in the phrases CountPhrasesInRange counts when the range lets only some of
+// them through, since the time that takes grows with the square of the number of words
+const MaxWordsInRange = 100
+
+// phraseLengths returns the lengths of the shortest and longest nWords-word phrases from the given dictionary
+func phraseLengths(dictionary []string, nWords int, delimiter string) (shortest, longest int) {
+	shortestWord, longestWord := utf8.RuneCountInString(dictionary[0]), 0
+	for _, word := range dictionary {
+		wordLen := utf8.RuneCountInString(word)
+		if wordLen < shortestWord {
+			shortestWord = wordLen
+		}
+		if wordLen > longestWord {
+			longestWord = wordLen
+		}
+	}
+	delimiterTotal := utf8.RuneCountInString(delimiter) * (nWords - 1)
+	return nWords*shortestWord + delimiterTotal, nWords*longestWord + delimiterTotal
+}
+
+// fits reports whether the range holds all of the nWords-word phrases from the given dictionary, or none of them
+// because they are all too short or too long. When it's neither, counting the phrases which fit takes real work.
+func (r LengthRange) fits(dictionary []string, nWords int, delimiter string) (all, none bool) {
+	shortest, longest := phraseLengths(dictionary, nWords, delimiter)
+	if r.Contains(shortest) && r.Contains(longest) {
+		return true, false
+	}
+	return false, longest < r.Min || (r.Max > 0 && shortest > r.Max)
+}
+
+// CountPhrasesInRange returns the exact number of distinct nWords-word sequences from the given dictionary whose
+// joined length falls inside the given range. It uses dynamic programming over the histogram of word lengths, so the
+// cost depends on the number of words and their lengths rather than on the (enormous) number of possible phrases. That
+// is only needed when the range lets some of the phrases through but not all; Generator refuses to count more than
+// MaxWordsInRange words then.
+func CountPhrasesInRange(dictionary []string, nWords int, delimiter string, lengths LengthRange) *big.Int {
+	total := new(big.Int)
+	if nWords < 1 || len(dictionary) == 0 {
+		return total
+	}
+	switch all, none := lengths.fits(dictionary, nWords, delimiter); {
+	case all:
+		return CountPhrases(len(dictionary), nWords)
+	case none:
+		return total
+	}
+
+	histogram := make(map[int]int64)
+	maxWordLen := 0
+	for _, word := range dictionary {
+		wordLen := utf8.RuneCountInString(word)
+		histogram[wordLen]++
+		if wordLen > maxWordLen {
+			maxWordLen = wordLen
+		}
+	}
+
+	// ways[n] is the number of sequences of the words seen so far whose lengths sum to n
+	ways := []*big.Int{big.NewInt(1)}
+	for w := 0; w < nWords; w++ {
+		next := make([]*big.Int, len(ways)+maxWordLen)
+		for i := range next {
+			next[i] = new(big.Int)
+		}
+		term := new(big.Int)
+		for sum, count := range ways {
+			if count.Sign() == 0 {
+				continue
+			}
+			for wordLen, n := range histogram {
+				term.Mul(count, big.NewInt(n))
+				next[sum+wordLen].Add(next[sum+wordLen], term)
+			}
+		}
+		ways = next
+	}
+
+	delimiterTotal := utf8.RuneCountInString(delimiter) * (nWords - 1)
+	for sum, count := range ways {
+		if lengths.Contains(sum + delimiterTotal) {
+			total.Add(total, count)
+		}
+	}
+	return total
+}
+
+// CountPhrases returns the number of distinct nWords-word sequences from a list of listLen words
+func CountPhrases(listLen int, nWords int) *big.Int {
+	if nWords < 1 {
+		return new(big.Int)
+	}
+	return new(big.Int).Exp(big.NewInt(int64(listLen)), big.NewInt(int64(nWords)), nil)
+}
+
+// Log2 returns the base 2 logarithm of x, which may be far too large to represent as a float64. It returns -Inf when
+// x is zero.
+func Log2(x *big.Int) float64 {
+	if x.Sign() <= 0 {
+		return math.Inf(-1)
+	}
+	shift := x.BitLen() - 53
+	if shift < 0 {
+		shift = 0
+	}
+	mantissa, _ := new(big.Int).Rsh(x, uint(shift)).Float64()
+	return math.Log2(mantissa) + float64(shift)
+}
@@ -0,0 +1,88 @@
+package passphrase
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/glvnst/snakeeyes/internal/wordsource"
+)
+
+// ErrInvalidList is wrapped by every problem ReadWordList finds in a word list
+var ErrInvalidList = errors.New("invalid word list")
+
+// LoadWordList reads and validates the word list in the named file. See ReadWordList.
+func LoadWordList(filename string) ([]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadWordList(f)
+}
+
+// ReadWordList reads a word list with either one word per line or, as in EFF's original lists, a dice code and a word
+// per line, parsing it with wordsource.ScanList as helpers/mkwordlists.go does. The list is validated before it is
+// returned: every line must hold a word, words must be unique, printable ASCII with no whitespace, and dice codes must
+// number the words in order. All of the problems found are returned together, each wrapping ErrInvalidList. An empty
+// input returns ErrEmptyList.
+func ReadWordList(r io.Reader) ([]string, error) {
+	var (
+		words     []string
+		problems  []error
+		firstSeen = make(map[string]int)
+	)
+	problem := func(lineNumber int, format string, a ...interface{}) {
+		problems = append(problems, fmt.Errorf("%w: line %d: %s", ErrInvalidList, lineNumber, fmt.Sprintf(format, a...)))
+	}
+
+	err := wordsource.ScanList(r, func(e wordsource.Entry) error {
+		if e.Word == "" {
+			problem(e.Line, "empty line")
+			return nil
+		}
+		if e.Err != nil {
+			problem(e.Line, "%s", e.Err)
+		}
+
+		// a plain line must be the word alone, without even surrounding whitespace
+		word := e.Word
+		if e.Code == "" {
+			word = e.Text
+		}
+		if strings.IndexFunc(word, unicode.IsSpace) >= 0 {
+			problem(e.Line, "%q contains whitespace", word)
+			return nil
+		}
+		if i := strings.IndexFunc(word, notPrintableASCII); i >= 0 {
+			problem(e.Line, "%q contains the character %q, which is not printable ASCII", word, []rune(word[i:])[0])
+			return nil
+		}
+		if seen, ok := firstSeen[word]; ok {
+			problem(e.Line, "%q is a duplicate of line %d", word, seen)
+			return nil
+		}
+		firstSeen[word] = e.Line
+		words = append(words, word)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(problems) > 0 {
+		return nil, errors.Join(problems...)
+	}
+	if len(words) == 0 {
+		return nil, ErrEmptyList
+	}
+	return words, nil
+}
+
+func notPrintableASCII(r rune) bool {
+	return r < '!' || r > '~'
+}
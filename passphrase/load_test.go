@@ -0,0 +1,63 @@
+package passphrase
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/glvnst/snakeeyes/internal/wordsource"
+)
+
+func TestReadWordList(t *testing.T) {
+	valid := map[string][]string{
+		"plain":         {"alpha\nbeta\ngamma\n", "alpha beta gamma"},
+		"crlf":          {"alpha\r\nbeta\r\n", "alpha beta"},
+		"dice-indexed":  {"11\talpha\n12\tbeta\n13\tgamma\n", "alpha beta gamma"},
+		"hyphenated":    {"jar-jar\nwookiee\n", "jar-jar wookiee"},
+		"no final line": {"alpha\nbeta", "alpha beta"},
+	}
+	for name, test := range valid {
+		words, err := ReadWordList(strings.NewReader(test[0]))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+			continue
+		}
+		if want := strings.Fields(test[1]); !reflect.DeepEqual(words, want) {
+			t.Errorf("%s: want: %v, got: %v", name, want, words)
+		}
+		// the helpers must read every valid list the same way
+		if parsed, _, err := wordsource.ParseList([]byte(test[0])); err != nil || !reflect.DeepEqual(parsed, words) {
+			t.Errorf("%s: wordsource.ParseList disagrees: %v, %v", name, parsed, err)
+		}
+	}
+
+	invalid := map[string]string{
+		"empty line":       "alpha\n\nbeta\n",
+		"blank line":       "alpha\n \t\nbeta\n",
+		"duplicate":        "alpha\nbeta\nalpha\n",
+		"inner whitespace": "alpha\nbeta gamma\n",
+		"outer whitespace": "alpha \nbeta\n",
+		"non-ascii":        "alpha\ncafé\n",
+		"control":          "alpha\nbe\x07ta\n",
+		"dice sequence":    "11\talpha\n13\tbeta\n",
+		"dice missing":     "11\talpha\nbeta\n",
+		"dice late":        "alpha\n12\tbeta\n",
+		"dice length":      "11\talpha\n112\tbeta\n",
+	}
+	for name, input := range invalid {
+		if _, err := ReadWordList(strings.NewReader(input)); !errors.Is(err, ErrInvalidList) {
+			t.Errorf("%s: expected ErrInvalidList, got: %v", name, err)
+		}
+	}
+
+	if _, err := ReadWordList(strings.NewReader("")); !errors.Is(err, ErrEmptyList) {
+		t.Errorf("expected ErrEmptyList for an empty file, got: %v", err)
+	}
+
+	// every problem is reported, not just the first
+	_, err := ReadWordList(strings.NewReader("alpha\n\nalpha\ncafé\n"))
+	if n := strings.Count(err.Error(), ErrInvalidList.Error()); n != 3 {
+		t.Errorf("expected 3 problems to be reported, got %d: %s", n, err)
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (C) 2020 Ben Burke
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package passphrase generates diceware-style passphrases by choosing words uniformly at random from a word list.
+// The word lists shipped with snakeeyes are available through Lookup.
+package passphrase
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+)
+
+// Generator produces passphrases of Count words chosen from Words and joined by Delimiter
+type Generator struct {
+	// Words is the list that words are chosen from
+	Words []string
+	// Count is the number of words in each passphrase
+	Count int
+	// Delimiter is placed between the words of each passphrase
+	Delimiter string
+	// Lengths optionally restricts the total length of each passphrase
+	Lengths LengthRange
+	// Rand is the source of randomness; a buffered crypto/rand.Reader is used when it is nil. Each word is chosen
+	// from eight bytes read from it (see Uint64n).
+	Rand io.Reader
+}
+
+// NewGenerator returns a Generator which uses crypto/rand to choose nWords from the given dictionary
+func NewGenerator(dictionary []string, nWords int, delimiter string) *Generator {
+	return &Generator{
+		Words:     dictionary,
+		Count:     nWords,
+		Delimiter: delimiter,
+	}
+}
+
+// Validate returns ErrEmptyList, ErrBadWordCount or ErrBadLength if the generator cannot produce any passphrases
+func (g *Generator) Validate() error {
+	if len(g.Words) == 0 {
+		return ErrEmptyList
+	}
+	if g.Count < 1 {
+		return ErrBadWordCount
+	}
+	if g.Lengths.Unbounded() {
+		return nil
+	}
+	if g.Lengths.Max > 0 && g.Lengths.Max < g.Lengths.Min {
+		return fmt.Errorf("%w (the maximum length %d is shorter than the minimum length %d)", ErrBadLength, g.Lengths.Max, g.Lengths.Min)
+	}
+	if err := g.checkCountable(); err != nil {
+		return err
+	}
+	if g.Admissible().Sign() == 0 {
+		return ErrBadLength
+	}
+	return nil
+}
+
+// checkCountable returns ErrBadLength if Lengths lets some phrases of Count words through but not all, and Count is
+// more than MaxWordsInRange, so that counting the ones which fit would take too long
+func (g *Generator) checkCountable() error {
+	if g.Count <= MaxWordsInRange {
+		return nil
+	}
+	if all, none := g.Lengths.fits(g.Words, g.Count, g.Delimiter); !all && !none {
+		return fmt.Errorf("%w (a length range which only some phrases fit can be applied to at most %d words)", ErrBadLength, MaxWordsInRange)
+	}
+	return nil
+}
+
+// Indices randomly chooses the positions in Words of the words of a new passphrase. When Lengths is set, entire
+// phrases are drawn until one fits, so the result is uniformly distributed over the set of admissible phrases.
+// Counting the phrases which fit is too slow to repeat for every phrase, so Indices only returns the errors which are
+// cheap to find; call Validate once first, since Indices never returns if no phrase fits Lengths. Errors from the
+// source of randomness are wrapped in ErrRandomness.
+func (g *Generator) Indices() ([]int, error) {
+	if len(g.Words) == 0 {
+		return nil, ErrEmptyList
+	}
+	if g.Count < 1 {
+		return nil, ErrBadWordCount
+	}
+	indices := make([]int, g.Count)
+	src := sourceFor(g.Rand)
+	dictLen := uint64(len(g.Words))
+
+	for {
+		for w := range indices {
+			wordIndex, err := uniform(src, dictLen)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrRandomness, err)
+			}
+			indices[w] = int(wordIndex)
+		}
+		if g.Lengths.Unbounded() || g.Lengths.Contains(utf8.RuneCountInString(g.Join(indices))) {
+			return indices, nil
+		}
+	}
+}
+
+// Join returns the words at the given positions in Words joined by Delimiter
+func (g *Generator) Join(indices []int) string {
+	phrase := make([]string, len(indices))
+	for i, wordIndex := range indices {
+		phrase[i] = g.Words[wordIndex]
+	}
+	return strings.Join(phrase, g.Delimiter)
+}
+
+// Phrase returns a new randomly chosen passphrase. As with Indices, call Validate first when Lengths is set.
+func (g *Generator) Phrase() (string, error) {
+	indices, err := g.Indices()
+	if err != nil {
+		return "", err
+	}
+	return g.Join(indices), nil
+}
+
+// Admissible returns the number of distinct passphrases the generator can produce
+func (g *Generator) Admissible() *big.Int {
+	if g.Lengths.Unbounded() {
+		return CountPhrases(len(g.Words), g.Count)
+	}
+	return CountPhrasesInRange(g.Words, g.Count, g.Delimiter, g.Lengths)
+}
+
+// Entropy returns the bits of entropy in each passphrase the generator produces
+func (g *Generator) Entropy() float64 {
+	if g.Lengths.Unbounded() {
+		return Entropy(len(g.Words), g.Count)
+	}
+	return Log2(g.Admissible())
+}
+
+// CountForBits returns the smallest Count which would give the generator's passphrases at least the given bits of
+// entropy, taking Lengths into account. It returns ErrBadBits if the list is too short to provide entropy,
+// ErrBadTarget if bits is NaN or would need more than MaxWordsForBits words, and ErrBadLength if Lengths.Max cuts off
+// the phrases before they are long enough to be that strong.
+func (g *Generator) CountForBits(bits float64) (int, error) {
+	if len(g.Words) == 0 {
+		return 0, ErrEmptyList
+	}
+	// the unconstrained count is a lower bound, since limiting the length only ever removes phrases
+	count := WordsForBits(len(g.Words), bits)
+	if count < 0 {
+		if len(g.Words) < 2 {
+			return 0, ErrBadBits
+		}
+		return 0, fmt.Errorf("%w (%g bits)", ErrBadTarget, bits)
+	}
+	if count < 1 {
+		count = 1
+	}
+	if g.Lengths.Unbounded() {
+		return count, nil
+	}
+
+	trial := *g
+	for ; ; count++ {
+		if shortest, _ := phraseLengths(g.Words, count, g.Delimiter); g.Lengths.Max > 0 && shortest > g.Lengths.Max {
+			return 0, fmt.Errorf("%w (no phrase short enough has %g bits of entropy)", ErrBadLength, bits)
+		}
+		trial.Count = count
+		if err := trial.checkCountable(); err != nil {
+			return 0, err
+		}
+		if trial.Entropy() >= bits {
+			return count, nil
+		}
+	}
+}
+
+// GenPassphraseE randomly chooses nWords from the given dictionary and returns them joined by the given delimiter. It
+// returns ErrEmptyList, ErrBadWordCount or ErrRandomness rather than panicking.
+func GenPassphraseE(dictionary []string, nWords int, delimiter string) (string, error) {
+	return NewGenerator(dictionary, nWords, delimiter).Phrase()
+}
+
+// GenPassphrase randomly chooses nWords from the given dictionary and returns them joined by the given delimiter. It
+// panics on any of the errors GenPassphraseE would return.
+func GenPassphrase(dictionary []string, nWords int, delimiter string) string {
+	phrase, err := GenPassphraseE(dictionary, nWords, delimiter)
+	if err != nil {
+		panic(err)
+	}
+	return phrase
+}
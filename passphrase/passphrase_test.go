@@ -0,0 +1,314 @@
+package passphrase
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var expectedListSizes = map[string]int{
+	"eff":         7776,
+	"memorable":   1296,
+	"touchscreen": 1296,
+	"got":         3996,
+	"potter":      3998,
+	"trek":        3998,
+	"wars":        3993,
+}
+
+// builtIn returns the named built-in word list, which must exist
+func builtIn(name string) []string {
+	list, ok := Lookup(name)
+	if !ok {
+		panic("no built-in word list named " + name)
+	}
+	return list
+}
+
+func TestMain(t *testing.T) {
+	// ensure that all expected word lists are loaded and have the proper length
+	for name, expectedSize := range expectedListSizes {
+		list, ok := Lookup(name)
+		if !ok {
+			t.Errorf("No word list named \"%s\" was found", name)
+		}
+		actualSize := len(list)
+		if actualSize != expectedSize {
+			t.Errorf("Word list \"%s\" was not expected size. want: %d, got: %d", name, expectedSize, actualSize)
+		}
+	}
+	// and that the registry lists exactly those
+	if len(WordLists) != len(expectedListSizes) {
+		t.Errorf("Unexpected number of word lists. want: %d, got: %d", len(expectedListSizes), len(WordLists))
+	}
+	for _, list := range WordLists {
+		if expectedSize, ok := expectedListSizes[list.Name]; !ok || list.Len() != expectedSize {
+			t.Errorf("Word list \"%s\" has an unexpected size of %d", list.Name, list.Len())
+		}
+	}
+}
+
+func TestGenPassphrase(t *testing.T) {
+	words := []string{
+		"one",
+		"two",
+		"three",
+		"four",
+		"five",
+		"six",
+		"seven",
+		"eight",
+		"nine",
+		"ten",
+	}
+	// GenPassphrase reads crypto/rand, so this only checks the shape of its phrases; uniformity_test.go checks the
+	// distribution of the words with a deterministic source
+	for i := 0; i < 1000; i++ {
+		phrase := GenPassphrase(words, 10, " ")
+		phraseLen := len(phrase)
+		// these phrase lengths should all be 39-59 characters
+		// (wordlen * 10 words) + 9 inter-word spaces
+		// wordlen is between 3 and 5, as in len("one") and len("three")
+		if phraseLen < 39 || phraseLen > 59 {
+			t.Errorf("expected test passphrase \"%s\" length 39-59 chars, got: %d chars", phrase, phraseLen)
+		}
+
+		returnedWords := strings.Split(phrase, " ")
+		if len(returnedWords) != 10 {
+			t.Errorf("expected 10 words in \"%s\", got: %d", phrase, len(returnedWords))
+		}
+		for _, word := range returnedWords {
+			if !strings.Contains(" "+strings.Join(words, " ")+" ", " "+word+" ") {
+				t.Errorf("unexpected word \"%s\" in \"%s\"", word, phrase)
+			}
+		}
+	}
+}
+
+func TestEntropy(t *testing.T) {
+	// 7776 == 6^5, so each eff word is worth exactly log2(6^5) bits
+	wantBits := 6 * 5 * math.Log2(6)
+	if gotBits := Entropy(7776, 6); math.Abs(gotBits-wantBits) > 1e-9 {
+		t.Errorf("unexpected entropy for 6 eff words. want: %f, got: %f", wantBits, gotBits)
+	}
+	if gotBits := Entropy(7776, 0); gotBits != 0 {
+		t.Errorf("expected zero entropy for zero words, got: %f", gotBits)
+	}
+
+	tests := []struct {
+		listLen int
+		bits    float64
+		want    int
+	}{
+		{7776, 64, 5},
+		{7776, 80, 7},
+		{1296, 80, 8},
+		{3993, 128, 11},
+		{1024, 80, 8},
+		{1, 64, -1},
+		{7776, math.NaN(), -1},
+		{7776, math.Inf(1), -1},
+		{7776, 1e30, -1},
+		{7776, -5, 0},
+		{7776, math.Inf(-1), 0},
+	}
+	for _, test := range tests {
+		if got := WordsForBits(test.listLen, test.bits); got != test.want {
+			t.Errorf("WordsForBits(%d, %g) want: %d, got: %d", test.listLen, test.bits, test.want, got)
+		}
+	}
+}
+
+func TestCountPhrasesInRange(t *testing.T) {
+	words := []string{"a", "bb", "ccc", "dddd", "ee"}
+	nWords := 3
+	lengths := LengthRange{Min: 7, Max: 9}
+
+	// count the admissible phrases the slow way and compare
+	want := 0
+	for _, w1 := range words {
+		for _, w2 := range words {
+			for _, w3 := range words {
+				if lengths.Contains(len(w1 + "-" + w2 + "-" + w3)) {
+					want++
+				}
+			}
+		}
+	}
+	got := CountPhrasesInRange(words, nWords, "-", lengths)
+	if got.Int64() != int64(want) {
+		t.Errorf("unexpected count of admissible phrases. want: %d, got: %s", want, got)
+	}
+
+	// without limits every phrase is admissible
+	all := CountPhrasesInRange(builtIn("eff"), 6, " ", LengthRange{})
+	if all.Cmp(CountPhrases(7776, 6)) != 0 {
+		t.Errorf("expected an unbounded range to admit every phrase, got: %s", all)
+	}
+	if bits := Log2(all); math.Abs(bits-Entropy(7776, 6)) > 1e-9 {
+		t.Errorf("Log2 of the phrase count disagrees with Entropy. want: %f, got: %f", Entropy(7776, 6), bits)
+	}
+
+	// ranges which every phrase fits, or none does, are answered without counting, however many words there are
+	if got := CountPhrasesInRange(builtIn("eff"), 3000, " ", LengthRange{Min: 10}); got.Cmp(CountPhrases(7776, 3000)) != 0 {
+		t.Errorf("expected every 3000 word phrase to be at least 10 characters long")
+	}
+	if got := CountPhrasesInRange(builtIn("eff"), 3000, " ", LengthRange{Max: 10000}); got.Sign() != 0 {
+		t.Errorf("expected no 3000 word phrase to fit in 10000 characters, got: %s", got)
+	}
+	g := &Generator{Words: builtIn("eff"), Count: MaxWordsInRange + 1, Delimiter: " ", Lengths: LengthRange{Min: 800}}
+	if err := g.Validate(); !errors.Is(err, ErrBadLength) {
+		t.Errorf("expected ErrBadLength for a range cutting through %d word phrases, got: %v", g.Count, err)
+	}
+	if _, err := (&Generator{Words: builtIn("eff"), Delimiter: " ", Lengths: LengthRange{Max: 5000}}).CountForBits(10000); !errors.Is(err, ErrBadLength) {
+		t.Errorf("expected ErrBadLength for a range cutting through the phrases strong enough, got: %v", err)
+	}
+
+	g = &Generator{Words: words, Count: nWords, Delimiter: "-", Lengths: lengths}
+	if g.Admissible().Int64() != int64(want) {
+		t.Errorf("unexpected number of admissible phrases from the generator. want: %d, got: %s", want, g.Admissible())
+	}
+	for i := 0; i < 1000; i++ {
+		phrase, err := g.Phrase()
+		if err != nil {
+			t.Fatalf("unexpected error generating a passphrase: %s", err)
+		}
+		if !lengths.Contains(len(phrase)) {
+			t.Fatalf("generated passphrase \"%s\" is outside the range %+v", phrase, lengths)
+		}
+	}
+}
+
+func TestCountForBits(t *testing.T) {
+	tests := []struct {
+		list    string
+		bits    float64
+		lengths LengthRange
+		want    int
+	}{
+		{"eff", 77, LengthRange{}, 6},
+		{"eff", 80, LengthRange{}, 7},
+		{"memorable", 77, LengthRange{}, 8},
+		{"wars", 128, LengthRange{}, 11},
+		{"eff", 0, LengthRange{}, 1},
+		// limiting the length removes phrases, so it can take more words to reach the same strength
+		{"eff", 80, LengthRange{Max: 45}, 7},
+		{"eff", 80, LengthRange{Max: 40}, 8},
+	}
+	for _, test := range tests {
+		g := &Generator{Words: builtIn(test.list), Delimiter: " ", Lengths: test.lengths}
+		got, err := g.CountForBits(test.bits)
+		if err != nil {
+			t.Errorf("CountForBits(%g) for the %s list with lengths %+v returned an error: %s", test.bits, test.list, test.lengths, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("CountForBits(%g) for the %s list with lengths %+v want: %d, got: %d", test.bits, test.list, test.lengths, test.want, got)
+		}
+		// the count is the smallest which is strong enough
+		g.Count = got
+		if g.Entropy() < test.bits {
+			t.Errorf("%d words from the %s list give only %f bits", got, test.list, g.Entropy())
+		}
+		if g.Count--; g.Count > 0 && g.Entropy() >= test.bits {
+			t.Errorf("%d words from the %s list already give %f bits", g.Count, test.list, g.Entropy())
+		}
+	}
+
+	g := &Generator{Words: builtIn("eff"), Delimiter: " ", Lengths: LengthRange{Max: 30}}
+	if _, err := g.CountForBits(80); !errors.Is(err, ErrBadLength) {
+		t.Errorf("expected ErrBadLength when phrases must be too short, got: %v", err)
+	}
+	if _, err := (&Generator{Words: []string{"only"}}).CountForBits(10); !errors.Is(err, ErrBadBits) {
+		t.Errorf("expected ErrBadBits for a one word list, got: %v", err)
+	}
+	for _, bits := range []float64{1e30, math.Inf(1), math.NaN()} {
+		if _, err := (&Generator{Words: builtIn("eff")}).CountForBits(bits); !errors.Is(err, ErrBadTarget) {
+			t.Errorf("expected ErrBadTarget for %g bits, got: %v", bits, err)
+		}
+	}
+	if _, err := (&Generator{}).CountForBits(10); !errors.Is(err, ErrEmptyList) {
+		t.Errorf("expected ErrEmptyList for an empty list, got: %v", err)
+	}
+}
+
+func TestGeneratorRand(t *testing.T) {
+	words := []string{"zero", "one", "two", "three"}
+	// each index is the top two bits of eight big-endian bytes, since 2^64 is a multiple of 4 and nothing is rejected
+	g := &Generator{
+		Words:     words,
+		Count:     4,
+		Delimiter: "+",
+		Rand:      bytes.NewReader(indexBytes(2, 3, 1, 0, 2)),
+	}
+	indices, err := g.Indices()
+	if err != nil {
+		t.Fatalf("unexpected error choosing indices: %s", err)
+	}
+	if want := []int{3, 1, 0, 2}; !reflect.DeepEqual(indices, want) {
+		t.Errorf("unexpected indices. want: %v, got: %v", want, indices)
+	}
+	if phrase := g.Join(indices); phrase != "three+one+zero+two" {
+		t.Errorf("unexpected phrase: \"%s\"", phrase)
+	}
+	if bits := g.Entropy(); bits != 8 {
+		t.Errorf("expected 8 bits of entropy, got: %f", bits)
+	}
+
+	// the reader is now exhausted, so generation must fail rather than panic
+	if _, err := g.Phrase(); err == nil {
+		t.Errorf("expected an error from an exhausted randomness source")
+	}
+}
+
+// indexBytes returns the bytes which make a Generator choose the given indices from a list of 2^bits words
+func indexBytes(bits uint, indices ...uint64) []byte {
+	var out []byte
+	for _, index := range indices {
+		out = binary.BigEndian.AppendUint64(out, index<<(64-bits))
+	}
+	return out
+}
+
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) {
+	return 0, errors.New("entropy pool on fire")
+}
+
+func TestGenPassphraseE(t *testing.T) {
+	if _, err := GenPassphraseE(nil, 6, " "); !errors.Is(err, ErrEmptyList) {
+		t.Errorf("expected ErrEmptyList for an empty list, got: %v", err)
+	}
+	for _, nWords := range []int{0, -1} {
+		if _, err := GenPassphraseE(builtIn("eff"), nWords, " "); !errors.Is(err, ErrBadWordCount) {
+			t.Errorf("expected ErrBadWordCount for %d words, got: %v", nWords, err)
+		}
+	}
+
+	g := &Generator{Words: builtIn("eff"), Count: 3, Delimiter: " ", Lengths: LengthRange{Max: 10}}
+	if err := g.Validate(); !errors.Is(err, ErrBadLength) {
+		t.Errorf("expected ErrBadLength when no phrase can fit, got: %v", err)
+	}
+	g.Lengths = LengthRange{Min: 30, Max: 20}
+	if err := g.Validate(); !errors.Is(err, ErrBadLength) {
+		t.Errorf("expected ErrBadLength for an inverted range, got: %v", err)
+	}
+
+	g = &Generator{Words: builtIn("eff"), Count: 6, Delimiter: " ", Rand: failingReader{}}
+	if _, err := g.Phrase(); !errors.Is(err, ErrRandomness) {
+		t.Errorf("expected ErrRandomness from a failing reader, got: %v", err)
+	}
+
+	phrase, err := GenPassphraseE(builtIn("eff"), 6, " ")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if words := strings.Split(phrase, " "); len(words) != 6 {
+		t.Errorf("expected 6 words in \"%s\", got: %d", phrase, len(words))
+	}
+}
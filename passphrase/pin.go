@@ -0,0 +1,241 @@
+package passphrase
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+)
+
+// CommonPINs are some of the most frequently chosen PINs, from published analyses of leaked PINs: the twenty most
+// common four digit PINs, and the most common six digit ones
+var CommonPINs = []string{
+	"1234", "1111", "0000", "1212", "7777", "1004", "2000", "4444", "2222", "6969",
+	"9999", "3333", "5555", "6666", "1122", "1313", "8888", "4321", "2001", "1010",
+	"123456", "654321", "111111", "000000", "123123", "666666", "121212", "112233", "789456", "159753",
+	"987654", "222222", "333333", "444444", "555555", "777777", "888888", "999999",
+}
+
+// PINGenerator produces numeric PINs of Length digits chosen uniformly from those satisfying its rules. It keeps the
+// counts it chooses PINs with between calls to PIN, so it isn't safe for concurrent use.
+type PINGenerator struct {
+	// Length is the number of digits in each PIN
+	Length int
+	// NoRepeats forbids a digit from following itself, as in 1123
+	NoRepeats bool
+	// NoRuns forbids three or more digits counting up or down in a row, as in 1235 or 8761
+	NoRuns bool
+	// NoCommon forbids the PINs in CommonPINs
+	NoCommon bool
+	// Rand is the source of randomness, as in Generator
+	Rand io.Reader
+
+	counted *pinCounts
+}
+
+// pinCounts are the completions of PINs of some length under some rules, and the number of PINs they add up to
+type pinCounts struct {
+	length      int
+	noRepeats   bool
+	noRuns      bool
+	completions [][10][3]*big.Int
+	total       *big.Int
+}
+
+// step describes how one digit of a PIN follows the one before it, for the NoRuns rule. The digits may be either
+// characters or their values.
+type step int
+
+const (
+	stepOther step = iota
+	stepUp
+	stepDown
+)
+
+func pinStep(previous, next byte) step {
+	switch {
+	case next == previous+1:
+		return stepUp
+	case next+1 == previous:
+		return stepDown
+	}
+	return stepOther
+}
+
+// followsRules reports whether the PIN satisfies the rules other than NoCommon
+func (p *PINGenerator) followsRules(pin []byte) bool {
+	last := stepOther
+	for i := 1; i < len(pin); i++ {
+		if p.NoRepeats && pin[i] == pin[i-1] {
+			return false
+		}
+		s := pinStep(pin[i-1], pin[i])
+		if p.NoRuns && s != stepOther && s == last {
+			return false
+		}
+		last = s
+	}
+	return true
+}
+
+// blocked reports whether the PIN is one of CommonPINs and those are forbidden
+func (p *PINGenerator) blocked(pin string) bool {
+	if !p.NoCommon {
+		return false
+	}
+	for _, common := range CommonPINs {
+		if pin == common {
+			return true
+		}
+	}
+	return false
+}
+
+// follows reports whether the digit next may follow the digit previous, which was reached by a step of kind last,
+// under NoRepeats and NoRuns, and the kind of step it would be. The digits are values from 0 to 9.
+func (p *PINGenerator) follows(previous byte, last step, next byte) (step, bool) {
+	if p.NoRepeats && next == previous {
+		return stepOther, false
+	}
+	s := pinStep(previous, next)
+	if p.NoRuns && s != stepOther && s == last {
+		return s, false
+	}
+	return s, true
+}
+
+// completions returns, for each k less than Length, the number of ways to follow a digit d, reached by a step of kind
+// s, with k more digits under NoRepeats and NoRuns, as completions[k][d][s]. A PIN's first digit is reached by
+// stepOther. These counts are both how Admissible counts the PINs and how PIN chooses one.
+func (p *PINGenerator) completions() [][10][3]*big.Int {
+	completions := make([][10][3]*big.Int, p.Length)
+	for d := range completions[0] {
+		for s := range completions[0][d] {
+			completions[0][d][s] = big.NewInt(1)
+		}
+	}
+	for k := 1; k < p.Length; k++ {
+		for d := byte(0); d < 10; d++ {
+			for s := stepOther; s <= stepDown; s++ {
+				ways := new(big.Int)
+				for e := byte(0); e < 10; e++ {
+					if t, ok := p.follows(d, s, e); ok {
+						ways.Add(ways, completions[k-1][e][t])
+					}
+				}
+				completions[k][d][s] = ways
+			}
+		}
+	}
+	return completions
+}
+
+// followingRules returns the number of PINs which satisfy NoRepeats and NoRuns, given their completions
+func followingRules(completions [][10][3]*big.Int) *big.Int {
+	total := new(big.Int)
+	for _, ways := range completions[len(completions)-1] {
+		total.Add(total, ways[stepOther])
+	}
+	return total
+}
+
+// Admissible returns the number of distinct PINs the generator can produce. It counts the PINs which satisfy
+// NoRepeats and NoRuns with dynamic programming over the last digit and how it followed the one before, then takes
+// away the common PINs which would otherwise be allowed.
+func (p *PINGenerator) Admissible() *big.Int {
+	if p.Length < 1 {
+		return new(big.Int)
+	}
+	total := followingRules(p.completions())
+	if p.NoCommon {
+		for _, common := range CommonPINs {
+			if len(common) == p.Length && p.followsRules([]byte(common)) {
+				total.Sub(total, big.NewInt(1))
+			}
+		}
+	}
+	return total
+}
+
+// Entropy returns the bits of entropy in each PIN the generator produces
+func (p *PINGenerator) Entropy() float64 {
+	return Log2(p.Admissible())
+}
+
+// MaxPINLength is the most digits a PIN may have, far beyond anything a PIN pad accepts
+const MaxPINLength = 1000
+
+// Validate returns ErrBadLength if the generator cannot produce any PINs. The rules leave plenty of PINs of any
+// length, so that only happens when Length is less than 1, or more than MaxPINLength.
+func (p *PINGenerator) Validate() error {
+	if p.Length < 1 {
+		return fmt.Errorf("%w (a PIN needs at least one digit)", ErrBadLength)
+	}
+	if p.Length > MaxPINLength {
+		return fmt.Errorf("%w (a PIN has at most %d digits)", ErrBadLength, MaxPINLength)
+	}
+	return nil
+}
+
+// LengthForBits returns the smallest Length which gives each PIN at least the given bits of entropy under the
+// generator's rules. It returns ErrBadTarget if bits is NaN or more than MaxPINLength digits would be needed.
+func (p *PINGenerator) LengthForBits(bits float64) (int, error) {
+	// no PIN has more than log2(10) bits per digit, so a shorter one can't reach the target
+	shortest := math.Ceil(bits / math.Log2(10))
+	if math.IsNaN(shortest) || shortest > MaxPINLength {
+		return 0, fmt.Errorf("%w (%g bits)", ErrBadTarget, bits)
+	}
+	trial := *p
+	for trial.Length = int(math.Max(shortest, 1)); trial.Length <= MaxPINLength; trial.Length++ {
+		if trial.Entropy() >= bits {
+			return trial.Length, nil
+		}
+	}
+	return 0, fmt.Errorf("%w (%g bits would need more than %d digits)", ErrBadTarget, bits, MaxPINLength)
+}
+
+// PIN returns a new random PIN, uniformly distributed over the allowed PINs. It draws a number below the count of
+// PINs which satisfy NoRepeats and NoRuns and walks the digits to the PIN with that position among them, so those rules
+// never cause a redraw. A common PIN forbidden by NoCommon is redrawn, which is rare since at most len(CommonPINs) of
+// the thousands of PINs are blocked. Errors from the source of randomness are wrapped in ErrRandomness.
+func (p *PINGenerator) PIN() (string, error) {
+	if err := p.Validate(); err != nil {
+		return "", err
+	}
+	src := sourceFor(p.Rand)
+	if c := p.counted; c == nil || c.length != p.Length || c.noRepeats != p.NoRepeats || c.noRuns != p.NoRuns {
+		completions := p.completions()
+		p.counted = &pinCounts{p.Length, p.NoRepeats, p.NoRuns, completions, followingRules(completions)}
+	}
+	completions, total := p.counted.completions, p.counted.total
+	pin := make([]byte, p.Length)
+	for {
+		position, err := uniformBig(src, total)
+		if err != nil {
+			return "", fmt.Errorf("%w: %v", ErrRandomness, err)
+		}
+		previous, last := byte(0), stepOther
+		for i := range pin {
+			remaining := completions[p.Length-1-i]
+			for digit := byte(0); digit < 10; digit++ {
+				s, ok := stepOther, true
+				if i > 0 {
+					s, ok = p.follows(previous, last, digit)
+				}
+				if !ok {
+					continue
+				}
+				// skip past the PINs which continue with this digit unless the position is among them
+				if ways := remaining[digit][s]; position.Cmp(ways) >= 0 {
+					position.Sub(position, ways)
+					continue
+				}
+				pin[i], previous, last = '0'+digit, digit, s
+				break
+			}
+		}
+		if !p.blocked(string(pin)) {
+			return string(pin), nil
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package passphrase
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	mathrand "math/rand"
+	"strconv"
+	"testing"
+)
+
+// bruteForceAdmissible counts the PINs the generator allows by checking every one
+func bruteForceAdmissible(p *PINGenerator) int64 {
+	limit := 1
+	for i := 0; i < p.Length; i++ {
+		limit *= 10
+	}
+	var count int64
+	pin := make([]byte, p.Length)
+	for n := 0; n < limit; n++ {
+		for i, rest := len(pin)-1, n; i >= 0; i, rest = i-1, rest/10 {
+			pin[i] = '0' + byte(rest%10)
+		}
+		if p.followsRules(pin) && !p.blocked(string(pin)) {
+			count++
+		}
+	}
+	return count
+}
+
+func TestPINAdmissible(t *testing.T) {
+	for length := 1; length <= 6; length++ {
+		for rules := 0; rules < 8; rules++ {
+			p := &PINGenerator{Length: length, NoRepeats: rules&1 != 0, NoRuns: rules&2 != 0, NoCommon: rules&4 != 0}
+			if got, want := p.Admissible(), big.NewInt(bruteForceAdmissible(p)); got.Cmp(want) != 0 {
+				t.Errorf("unexpected count of PINs for %+v. want: %s, got: %s", *p, want, got)
+			}
+		}
+	}
+
+	// most common PINs already break the other rules; 1212, 6969, 1313 and 1010 don't
+	p := &PINGenerator{Length: 4, NoRepeats: true, NoRuns: true}
+	before := p.Admissible()
+	p.NoCommon = true
+	if got := new(big.Int).Sub(before, p.Admissible()); got.Cmp(big.NewInt(4)) != 0 {
+		t.Errorf("expected the blocklist to remove 4 PINs which the other rules allow, got: %s", got)
+	}
+}
+
+func TestPINValidate(t *testing.T) {
+	if err := (&PINGenerator{}).Validate(); !errors.Is(err, ErrBadLength) {
+		t.Errorf("expected ErrBadLength for no digits, got: %v", err)
+	}
+	if err := (&PINGenerator{Length: 1, NoRepeats: true, NoRuns: true, NoCommon: true}).Validate(); err != nil {
+		t.Errorf("unexpected error for a one digit PIN: %s", err)
+	}
+	if err := (&PINGenerator{Length: MaxPINLength + 1}).Validate(); !errors.Is(err, ErrBadLength) {
+		t.Errorf("expected ErrBadLength for %d digits, got: %v", MaxPINLength+1, err)
+	}
+	if _, err := (&PINGenerator{Length: 4, Rand: failingReader{}}).PIN(); !errors.Is(err, ErrRandomness) {
+		t.Errorf("expected ErrRandomness from a failing source, got: %v", err)
+	}
+}
+
+func TestPINLengthForBits(t *testing.T) {
+	tests := []struct {
+		p    PINGenerator
+		bits float64
+		want int
+	}{
+		{PINGenerator{}, 13, 4},
+		{PINGenerator{}, 13.3, 5},
+		{PINGenerator{}, 0, 1},
+		// the rules take away enough that 20 bits needs a seventh digit
+		{PINGenerator{NoRepeats: true, NoRuns: true, NoCommon: true}, 20, 7},
+	}
+	for _, test := range tests {
+		if got, err := test.p.LengthForBits(test.bits); err != nil || got != test.want {
+			t.Errorf("LengthForBits(%.1f) for %+v want: %d, got: %d (error: %v)", test.bits, test.p, test.want, got, err)
+		}
+	}
+
+	// targets no length reaches must fail rather than search forever
+	for _, bits := range []float64{math.NaN(), math.Inf(1), 1e30, 3200} {
+		if _, err := (&PINGenerator{NoRepeats: true}).LengthForBits(bits); !errors.Is(err, ErrBadTarget) {
+			t.Errorf("LengthForBits(%g) want: ErrBadTarget, got: %v", bits, err)
+		}
+	}
+}
+
+func TestPINLong(t *testing.T) {
+	// a whole PIN this long follows the rules with probability about 0.9^999, so it can't be found by redrawing
+	p := &PINGenerator{Length: MaxPINLength, NoRepeats: true, NoRuns: true, NoCommon: true}
+	pin, err := p.PIN()
+	if err != nil {
+		t.Fatalf("unexpected error generating a PIN: %s", err)
+	}
+	if len(pin) != MaxPINLength || !p.followsRules([]byte(pin)) {
+		t.Errorf("%s breaks the rules", pin)
+	}
+}
+
+func TestPINUniform(t *testing.T) {
+	p := &PINGenerator{Length: 4, NoRepeats: true, NoRuns: true, NoCommon: true, Rand: mathrand.New(mathrand.NewSource(11))}
+	n := int(p.Admissible().Int64())
+	counts := make([]int, 10000)
+	for i := 0; i < 20*n; i++ {
+		pin, err := p.PIN()
+		if err != nil {
+			t.Fatalf("unexpected error generating a PIN: %s", err)
+		}
+		if len(pin) != 4 || !p.followsRules([]byte(pin)) || p.blocked(pin) {
+			t.Fatalf("%s breaks the rules", pin)
+		}
+		value, _ := strconv.Atoi(pin)
+		counts[value]++
+	}
+
+	admissible := make([]int, 0, n)
+	for value, count := range counts {
+		pin := fmt.Sprintf("%04d", value)
+		if p.followsRules([]byte(pin)) && !p.blocked(pin) {
+			admissible = append(admissible, count)
+		}
+	}
+	if len(admissible) != n {
+		t.Fatalf("expected %d admissible PINs, found %d", n, len(admissible))
+	}
+	checkFit(t, "the PINs", admissible, 1)
+}
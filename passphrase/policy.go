@@ -0,0 +1,278 @@
+package passphrase
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrBadPolicy is returned when a password policy can't be met, for instance because it forbids every digit but
+// requires one
+var ErrBadPolicy = errors.New("the password policy can't be satisfied")
+
+// DefaultSymbols are the symbols a Policy chooses delimiters from when it doesn't list its own
+const DefaultSymbols = "!#$%&*+-=?@^_~"
+
+// Policy describes the rules a password validator enforces. It can be read from a JSON file with LoadPolicy.
+type Policy struct {
+	// Upper, Digit and Symbol require at least one uppercase letter, digit or symbol
+	Upper  bool `json:"upper,omitempty"`
+	Digit  bool `json:"digit,omitempty"`
+	Symbol bool `json:"symbol,omitempty"`
+	// MinLength and MaxLength limit the length of the password, as in LengthRange
+	MinLength int `json:"min_length,omitempty"`
+	MaxLength int `json:"max_length,omitempty"`
+	// Forbidden lists characters which mustn't appear anywhere in the password
+	Forbidden string `json:"forbidden,omitempty"`
+	// Symbols lists the symbols to choose from when Symbol is set; DefaultSymbols is used when it's empty
+	Symbols string `json:"symbols,omitempty"`
+}
+
+// LoadPolicy reads a policy from a JSON file such as {"upper": true, "digit": true, "max_length": 40}
+func LoadPolicy(filename string) (Policy, error) {
+	var p Policy
+	f, err := os.Open(filename)
+	if err != nil {
+		return p, err
+	}
+	defer f.Close()
+
+	decoder := json.NewDecoder(f)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&p); err != nil {
+		return p, fmt.Errorf("%s: %w", filename, err)
+	}
+	return p, nil
+}
+
+// Transforms reports whether the policy changes passphrases, rather than only limiting their length
+func (p Policy) Transforms() bool {
+	return p.Upper || p.Digit || p.Symbol || p.Forbidden != ""
+}
+
+// Transformer turns a Generator's passphrases into passwords which satisfy a Policy. Each transformation is chosen
+// uniformly at random from the same source as the words:
+//
+//   - Upper capitalizes the first letter of one of the words
+//   - Digit appends a digit to one of the words
+//   - Symbol replaces each delimiter with a symbol
+//
+// Any of these whose choices can be read back from the password, because the words themselves contain no
+// uppercase letters, digits or symbols, add their entropy to the passphrase's; see Bits.
+type Transformer struct {
+	g       *Generator
+	policy  Policy
+	digits  []rune
+	symbols []rune
+
+	// upperCounts and digitCounts record whether capitalizing and adding a digit add entropy
+	upperCounts bool
+	digitCounts bool
+	// original holds the position each of the generator's words had in its list before Prepare filtered it
+	original []int
+}
+
+// Prepare adapts the generator to the policy and returns a Transformer for its passphrases. It drops words containing
+// forbidden characters (and, when Upper is set, words which can't be capitalized) from g.Words, narrows g.Lengths to
+// the policy's length limits less the room needed for a digit, and sets a one character placeholder delimiter when
+// Symbol is set. Call it before choosing g.Count with CountForBits, since the list may shrink.
+func (p Policy) Prepare(g *Generator) (*Transformer, error) {
+	t := &Transformer{g: g, policy: p, upperCounts: true, digitCounts: true}
+	forbidden := func(r rune) bool { return strings.ContainsRune(p.Forbidden, r) }
+
+	words := make([]string, 0, len(g.Words))
+	t.original = make([]int, 0, len(g.Words))
+	inWords := make(map[rune]bool)
+	for i, word := range g.Words {
+		if strings.IndexFunc(word, forbidden) >= 0 {
+			continue
+		}
+		if p.Upper {
+			first, _ := utf8.DecodeRuneInString(word)
+			if !unicode.IsLower(first) || forbidden(unicode.ToUpper(first)) {
+				continue
+			}
+		}
+		words = append(words, word)
+		t.original = append(t.original, i)
+		for _, r := range word {
+			inWords[r] = true
+			if unicode.IsUpper(r) {
+				t.upperCounts = false
+			}
+			if unicode.IsDigit(r) {
+				t.digitCounts = false
+			}
+		}
+	}
+	if len(words) == 0 {
+		return nil, fmt.Errorf("%w (no word in the list is allowed)", ErrBadPolicy)
+	}
+
+	if p.Digit {
+		for _, r := range "0123456789" {
+			if !forbidden(r) {
+				t.digits = append(t.digits, r)
+			}
+		}
+		if len(t.digits) == 0 {
+			return nil, fmt.Errorf("%w (every digit is forbidden)", ErrBadPolicy)
+		}
+	}
+	if p.Symbol {
+		symbols := p.Symbols
+		if symbols == "" {
+			symbols = DefaultSymbols
+		}
+		// symbols which appear in the words would make the words hard to tell apart, and other characters aren't symbols
+		for _, r := range symbols {
+			if !forbidden(r) && !inWords[r] && !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r) && !t.hasSymbol(r) {
+				t.symbols = append(t.symbols, r)
+			}
+		}
+		if len(t.symbols) == 0 {
+			return nil, fmt.Errorf("%w (no symbol is allowed)", ErrBadPolicy)
+		}
+		g.Delimiter = " "
+	} else if strings.IndexFunc(g.Delimiter, forbidden) >= 0 {
+		return nil, fmt.Errorf("%w (the delimiter %q contains a forbidden character)", ErrBadPolicy, g.Delimiter)
+	}
+
+	lengths := g.Lengths
+	if p.MinLength > lengths.Min {
+		lengths.Min = p.MinLength
+	}
+	if p.MaxLength > 0 && (lengths.Max <= 0 || p.MaxLength < lengths.Max) {
+		lengths.Max = p.MaxLength
+	}
+	if p.Digit {
+		// the digit takes one character of the limits
+		lengths.Min--
+		if lengths.Max == 1 {
+			return nil, fmt.Errorf("%w (there is no room for a word and a digit)", ErrBadLength)
+		}
+		if lengths.Max > 0 {
+			lengths.Max--
+		}
+	}
+
+	g.Words = words
+	g.Lengths = lengths
+	return t, nil
+}
+
+func (t *Transformer) hasSymbol(r rune) bool {
+	for _, symbol := range t.symbols {
+		if symbol == r {
+			return true
+		}
+	}
+	return false
+}
+
+// OriginalIndices returns the positions, in the list the generator had before Prepare, of the words at the given
+// positions in its filtered list
+func (t *Transformer) OriginalIndices(indices []int) []int {
+	original := make([]int, len(indices))
+	for i, index := range indices {
+		original[i] = t.original[index]
+	}
+	return original
+}
+
+// Bits returns the entropy the transformations add to each passphrase
+func (t *Transformer) Bits() float64 {
+	bits := 0.0
+	positions := math.Log2(float64(t.g.Count))
+	if t.policy.Upper && t.upperCounts {
+		bits += positions
+	}
+	if t.policy.Digit && t.digitCounts {
+		bits += positions + math.Log2(float64(len(t.digits)))
+	}
+	if t.policy.Symbol {
+		bits += float64(t.g.Count-1) * math.Log2(float64(len(t.symbols)))
+	}
+	return bits
+}
+
+// Entropy returns the bits of entropy in each transformed passphrase
+func (t *Transformer) Entropy() float64 {
+	return t.g.Entropy() + t.Bits()
+}
+
+// Apply returns the passphrase made of the words at the given positions in the generator's list, transformed to
+// satisfy the policy. Errors from the source of randomness are wrapped in ErrRandomness.
+func (t *Transformer) Apply(indices []int) (string, error) {
+	words, delimiters, err := t.ApplyWords(indices)
+	if err != nil {
+		return "", err
+	}
+	var phrase strings.Builder
+	for i, word := range words {
+		if i > 0 {
+			phrase.WriteString(delimiters[i-1])
+		}
+		phrase.WriteString(word)
+	}
+	return phrase.String(), nil
+}
+
+// ApplyWords is like Apply, but returns the transformed words and the delimiters between them rather than joining
+// them into a passphrase
+func (t *Transformer) ApplyWords(indices []int) (words, delimiters []string, err error) {
+	if t.policy.Symbol && len(indices) < 2 {
+		return nil, nil, fmt.Errorf("%w (symbol delimiters need at least two words)", ErrBadWordCount)
+	}
+	src := sourceFor(t.g.Rand)
+	choose := func(n int) (int, error) {
+		x, err := uniform(src, uint64(n))
+		if err != nil {
+			return 0, fmt.Errorf("%w: %v", ErrRandomness, err)
+		}
+		return int(x), nil
+	}
+
+	words = make([]string, len(indices))
+	for i, index := range indices {
+		words[i] = t.g.Words[index]
+	}
+	if t.policy.Upper {
+		i, err := choose(len(words))
+		if err != nil {
+			return nil, nil, err
+		}
+		first, size := utf8.DecodeRuneInString(words[i])
+		words[i] = string(unicode.ToUpper(first)) + words[i][size:]
+	}
+	if t.policy.Digit {
+		i, err := choose(len(words))
+		if err != nil {
+			return nil, nil, err
+		}
+		digit, err := choose(len(t.digits))
+		if err != nil {
+			return nil, nil, err
+		}
+		words[i] += string(t.digits[digit])
+	}
+
+	delimiters = make([]string, 0, len(words))
+	for i := 1; i < len(words); i++ {
+		if !t.policy.Symbol {
+			delimiters = append(delimiters, t.g.Delimiter)
+			continue
+		}
+		symbol, err := choose(len(t.symbols))
+		if err != nil {
+			return nil, nil, err
+		}
+		delimiters = append(delimiters, string(t.symbols[symbol]))
+	}
+	return words, delimiters, nil
+}
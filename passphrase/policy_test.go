@@ -0,0 +1,196 @@
+package passphrase
+
+import (
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+func TestLoadPolicy(t *testing.T) {
+	dir := t.TempDir()
+	good := filepath.Join(dir, "good.json")
+	os.WriteFile(good, []byte(`{"upper": true, "digit": true, "forbidden": "\"'", "max_length": 40}`), 0644)
+	p, err := LoadPolicy(good)
+	if err != nil {
+		t.Fatalf("unexpected error loading a policy: %s", err)
+	}
+	if want := (Policy{Upper: true, Digit: true, Forbidden: `"'`, MaxLength: 40}); p != want {
+		t.Errorf("unexpected policy. want: %+v, got: %+v", want, p)
+	}
+
+	bad := filepath.Join(dir, "bad.json")
+	os.WriteFile(bad, []byte(`{"uppercase": true}`), 0644)
+	if _, err := LoadPolicy(bad); err == nil {
+		t.Errorf("expected an error for an unknown policy field")
+	}
+}
+
+func TestPolicyPrepare(t *testing.T) {
+	g := &Generator{Words: []string{"apple", "berry", "cherry", "date", "Elder", "fig"}, Count: 3, Delimiter: " ", Lengths: LengthRange{Max: 30}}
+	p := Policy{Upper: true, Digit: true, Symbol: true, Forbidden: "y", MinLength: 10, MaxLength: 25}
+	transformer, err := p.Prepare(g)
+	if err != nil {
+		t.Fatalf("unexpected error preparing a policy: %s", err)
+	}
+	// words with a y or which can't be capitalized are gone
+	if want := []string{"apple", "date", "fig"}; !reflect.DeepEqual(g.Words, want) {
+		t.Errorf("unexpected words. want: %v, got: %v", want, g.Words)
+	}
+	// but their positions are still reported in the original list
+	if got, want := transformer.OriginalIndices([]int{2, 0, 1, 2}), []int{5, 0, 3, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected original indices. want: %v, got: %v", want, got)
+	}
+	// the stricter maximum, less a character for the digit
+	if want := (LengthRange{Min: 9, Max: 24}); g.Lengths != want {
+		t.Errorf("unexpected lengths. want: %+v, got: %+v", want, g.Lengths)
+	}
+	if g.Delimiter != " " {
+		t.Errorf("expected a one character placeholder delimiter, got: %q", g.Delimiter)
+	}
+
+	tests := []struct {
+		policy    Policy
+		delimiter string
+		want      error
+	}{
+		{Policy{Digit: true, Forbidden: "0123456789"}, " ", ErrBadPolicy},
+		{Policy{Symbol: true, Symbols: "-ab"}, " ", ErrBadPolicy},
+		{Policy{Forbidden: "aeiou"}, " ", ErrBadPolicy},
+		{Policy{Forbidden: " "}, " ", ErrBadPolicy},
+		{Policy{Digit: true, MaxLength: 1}, " ", ErrBadLength},
+		// symbol delimiters replace the forbidden one
+		{Policy{Symbol: true, Forbidden: " "}, " ", nil},
+	}
+	for _, test := range tests {
+		g := &Generator{Words: []string{"one", "two", "three", "t-shirt"}, Count: 3, Delimiter: test.delimiter}
+		if _, err := test.policy.Prepare(g); !errors.Is(err, test.want) {
+			t.Errorf("preparing %+v want: %v, got: %v", test.policy, test.want, err)
+		}
+	}
+}
+
+func TestPolicyApply(t *testing.T) {
+	p := Policy{Upper: true, Digit: true, Symbol: true, Forbidden: "$", MaxLength: 40}
+	g := seededGenerator(builtIn("eff"), 6, 17)
+	transformer, err := p.Prepare(g)
+	if err != nil {
+		t.Fatalf("unexpected error preparing a policy: %s", err)
+	}
+
+	// eff has t-shirt and the like, so the symbols are the defaults less - and the forbidden $
+	symbols := strings.NewReplacer("-", "", "$", "").Replace(DefaultSymbols)
+	wantBits := math.Log2(6) + math.Log2(6) + math.Log2(10) + 5*math.Log2(float64(len(symbols)))
+	if bits := transformer.Bits(); math.Abs(bits-wantBits) > 1e-9 {
+		t.Errorf("unexpected entropy from the transformations. want: %f, got: %f", wantBits, bits)
+	}
+	if bits := transformer.Entropy(); math.Abs(bits-(g.Entropy()+wantBits)) > 1e-9 {
+		t.Errorf("expected the transformed entropy to add to the words', got: %f", bits)
+	}
+
+	symbolCounts := make([]int, len(symbols))
+	digitCounts := make([]int, 10)
+	for i := 0; i < 2000; i++ {
+		indices, err := g.Indices()
+		if err != nil {
+			t.Fatalf("unexpected error choosing indices: %s", err)
+		}
+		phrase, err := transformer.Apply(indices)
+		if err != nil {
+			t.Fatalf("unexpected error applying the policy: %s", err)
+		}
+		if n := utf8.RuneCountInString(phrase); n > 40 {
+			t.Errorf("\"%s\" is %d characters long", phrase, n)
+		}
+		if strings.ContainsRune(phrase, '$') {
+			t.Errorf("\"%s\" contains a forbidden character", phrase)
+		}
+		if strings.IndexFunc(phrase, unicode.IsUpper) < 0 || strings.IndexFunc(phrase, unicode.IsDigit) < 0 {
+			t.Errorf("\"%s\" lacks an uppercase letter or a digit", phrase)
+		}
+		delimiters := 0
+		for _, r := range phrase {
+			if i := strings.IndexRune(symbols, r); i >= 0 {
+				symbolCounts[i]++
+				delimiters++
+			}
+			if unicode.IsDigit(r) {
+				digitCounts[r-'0']++
+			}
+		}
+		if delimiters != 5 {
+			t.Errorf("expected five symbol delimiters in \"%s\"", phrase)
+		}
+	}
+	checkFit(t, "the symbol delimiters", symbolCounts, 2)
+	checkFit(t, "the digits", digitCounts, 2)
+
+	g.Count = 1
+	if _, err := transformer.Apply([]int{0}); !errors.Is(err, ErrBadWordCount) {
+		t.Errorf("expected ErrBadWordCount for symbol delimiters in a one word phrase, got: %v", err)
+	}
+}
+
+func TestPolicyBitsNeedReadableChoices(t *testing.T) {
+	// when the words have digits of their own, an added digit can't be told apart from them, so it isn't counted
+	g := &Generator{Words: []string{"r2d2", "c3po", "bb8", "ig88"}, Count: 4, Delimiter: " "}
+	transformer, err := Policy{Upper: true, Digit: true}.Prepare(g)
+	if err != nil {
+		t.Fatalf("unexpected error preparing a policy: %s", err)
+	}
+	if bits := transformer.Bits(); bits != 2 {
+		t.Errorf("expected only the 2 bits of choosing which word to capitalize, got: %f", bits)
+	}
+}
+
+func TestPolicyOriginalIndicesBuiltIn(t *testing.T) {
+	// forbidding e leaves capricorn at position 426 of the filtered list, but it's word 835 of eff (dice code 14622)
+	eff := builtIn("eff")
+	g := NewGenerator(eff, 6, " ")
+	transformer, err := Policy{Forbidden: "e"}.Prepare(g)
+	if err != nil {
+		t.Fatalf("unexpected error preparing a policy: %s", err)
+	}
+	for i, word := range g.Words {
+		original := transformer.OriginalIndices([]int{i})[0]
+		if eff[original] != word {
+			t.Fatalf("word %q at %d of the filtered list maps to %q at %d of eff", word, i, eff[original], original)
+		}
+		if word == "capricorn" && (i != 426 || original != 835 || DiceCode(original, len(eff)) != "14622") {
+			t.Errorf("expected capricorn at 426 of the filtered list and 835 (14622) of eff, got %d and %d", i, original)
+		}
+	}
+}
+
+func TestPolicyApplyWords(t *testing.T) {
+	g := seededGenerator([]string{"t-shirt", "x-ray", "yo-yo"}, 4, 3)
+	g.Delimiter = "-"
+	transformer, err := Policy{Digit: true}.Prepare(g)
+	if err != nil {
+		t.Fatalf("unexpected error preparing a policy: %s", err)
+	}
+	indices := []int{0, 1, 2, 0}
+	words, delimiters, err := transformer.ApplyWords(indices)
+	if err != nil {
+		t.Fatalf("unexpected error applying the policy: %s", err)
+	}
+	if want := []string{"-", "-", "-"}; !reflect.DeepEqual(delimiters, want) {
+		t.Errorf("unexpected delimiters. want: %q, got: %q", want, delimiters)
+	}
+	digits := 0
+	for i, word := range words {
+		if trimmed := strings.TrimRight(word, "0123456789"); trimmed != g.Words[indices[i]] {
+			t.Errorf("unexpected word %q at %d", word, i)
+		} else if trimmed != word {
+			digits++
+		}
+	}
+	if digits != 1 {
+		t.Errorf("expected a digit on exactly one of %q", words)
+	}
+}
@@ -0,0 +1,233 @@
+package passphrase
+
+import (
+	"sort"
+	"strings"
+)
+
+// ListReport describes how well a word list meets the properties EFF designed its lists around. It is meant to be
+// encoded as JSON.
+type ListReport struct {
+	// Words is the number of entries in the list and UniqueWords the number of distinct ones
+	Words       int      `json:"words"`
+	UniqueWords int      `json:"unique_words"`
+	Duplicates  []string `json:"duplicates,omitempty"`
+
+	// PrefixFree is true when no word is the beginning of another word. PrefixExample shows a pair which isn't.
+	PrefixFree    bool     `json:"prefix_free"`
+	PrefixExample []string `json:"prefix_example,omitempty"`
+	// UniquePrefixLength is the fewest leading characters which tell every word apart (EFF's short list 2 promises
+	// three), so that software can autocomplete each word after that many characters
+	UniquePrefixLength int `json:"unique_prefix_length"`
+
+	// MinEditDistance is the smallest number of single character insertions, deletions or substitutions which turns
+	// one word of the list into another; MinEditDistancePair is a pair of words that far apart
+	MinEditDistance     int      `json:"min_edit_distance"`
+	MinEditDistancePair []string `json:"min_edit_distance_pair,omitempty"`
+
+	// UniquelyDecodable is true when every concatenation of words (a passphrase with an empty delimiter) can be split
+	// back into words in only one way. AmbiguousExample gives two different word sequences with the same
+	// concatenation when it's false.
+	UniquelyDecodable bool       `json:"uniquely_decodable"`
+	AmbiguousExample  [][]string `json:"ambiguous_example,omitempty"`
+}
+
+// ValidateList checks the given word list for uniqueness, prefix-freeness, unique prefix length, minimum edit
+// distance and unique decodability. Words are compared byte by byte, which suits ASCII lists such as the ones
+// ReadWordList accepts.
+func ValidateList(words []string) *ListReport {
+	report := &ListReport{Words: len(words)}
+
+	seen := make(map[string]bool, len(words))
+	unique := make([]string, 0, len(words))
+	for _, word := range words {
+		if seen[word] {
+			report.Duplicates = append(report.Duplicates, word)
+			continue
+		}
+		seen[word] = true
+		unique = append(unique, word)
+	}
+	sort.Strings(unique)
+	report.UniqueWords = len(unique)
+
+	checkPrefixes(report, unique)
+	checkEditDistance(report, unique)
+	if len(report.Duplicates) > 0 {
+		duplicate := report.Duplicates[0]
+		report.AmbiguousExample = [][]string{{duplicate}, {duplicate}}
+	} else {
+		report.AmbiguousExample = ambiguousConcatenation(unique, seen)
+		report.UniquelyDecodable = report.AmbiguousExample == nil
+	}
+	return report
+}
+
+// checkPrefixes fills in the prefix properties of the report for the given sorted, duplicate-free words. In sorted
+// order any word which begins another word is followed directly by a word it begins, and the longest prefix
+// shared by any two words is shared by some adjacent pair.
+func checkPrefixes(report *ListReport, sorted []string) {
+	report.PrefixFree = true
+	if len(sorted) > 0 {
+		report.UniquePrefixLength = 1
+	}
+	for i := 1; i < len(sorted); i++ {
+		previous, word := sorted[i-1], sorted[i]
+		if report.PrefixFree && strings.HasPrefix(word, previous) {
+			report.PrefixFree = false
+			report.PrefixExample = []string{previous, word}
+		}
+		if shared := commonPrefixLength(previous, word); shared+1 > report.UniquePrefixLength {
+			report.UniquePrefixLength = shared + 1
+		}
+	}
+}
+
+func commonPrefixLength(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+// checkEditDistance fills in the minimum edit distance between any two of the given duplicate-free words
+func checkEditDistance(report *ListReport, words []string) {
+	if len(words) < 2 {
+		return
+	}
+
+	// comparing words in order of length lets the search skip every pair whose difference in length alone is at
+	// least the best distance found so far
+	byLength := append([]string(nil), words...)
+	sort.SliceStable(byLength, func(i, j int) bool { return len(byLength[i]) < len(byLength[j]) })
+
+	best := len(byLength[len(byLength)-1]) + 1
+	for i, a := range byLength {
+		for _, b := range byLength[i+1:] {
+			if len(b)-len(a) >= best {
+				break
+			}
+			if d := boundedEditDistance(a, b, best); d < best {
+				best = d
+				report.MinEditDistancePair = []string{a, b}
+				if best == 1 {
+					report.MinEditDistance = best
+					return
+				}
+			}
+		}
+	}
+	report.MinEditDistance = best
+}
+
+// boundedEditDistance returns the Levenshtein distance between a and b, or bound if the distance is at least bound
+func boundedEditDistance(a, b string, bound int) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		rowMin := current[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(minInt(previous[j]+1, current[j-1]+1), previous[j-1]+cost)
+			rowMin = minInt(rowMin, current[j])
+		}
+		if rowMin >= bound {
+			return bound
+		}
+		previous, current = current, previous
+	}
+	return minInt(previous[len(b)], bound)
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// danglingSuffix is a step in the Sardinas-Patterson test. Two sequences of words are being built with the same
+// concatenation except that one of them runs past the other by suffix. Each step appends word to the shorter
+// sequence; flipped records when that makes it the longer one.
+type danglingSuffix struct {
+	suffix  string
+	word    string
+	flipped bool
+	parent  *danglingSuffix
+}
+
+// ambiguousConcatenation runs the Sardinas-Patterson test on the given sorted, duplicate-free words, returning two
+// different sequences of words with the same concatenation, or nil if there are none (the list is uniquely
+// decodable). isWord must report membership in the list.
+func ambiguousConcatenation(sorted []string, isWord map[string]bool) [][]string {
+	var queue []*danglingSuffix
+	visited := make(map[string]bool)
+	visit := func(step *danglingSuffix) {
+		if !visited[step.suffix] {
+			visited[step.suffix] = true
+			queue = append(queue, step)
+		}
+	}
+
+	// the initial dangling suffixes come from words which begin other words
+	for i, short := range sorted {
+		for _, long := range sorted[i+1:] {
+			if !strings.HasPrefix(long, short) {
+				break
+			}
+			visit(&danglingSuffix{suffix: long[len(short):], word: long, parent: &danglingSuffix{word: short}})
+		}
+	}
+
+	for len(queue) > 0 {
+		step := queue[0]
+		queue = queue[1:]
+
+		if isWord[step.suffix] {
+			return step.sequences(step.suffix)
+		}
+		// words which the suffix begins overtake the longer sequence
+		first := sort.SearchStrings(sorted, step.suffix)
+		for _, word := range sorted[first:] {
+			if !strings.HasPrefix(word, step.suffix) {
+				break
+			}
+			visit(&danglingSuffix{suffix: word[len(step.suffix):], word: word, flipped: true, parent: step})
+		}
+		// words which begin the suffix leave the shorter sequence still shorter
+		for n := 1; n < len(step.suffix); n++ {
+			if word := step.suffix[:n]; isWord[word] {
+				visit(&danglingSuffix{suffix: step.suffix[n:], word: word, parent: step})
+			}
+		}
+	}
+	return nil
+}
+
+// sequences replays the steps leading to this dangling suffix and appends the final word which closes it, returning
+// the two word sequences
+func (d *danglingSuffix) sequences(final string) [][]string {
+	var steps []*danglingSuffix
+	for step := d; step != nil; step = step.parent {
+		steps = append(steps, step)
+	}
+
+	// the root holds the word which begins the second step's word; it starts the shorter sequence
+	short, long := []string{steps[len(steps)-1].word}, []string{steps[len(steps)-2].word}
+	for i := len(steps) - 3; i >= 0; i-- {
+		short = append(short, steps[i].word)
+		if steps[i].flipped {
+			short, long = long, short
+		}
+	}
+	short = append(short, final)
+	return [][]string{long, short}
+}
@@ -0,0 +1,72 @@
+package passphrase
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateList(t *testing.T) {
+	// EFF promises these properties for its short list 2 (our touchscreen list)
+	report := ValidateList(builtIn("touchscreen"))
+	if !report.PrefixFree || report.UniquePrefixLength != 3 || report.MinEditDistance != 3 || !report.UniquelyDecodable {
+		t.Errorf("touchscreen list doesn't have the properties EFF describes: %+v", report)
+	}
+	report = ValidateList(builtIn("eff"))
+	if report.UniqueWords != 7776 || !report.PrefixFree || !report.UniquelyDecodable {
+		t.Errorf("eff list expected to be unique, prefix-free and uniquely decodable: %+v", report)
+	}
+
+	report = ValidateList([]string{"cat", "dog", "cat", "bird"})
+	if report.Words != 4 || report.UniqueWords != 3 || !reflect.DeepEqual(report.Duplicates, []string{"cat"}) {
+		t.Errorf("expected one duplicate: %+v", report)
+	}
+	if report.UniquelyDecodable {
+		t.Errorf("a list with duplicates can't be uniquely decodable")
+	}
+
+	report = ValidateList([]string{"act", "action", "ion", "tack"})
+	if report.PrefixFree || !reflect.DeepEqual(report.PrefixExample, []string{"act", "action"}) {
+		t.Errorf("expected act to be reported as a prefix of action: %+v", report)
+	}
+	if report.UniquePrefixLength != 4 {
+		t.Errorf("expected a unique prefix length of 4, got: %d", report.UniquePrefixLength)
+	}
+	if report.MinEditDistance != 2 || !reflect.DeepEqual(report.MinEditDistancePair, []string{"act", "tack"}) {
+		t.Errorf("expected act and tack to be 2 edits apart, got: %d (%v)", report.MinEditDistance, report.MinEditDistancePair)
+	}
+}
+
+func TestUniqueDecodability(t *testing.T) {
+	tests := []struct {
+		words []string
+		want  bool
+	}{
+		{[]string{"abc", "def", "ghi"}, true},
+		// prefix codes are always uniquely decodable
+		{[]string{"a", "ba", "bba", "bbb"}, true},
+		// not prefix-free, but still uniquely decodable (every word ends in its own "b")
+		{[]string{"a", "ab", "abb"}, true},
+		{[]string{"a", "ab", "bc", "c"}, false},
+		// a classic example which takes several rounds of dangling suffixes to show ambiguity
+		{[]string{"1", "011", "01110", "1110", "10011"}, false},
+	}
+	for _, test := range tests {
+		report := ValidateList(test.words)
+		if report.UniquelyDecodable != test.want {
+			t.Errorf("%v: expected uniquely decodable to be %t", test.words, test.want)
+			continue
+		}
+		if test.want {
+			continue
+		}
+		example := report.AmbiguousExample
+		if len(example) != 2 || reflect.DeepEqual(example[0], example[1]) {
+			t.Errorf("%v: expected two different parsings, got: %v", test.words, example)
+			continue
+		}
+		if strings.Join(example[0], "") != strings.Join(example[1], "") {
+			t.Errorf("%v: the parsings %v don't join to the same string", test.words, example)
+		}
+	}
+}
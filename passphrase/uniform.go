@@ -0,0 +1,128 @@
+package passphrase
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"math/bits"
+	"sync"
+)
+
+// uint64Source supplies uniformly distributed random 64 bit numbers
+type uint64Source interface {
+	Uint64() (uint64, error)
+}
+
+// cryptoSource is shared by every Generator without a Rand of its own. Reading crypto/rand a few hundred bytes at a
+// time rather than eight makes a word cost a copy instead of a system call.
+var cryptoSource = &bufferedSource{src: rand.Reader}
+
+// bufferedSource reads numbers from src through a buffer. It is safe for concurrent use.
+type bufferedSource struct {
+	mu     sync.Mutex
+	src    io.Reader
+	buf    [512]byte
+	next   int
+	filled int
+}
+
+func (s *bufferedSource) Uint64() (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.filled-s.next < 8 {
+		n, err := io.ReadFull(s.src, s.buf[:])
+		if err != nil {
+			s.next, s.filled = 0, 0
+			return 0, err
+		}
+		s.next, s.filled = 0, n
+	}
+	x := binary.BigEndian.Uint64(s.buf[s.next:])
+	s.next += 8
+	return x, nil
+}
+
+// readerSource reads each number from r as eight big-endian bytes, without reading ahead, so that a deterministic
+// reader produces predictable words
+type readerSource struct {
+	r   io.Reader
+	buf [8]byte
+}
+
+func (s *readerSource) Uint64() (uint64, error) {
+	if _, err := io.ReadFull(s.r, s.buf[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(s.buf[:]), nil
+}
+
+// sourceFor returns the buffered crypto/rand source when r is nil and a source reading r otherwise
+func sourceFor(r io.Reader) uint64Source {
+	if r == nil {
+		return cryptoSource
+	}
+	return &readerSource{r: r}
+}
+
+// uniform returns a number uniformly distributed in [0, n), using Lemire's multiply and reject method
+// (https://arxiv.org/abs/1805.10941). The product of a random 64 bit x and n is a 128 bit number whose high half is
+// in [0, n). Each of the n possible high halves is produced by either floor(2^64/n) or ceil(2^64/n) values of x;
+// the low half tells which x are the 2^64 mod n extras, and rejecting those leaves exactly floor(2^64/n) values of x
+// for every result. At most one draw in two is rejected, and for word lists almost none are.
+func uniform(src uint64Source, n uint64) (uint64, error) {
+	x, err := src.Uint64()
+	if err != nil {
+		return 0, err
+	}
+	hi, lo := bits.Mul64(x, n)
+	if lo < n {
+		// -n % n is 2^64 mod n, computed without overflowing
+		threshold := -n % n
+		for lo < threshold {
+			if x, err = src.Uint64(); err != nil {
+				return 0, err
+			}
+			hi, lo = bits.Mul64(x, n)
+		}
+	}
+	return hi, nil
+}
+
+// Uint64n returns a number uniformly distributed in [0, n) read from r, or from crypto/rand when r is nil. It is
+// what a Generator uses to choose each word, and is much cheaper than crypto/rand.Int. Errors from r are wrapped
+// in ErrRandomness. It panics if n is 0.
+func Uint64n(r io.Reader, n uint64) (uint64, error) {
+	if n == 0 {
+		panic("passphrase: Uint64n called with n == 0")
+	}
+	x, err := uniform(sourceFor(r), n)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrRandomness, err)
+	}
+	return x, nil
+}
+
+// uniformBig returns a number uniformly distributed in [0, n), for n too large for uniform. It draws numbers with as
+// many bits as n - 1 until one is less than n, which takes fewer than two draws on average.
+func uniformBig(src uint64Source, n *big.Int) (*big.Int, error) {
+	bitLen := new(big.Int).Sub(n, big.NewInt(1)).BitLen()
+	buf := make([]byte, (bitLen+63)/64*8)
+	x := new(big.Int)
+	for {
+		for i := 0; i < len(buf); i += 8 {
+			w, err := src.Uint64()
+			if err != nil {
+				return nil, err
+			}
+			binary.BigEndian.PutUint64(buf[i:], w)
+		}
+		// keep the top bitLen bits
+		x.SetBytes(buf)
+		x.Rsh(x, uint(len(buf)*8-bitLen))
+		if x.Cmp(n) < 0 {
+			return x, nil
+		}
+	}
+}
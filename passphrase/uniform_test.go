@@ -0,0 +1,177 @@
+package passphrase
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	mathrand "math/rand"
+	"sync"
+	"testing"
+)
+
+// uint64Bytes returns the big-endian encoding of each of the given numbers
+func uint64Bytes(xs ...uint64) []byte {
+	var out []byte
+	for _, x := range xs {
+		out = binary.BigEndian.AppendUint64(out, x)
+	}
+	return out
+}
+
+func TestUniformRejection(t *testing.T) {
+	tests := []struct {
+		n     uint64
+		draws []uint64
+		want  uint64
+	}{
+		// 2^64 mod 3 is 1, so only x = 0 (whose low half is 0) is rejected
+		{3, []uint64{0, 1 << 63}, 1},
+		{3, []uint64{1, 0}, 0},
+		{3, []uint64{math.MaxUint64, 0}, 2},
+		// 2^64 mod (2^63 + 1) is 2^63 - 1, so nearly half of all x are rejected: 2 * (2^63 + 1) has a low half of 2
+		{1<<63 + 1, []uint64{2, 1}, 0},
+		{1<<63 + 1, []uint64{2, 2, math.MaxUint64}, 1 << 63},
+		// a power of two is never rejected and is just the top bits
+		{4096, []uint64{0, 1}, 0},
+		{1, []uint64{math.MaxUint64}, 0},
+	}
+	for _, test := range tests {
+		src := &readerSource{r: bytes.NewReader(uint64Bytes(test.draws...))}
+		got, err := uniform(src, test.n)
+		if err != nil {
+			t.Errorf("uniform(%v, %d) returned an error: %s", test.draws, test.n, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("uniform(%v, %d) want: %d, got: %d", test.draws, test.n, test.want, got)
+		}
+	}
+}
+
+func TestUint64nErrors(t *testing.T) {
+	if _, err := Uint64n(failingReader{}, 7776); !errors.Is(err, ErrRandomness) {
+		t.Errorf("expected ErrRandomness from a failing reader, got: %v", err)
+	}
+	// a short read can't be used to choose a word
+	if _, err := Uint64n(bytes.NewReader([]byte{1, 2, 3}), 7776); !errors.Is(err, ErrRandomness) {
+		t.Errorf("expected ErrRandomness from a short read, got: %v", err)
+	}
+	if _, err := (&bufferedSource{src: failingReader{}}).Uint64(); err == nil {
+		t.Errorf("expected an error from a buffered failing reader")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected Uint64n to panic when n is 0")
+		}
+	}()
+	Uint64n(nil, 0)
+}
+
+// TestUint64nUniform compares the distribution of Uint64n with crypto/rand.Int, the way words used to be chosen, on
+// the same deterministic stream of bytes so that the test can't flake
+func TestUint64nUniform(t *testing.T) {
+	samplers := map[string]func(r *mathrand.Rand, n uint64) uint64{
+		"Uint64n": func(r *mathrand.Rand, n uint64) uint64 {
+			x, err := Uint64n(r, n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return x
+		},
+		"crypto/rand.Int": func(r *mathrand.Rand, n uint64) uint64 {
+			x, err := rand.Int(r, new(big.Int).SetUint64(n))
+			if err != nil {
+				t.Fatal(err)
+			}
+			return x.Uint64()
+		},
+	}
+	for name, sample := range samplers {
+		for _, n := range []uint64{6, 1296, 3993, 7776} {
+			r := mathrand.New(mathrand.NewSource(int64(n)))
+			counts := make([]int, n)
+			perBucket := 50
+			for i := 0; i < perBucket*int(n); i++ {
+				counts[sample(r, n)]++
+			}
+			checkFit(t, fmt.Sprintf("%s over %d values", name, n), counts, 1)
+		}
+	}
+}
+
+func TestBufferedSourceConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				if x, err := Uint64n(nil, 7776); err != nil || x >= 7776 {
+					t.Errorf("unexpected result from the shared source: %d, %v", x, err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// BenchmarkRandInt chooses an eff word the way snakeeyes used to, with a big.Int and crypto/rand.Int
+func BenchmarkRandInt(b *testing.B) {
+	b.ReportAllocs()
+	n := big.NewInt(7776)
+	for i := 0; i < b.N; i++ {
+		if _, err := rand.Int(rand.Reader, n); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkUint64n chooses an eff word from the shared buffered crypto/rand source
+func BenchmarkUint64n(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := Uint64n(nil, 7776); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkUint64nUnbuffered chooses an eff word reading crypto/rand eight bytes at a time
+func BenchmarkUint64nUnbuffered(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := Uint64n(rand.Reader, 7776); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkPhrase generates six word eff passphrases
+func BenchmarkPhrase(b *testing.B) {
+	b.ReportAllocs()
+	g := NewGenerator(builtIn("eff"), 6, " ")
+	for i := 0; i < b.N; i++ {
+		if _, err := g.Phrase(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkPhraseParallel generates six word eff passphrases from every CPU at once, as bulk generation might
+func BenchmarkPhraseParallel(b *testing.B) {
+	b.ReportAllocs()
+	g := NewGenerator(builtIn("eff"), 6, " ")
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := g.Phrase(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,178 @@
+package passphrase
+
+import (
+	"fmt"
+	mathrand "math/rand"
+	"strings"
+	"testing"
+
+	"github.com/glvnst/snakeeyes/internal/stats"
+)
+
+// The tests in this file check that generated passphrases are uniformly distributed, using chi-squared
+// goodness-of-fit and independence tests. Each test draws from math/rand with a fixed seed, so its outcome is
+// reproducible and it can't flake; what it guards against is a change to the code which biases the choice of words.
+//
+// alpha is the p-value below which a test fails. When a test makes several comparisons it fails below
+// alpha/comparisons (a Bonferroni correction), so that each test as a whole has at most this chance of failing on
+// unbiased output for a newly chosen seed.
+const alpha = 0.001
+
+// seededGenerator returns a generator choosing nWords from words with a deterministic source of randomness
+func seededGenerator(words []string, nWords int, seed int64) *Generator {
+	g := NewGenerator(words, nWords, " ")
+	g.Rand = mathrand.New(mathrand.NewSource(seed))
+	return g
+}
+
+// checkFit fails the test if counts aren't plausibly uniform, at the significance level alpha/comparisons
+func checkFit(t *testing.T, what string, counts []int, comparisons int) {
+	t.Helper()
+	statistic, df := stats.ChiSquaredUniform(counts)
+	if p := stats.ChiSquaredPValue(statistic, df); p < alpha/float64(comparisons) {
+		t.Errorf("%s is not uniform: chi-squared %.1f with %d degrees of freedom, p = %.3g", what, statistic, df, p)
+	}
+}
+
+// wordCounts generates phrases and counts how often each word was chosen for each position in the phrase
+func wordCounts(t *testing.T, g *Generator, phrases int) [][]int {
+	t.Helper()
+	counts := make([][]int, g.Count)
+	for i := range counts {
+		counts[i] = make([]int, len(g.Words))
+	}
+	for i := 0; i < phrases; i++ {
+		indices, err := g.Indices()
+		if err != nil {
+			t.Fatalf("unexpected error choosing indices: %s", err)
+		}
+		for position, index := range indices {
+			counts[position][index]++
+		}
+	}
+	return counts
+}
+
+// testLists are the word lists the uniformity tests run on: a short one, and the real lists with the most and
+// fewest words, the latter with a size which isn't a power of six or two
+var testLists = []struct {
+	name  string
+	words []string
+}{
+	{"ten words", strings.Fields("one two three four five six seven eight nine ten")},
+	{"memorable", builtIn("memorable")},
+	{"wars", builtIn("wars")},
+	{"eff", builtIn("eff")},
+}
+
+func TestWordsUniform(t *testing.T) {
+	const nWords = 6
+	for i, list := range testLists {
+		// enough phrases that every word is expected at least 20 times in each position
+		phrases := 20 * len(list.words)
+		counts := wordCounts(t, seededGenerator(list.words, nWords, int64(i+1)), phrases)
+
+		all := make([]int, len(list.words))
+		for _, positionCounts := range counts {
+			for index, count := range positionCounts {
+				all[index] += count
+			}
+		}
+		checkFit(t, fmt.Sprintf("the %s list over all positions", list.name), all, 1)
+		for position, positionCounts := range counts {
+			checkFit(t, fmt.Sprintf("the %s list at position %d", list.name, position+1), positionCounts, nWords)
+		}
+	}
+}
+
+// TestPositionsIndependent checks that the word chosen for one position of a phrase says nothing about the word at
+// any other. The lists are too long for a table of every pair of words, so words are grouped into bins of
+// neighbouring indices.
+func TestPositionsIndependent(t *testing.T) {
+	const (
+		nWords = 4
+		bins   = 16
+	)
+	pairs := nWords * (nWords - 1) / 2
+	for i, list := range testLists {
+		g := seededGenerator(list.words, nWords, int64(100+i))
+		nBins := bins
+		if len(list.words) < nBins {
+			nBins = len(list.words)
+		}
+		bin := func(index int) int { return index * nBins / len(list.words) }
+
+		tables := make(map[[2]int][][]int)
+		for a := 0; a < nWords; a++ {
+			for b := a + 1; b < nWords; b++ {
+				table := make([][]int, nBins)
+				for row := range table {
+					table[row] = make([]int, nBins)
+				}
+				tables[[2]int{a, b}] = table
+			}
+		}
+		// about 40 phrases expected in each cell
+		for n := 0; n < 40*nBins*nBins; n++ {
+			indices, err := g.Indices()
+			if err != nil {
+				t.Fatalf("unexpected error choosing indices: %s", err)
+			}
+			for pair, table := range tables {
+				table[bin(indices[pair[0]])][bin(indices[pair[1]])]++
+			}
+		}
+
+		for pair, table := range tables {
+			statistic, df := stats.ChiSquaredIndependence(table)
+			if p := stats.ChiSquaredPValue(statistic, df); p < alpha/float64(pairs) {
+				t.Errorf("positions %d and %d of phrases from the %s list are not independent: chi-squared %.1f with %d degrees of freedom, p = %.3g",
+					pair[0]+1, pair[1]+1, list.name, statistic, df, p)
+			}
+		}
+	}
+}
+
+// TestLengthLimitedUniform checks that rejecting phrases outside a length range leaves every admissible phrase
+// equally likely
+func TestLengthLimitedUniform(t *testing.T) {
+	words := []string{"a", "bb", "ccc", "dddd", "ee"}
+	g := seededGenerator(words, 3, 7)
+	g.Delimiter = "-"
+	g.Lengths = LengthRange{Min: 7, Max: 9}
+	admissible := int(g.Admissible().Int64())
+
+	seen := make(map[string]int)
+	for i := 0; i < 200*admissible; i++ {
+		phrase, err := g.Phrase()
+		if err != nil {
+			t.Fatalf("unexpected error generating a passphrase: %s", err)
+		}
+		seen[phrase]++
+	}
+	if len(seen) != admissible {
+		t.Errorf("expected all %d admissible phrases, got %d", admissible, len(seen))
+	}
+	counts := make([]int, 0, len(seen))
+	for _, count := range seen {
+		counts = append(counts, count)
+	}
+	checkFit(t, "phrases limited to 7 to 9 characters", counts, 1)
+}
+
+// TestUniformityTestsDetectBias makes sure TestWordsUniform is strong enough to notice a realistic mistake: using
+// the remainder of a 16 bit random number as the index, which favours the first words of any list whose length
+// doesn't divide 65536. It draws as many words as TestWordsUniform does from the wars list.
+func TestUniformityTestsDetectBias(t *testing.T) {
+	r := mathrand.New(mathrand.NewSource(1))
+	const n = 3993
+	counts := make([]int, n)
+	for i := 0; i < 6*20*n; i++ {
+		// 65536 = 16 * 3993 + 1648, so the first 1648 words are a sixteenth more likely
+		counts[int(r.Int63()&0xffff)%n]++
+	}
+	statistic, df := stats.ChiSquaredUniform(counts)
+	if p := stats.ChiSquaredPValue(statistic, df); p >= alpha {
+		t.Errorf("expected modulo bias to be detected, got chi-squared %.1f with %d degrees of freedom, p = %.3g", statistic, df, p)
+	}
+}
@@ -0,0 +1,84 @@
+// Code generated by helpers/mkwordlists.go DO NOT EDIT.
+package passphrase
+
+// WordLists describes the word lists shipped with snakeeyes, in the order the help shows them. Their words are
+// front coded in the wordlists directory.
+var WordLists = []*WordList{
+	{
+		Name:        "eff",
+		Aliases:     []string{"large"},
+		Description: "like Arnold Reinhold's Diceware, but tweaked by EFF",
+		URL:         "https://www.eff.org/files/2016/07/18/eff_large_wordlist.txt",
+		License:     "CC-BY-3.0-US",
+		SHA256:      "addd35536511597a02fa0a9ff1e5284677b8883b83e986e43f15a3db996b903e",
+		DiceIndexed: true,
+		size:        7776,
+		digest:      "6d557f0693958fb5e650b68b5bee585eb82cf4da32965505c789e924743bc522",
+	},
+	{
+		Name:        "memorable",
+		Aliases:     []string{"short", "effshort1"},
+		Description: "the most memorable and distinct words per EFF",
+		URL:         "https://www.eff.org/files/2016/09/08/eff_short_wordlist_1.txt",
+		License:     "CC-BY-3.0-US",
+		SHA256:      "",
+		DiceIndexed: true,
+		size:        1296,
+		digest:      "36ecca49e4fa20ca84b176c32f2e9c82f98f446585190e75f9879a95c08247bf",
+	},
+	{
+		Name:        "touchscreen",
+		Aliases:     []string{"effshort2"},
+		Description: "EFF experiment optimized for typing on software keyboards",
+		URL:         "https://www.eff.org/files/2016/09/08/eff_short_wordlist_2_0.txt",
+		License:     "CC-BY-3.0-US",
+		SHA256:      "",
+		DiceIndexed: true,
+		size:        1296,
+		digest:      "7aa57a4d3ecf6581729992bad9575bacdebf7c28378af2aec6a50f11aec326f5",
+	},
+	{
+		Name:          "got",
+		Aliases:       []string{"gameofthrones"},
+		Description:   "contains hyphenated words, inspired by Game of Thrones",
+		URL:           "https://www.eff.org/files/2018/08/29/gameofthrones_8k-2018.txt",
+		License:       "CC-BY-3.0-US",
+		SHA256:        "",
+		ForkedFromEFF: true,
+		size:          3996,
+		digest:        "d98ee3b7169830d502d005d763507cc3b88672650a03ebf752bbc30cd990cd7d",
+	},
+	{
+		Name:          "potter",
+		Aliases:       []string{"harrypotter"},
+		Description:   "contains hyphenated words, inspired by Harry Potter",
+		URL:           "https://www.eff.org/files/2018/08/29/harrypotter_8k_3column-txt.txt",
+		License:       "CC-BY-3.0-US",
+		SHA256:        "",
+		ForkedFromEFF: true,
+		size:          3998,
+		digest:        "1b40949e7478908630944d7ff5f71b70d91e913761bca588920fb29f95c49277",
+	},
+	{
+		Name:          "trek",
+		Aliases:       []string{"startrek"},
+		Description:   "contains hyphenated words, inspired by Star Trek",
+		URL:           "https://www.eff.org/files/2018/08/29/memory-alpha_8k_2018.txt",
+		License:       "CC-BY-3.0-US",
+		SHA256:        "",
+		ForkedFromEFF: true,
+		size:          3998,
+		digest:        "246a60210427961ac74334d69cda532e1a4302c17779f41a8b6a60fd9b8866c1",
+	},
+	{
+		Name:          "wars",
+		Aliases:       []string{"starwars"},
+		Description:   "contains hyphenated words, inspired by Star Wars",
+		URL:           "https://www.eff.org/files/2018/08/29/starwars_8k_2018.txt",
+		License:       "CC-BY-3.0-US",
+		SHA256:        "",
+		ForkedFromEFF: true,
+		size:          3993,
+		digest:        "82a6f4807a65d871d614bcd8419efde49bd97e01d03630f19c9d3c50f0fad458",
+	},
+}
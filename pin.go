@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/glvnst/snakeeyes/passphrase"
+)
+
+// generatePINs implements -mode pin, printing numeric PINs instead of passphrases
+func generatePINs(generator *passphrase.PINGenerator, count int, showEntropy bool) {
+	if generator.Length < 1 {
+		dieWith(exitUsage, "The -length must be at least 1.\n")
+	}
+	if err := generator.Validate(); err != nil {
+		dieWith(exitUsage, "Unable to generate a PIN: %s.\n", err)
+	}
+	bits := generator.Entropy()
+	for p := 0; p < count; p++ {
+		line, err := generator.PIN()
+		if err != nil {
+			dieOnGenError(err, "pin")
+		}
+		if showEntropy {
+			line += fmt.Sprintf("\t(%.1f bits)", bits)
+		}
+		fmt.Println(line)
+	}
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/glvnst/snakeeyes/passphrase"
+)
+
+const serveHelpText = `usage: %s serve [-addr host:port] [-list name] [-list-file path]
+
+Serves passphrases over HTTP, for tools which can't run snakeeyes themselves.
+Each GET request to / returns new passphrases, chosen the same way gen chooses
+them and configured with query parameters named after gen's options:
+
+  words      the number of words in each passphrase (6 by default)
+  bits       the fewest words giving at least this many bits, instead of words
+  phrases    the number of passphrases (3 by default, at most 100)
+  list       the word list to choose words from (-list by default)
+  delimiter  the delimiter between words (a space by default)
+  format     text, json, csv, tsv or yaml (json by default)
+
+For example: curl 'http://127.0.0.1:8080/?words=7&list=memorable&format=text'
+
+Passphrases are secrets and the server only speaks plain HTTP, so by default it
+listens on the loopback interface alone; put it behind a TLS proxy before
+listening anywhere else. Responses are marked as not to be cached, and clients
+taking more than 10 seconds to send a request or read a response are cut off.
+The password rules, length limits and other modes of gen aren't available.
+
+Command line options:
+
+`
+
+// maxServedWords and maxServedPhrases bound what a single request can ask for
+const (
+	maxServedWords   = 64
+	maxServedPhrases = 100
+)
+
+// serveTimeout bounds how long a client may take to send a request or read the response, so that slow clients can't
+// hold connections open
+const serveTimeout = 10 * time.Second
+
+// contentTypes maps each output format to the media type it is served as
+var contentTypes = map[string]string{
+	"text": "text/plain; charset=utf-8",
+	"json": "application/json",
+	"csv":  "text/csv; charset=utf-8",
+	"tsv":  "text/tab-separated-values; charset=utf-8",
+	"yaml": "application/yaml",
+}
+
+// serveMain implements the serve command, which serves passphrases over HTTP
+func serveMain(args []string) {
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := flags.String("addr", "127.0.0.1:8080", "the address to listen on")
+	options := addListFlags(flags, "eff", "the word list to choose words from when a request doesn't name one")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), serveHelpText, os.Args[0])
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	checkNoArgs(flags)
+
+	lists := options.load()
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           &phraseServer{lists: lists, defaultList: options.chosen(lists)[0]},
+		ReadHeaderTimeout: serveTimeout,
+		ReadTimeout:       serveTimeout,
+		WriteTimeout:      serveTimeout,
+		IdleTimeout:       serveTimeout,
+	}
+	warn("Serving passphrases on http://%s/\n", *addr)
+	if err := server.ListenAndServe(); err != nil {
+		die("Unable to serve passphrases: %s\n", err)
+	}
+}
+
+// phraseServer serves passphrases chosen from its word lists
+type phraseServer struct {
+	lists       wordLists
+	defaultList string
+}
+
+// badRequest is an error in a request's query parameters
+type badRequest string
+
+func (b badRequest) Error() string {
+	return string(b)
+}
+
+func (s *phraseServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "Only GET requests are supported.", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "json"
+	}
+	newWriter, ok := outputFormats[format]
+	if !ok {
+		http.Error(w, fmt.Sprintf("No such format \"%s\"; choose from %s.", format, strings.Join(formatNames(), ", ")), http.StatusBadRequest)
+		return
+	}
+
+	var body bytes.Buffer
+	if err := s.writePhrases(newWriter(&body, false, false), r.URL.Query()); err != nil {
+		var bad badRequest
+		if errors.As(err, &bad) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		} else {
+			http.Error(w, "Unable to generate passphrases.", http.StatusInternalServerError)
+		}
+		return
+	}
+	w.Header().Set("Content-Type", contentTypes[format])
+	w.Write(body.Bytes())
+}
+
+// writePhrases writes the passphrases the query asks for. Mistakes in the query are returned as a badRequest.
+func (s *phraseServer) writePhrases(out phraseWriter, query url.Values) error {
+	listName := s.defaultList
+	if query.Has("list") {
+		listName = query.Get("list")
+	}
+	wordList, ok := s.lists.lookup(listName)
+	if !ok {
+		return badRequest(fmt.Sprintf("No such list \"%s\".", listName))
+	}
+	phrases, err := queryInt(query, "phrases", 3, maxServedPhrases)
+	if err != nil {
+		return err
+	}
+	count, err := queryInt(query, "words", 6, maxServedWords)
+	if err != nil {
+		return err
+	}
+	delimiter := " "
+	if query.Has("delimiter") {
+		delimiter = query.Get("delimiter")
+	}
+
+	generator := passphrase.NewGenerator(wordList, count, delimiter)
+	if query.Has("bits") {
+		if query.Has("words") {
+			return badRequest("Use either words or bits, not both.")
+		}
+		bits, err := strconv.ParseFloat(query.Get("bits"), 64)
+		if err != nil || !(bits > 0) || math.IsInf(bits, 0) {
+			return badRequest("The bits target must be a positive number of bits.")
+		}
+		count, err = generator.CountForBits(bits)
+		if err != nil || count > maxServedWords {
+			return badRequest(fmt.Sprintf("No more than %d words from the \"%s\" list reach %g bits.", maxServedWords, listName, bits))
+		}
+		generator.Count = count
+	}
+	if err := generator.Validate(); err != nil {
+		return badRequest(fmt.Sprintf("Unable to generate a passphrase from the \"%s\" list: %s.", listName, err))
+	}
+
+	for p := 0; p < phrases; p++ {
+		indices, err := generator.Indices()
+		if err != nil {
+			return err
+		}
+		words := make([]string, len(indices))
+		for i, index := range indices {
+			words[i] = wordList[index]
+		}
+		record := phraseRecord{Phrase: generator.Join(indices), Words: words, Indices: indices, List: listName, Delimiter: delimiter, Entropy: generator.Entropy()}
+		if err := out.Write(record); err != nil {
+			return err
+		}
+	}
+	return out.Close()
+}
+
+// queryInt returns the named query parameter as a number from 1 to max, or def if it isn't given
+func queryInt(query url.Values, name string, def, max int) (int, error) {
+	if !query.Has(name) {
+		return def, nil
+	}
+	n, err := strconv.Atoi(query.Get(name))
+	if err != nil || n < 1 || n > max {
+		return 0, badRequest(fmt.Sprintf("The %s count must be a number from 1 to %d.", name, max))
+	}
+	return n, nil
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/glvnst/snakeeyes/passphrase"
+)
+
+func TestServePhrases(t *testing.T) {
+	server := &phraseServer{lists: wordLists{"tiny": {"one", "two", "three"}}, defaultList: "eff"}
+	response := httptest.NewRecorder()
+	server.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/?words=4&phrases=2&delimiter=-", nil))
+	if response.Code != http.StatusOK {
+		t.Fatalf("unexpected status %d: %s", response.Code, response.Body)
+	}
+	if got := response.Header().Get("Cache-Control"); got != "no-store" {
+		t.Errorf("expected the passphrases not to be cached, got Cache-Control: %q", got)
+	}
+	if got := response.Header().Get("Content-Type"); got != "application/json" {
+		t.Errorf("unexpected content type %q", got)
+	}
+
+	var records []phraseRecord
+	if err := json.Unmarshal(response.Body.Bytes(), &records); err != nil {
+		t.Fatalf("unable to decode the passphrases: %s", err)
+	}
+	eff, _ := passphrase.Lookup("eff")
+	if len(records) != 2 {
+		t.Fatalf("expected 2 passphrases, got: %d", len(records))
+	}
+	for _, record := range records {
+		if len(record.Words) != 4 || record.List != "eff" || record.Phrase != strings.Join(record.Words, "-") {
+			t.Errorf("unexpected passphrase %+v", record)
+		}
+		for i, index := range record.Indices {
+			if eff[index] != record.Words[i] {
+				t.Errorf("word %q doesn't match index %d of eff", record.Words[i], index)
+			}
+		}
+	}
+
+	response = httptest.NewRecorder()
+	server.ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/?list=tiny&bits=3&format=text&phrases=1", nil))
+	if got := strings.Fields(strings.ReplaceAll(response.Body.String(), "\n", " ")); response.Code != http.StatusOK || len(got) != 2 {
+		t.Errorf("expected one two word passphrase from the tiny list, got %d: %q", response.Code, response.Body)
+	}
+}
+
+func TestServeErrors(t *testing.T) {
+	server := &phraseServer{lists: wordLists{}, defaultList: "eff"}
+	tests := []struct {
+		method, target string
+		want           int
+	}{
+		{http.MethodGet, "/?words=0", http.StatusBadRequest},
+		{http.MethodGet, "/?words=65", http.StatusBadRequest},
+		{http.MethodGet, "/?phrases=101", http.StatusBadRequest},
+		{http.MethodGet, "/?phrases=x", http.StatusBadRequest},
+		{http.MethodGet, "/?list=nope", http.StatusBadRequest},
+		{http.MethodGet, "/?format=xml", http.StatusBadRequest},
+		{http.MethodGet, "/?bits=NaN", http.StatusBadRequest},
+		{http.MethodGet, "/?bits=1e30", http.StatusBadRequest},
+		{http.MethodGet, "/?bits=80&words=6", http.StatusBadRequest},
+		{http.MethodPost, "/", http.StatusMethodNotAllowed},
+		{http.MethodGet, "/other", http.StatusNotFound},
+	}
+	for _, test := range tests {
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, httptest.NewRequest(test.method, test.target, nil))
+		if response.Code != test.want {
+			t.Errorf("%s %s want: %d, got: %d (%s)", test.method, test.target, test.want, response.Code, strings.TrimSpace(response.Body.String()))
+		}
+	}
+}
@@ -1,26372 +0,0 @@
-// Code generated by helpers/mkwordlists.go DO NOT EDIT.
-package main
-
-var WordLists map[string][]string = map[string][]string{
-	"touchscreen": {
-		"aardvark",
-		"abandoned",
-		"abbreviate",
-		"abdomen",
-		"abhorrence",
-		"abiding",
-		"abnormal",
-		"abrasion",
-		"absorbing",
-		"abundant",
-		"abyss",
-		"academy",
-		"accountant",
-		"acetone",
-		"achiness",
-		"acid",
-		"acoustics",
-		"acquire",
-		"acrobat",
-		"actress",
-		"acuteness",
-		"aerosol",
-		"aesthetic",
-		"affidavit",
-		"afloat",
-		"afraid",
-		"aftershave",
-		"again",
-		"agency",
-		"aggressor",
-		"aghast",
-		"agitate",
-		"agnostic",
-		"agonizing",
-		"agreeing",
-		"aidless",
-		"aimlessly",
-		"ajar",
-		"alarmclock",
-		"albatross",
-		"alchemy",
-		"alfalfa",
-		"algae",
-		"aliens",
-		"alkaline",
-		"almanac",
-		"alongside",
-		"alphabet",
-		"already",
-		"also",
-		"altitude",
-		"aluminum",
-		"always",
-		"amazingly",
-		"ambulance",
-		"amendment",
-		"amiable",
-		"ammunition",
-		"amnesty",
-		"amoeba",
-		"amplifier",
-		"amuser",
-		"anagram",
-		"anchor",
-		"android",
-		"anesthesia",
-		"angelfish",
-		"animal",
-		"anklet",
-		"announcer",
-		"anonymous",
-		"answer",
-		"antelope",
-		"anxiety",
-		"anyplace",
-		"aorta",
-		"apartment",
-		"apnea",
-		"apostrophe",
-		"apple",
-		"apricot",
-		"aquamarine",
-		"arachnid",
-		"arbitrate",
-		"ardently",
-		"arena",
-		"argument",
-		"aristocrat",
-		"armchair",
-		"aromatic",
-		"arrowhead",
-		"arsonist",
-		"artichoke",
-		"asbestos",
-		"ascend",
-		"aseptic",
-		"ashamed",
-		"asinine",
-		"asleep",
-		"asocial",
-		"asparagus",
-		"astronaut",
-		"asymmetric",
-		"atlas",
-		"atmosphere",
-		"atom",
-		"atrocious",
-		"attic",
-		"atypical",
-		"auctioneer",
-		"auditorium",
-		"augmented",
-		"auspicious",
-		"automobile",
-		"auxiliary",
-		"avalanche",
-		"avenue",
-		"aviator",
-		"avocado",
-		"awareness",
-		"awhile",
-		"awkward",
-		"awning",
-		"awoke",
-		"axially",
-		"azalea",
-		"babbling",
-		"backpack",
-		"badass",
-		"bagpipe",
-		"bakery",
-		"balancing",
-		"bamboo",
-		"banana",
-		"barracuda",
-		"basket",
-		"bathrobe",
-		"bazooka",
-		"blade",
-		"blender",
-		"blimp",
-		"blouse",
-		"blurred",
-		"boatyard",
-		"bobcat",
-		"body",
-		"bogusness",
-		"bohemian",
-		"boiler",
-		"bonnet",
-		"boots",
-		"borough",
-		"bossiness",
-		"bottle",
-		"bouquet",
-		"boxlike",
-		"breath",
-		"briefcase",
-		"broom",
-		"brushes",
-		"bubblegum",
-		"buckle",
-		"buddhist",
-		"buffalo",
-		"bullfrog",
-		"bunny",
-		"busboy",
-		"buzzard",
-		"cabin",
-		"cactus",
-		"cadillac",
-		"cafeteria",
-		"cage",
-		"cahoots",
-		"cajoling",
-		"cakewalk",
-		"calculator",
-		"camera",
-		"canister",
-		"capsule",
-		"carrot",
-		"cashew",
-		"cathedral",
-		"caucasian",
-		"caviar",
-		"ceasefire",
-		"cedar",
-		"celery",
-		"cement",
-		"census",
-		"ceramics",
-		"cesspool",
-		"chalkboard",
-		"cheesecake",
-		"chimney",
-		"chlorine",
-		"chopsticks",
-		"chrome",
-		"chute",
-		"cilantro",
-		"cinnamon",
-		"circle",
-		"cityscape",
-		"civilian",
-		"clay",
-		"clergyman",
-		"clipboard",
-		"clock",
-		"clubhouse",
-		"coathanger",
-		"cobweb",
-		"coconut",
-		"codeword",
-		"coexistent",
-		"coffeecake",
-		"cognitive",
-		"cohabitate",
-		"collarbone",
-		"computer",
-		"confetti",
-		"copier",
-		"cornea",
-		"cosmetics",
-		"cotton",
-		"couch",
-		"coverless",
-		"coyote",
-		"coziness",
-		"crawfish",
-		"crewmember",
-		"crib",
-		"croissant",
-		"crumble",
-		"crystal",
-		"cubical",
-		"cucumber",
-		"cuddly",
-		"cufflink",
-		"cuisine",
-		"culprit",
-		"cup",
-		"curry",
-		"cushion",
-		"cuticle",
-		"cybernetic",
-		"cyclist",
-		"cylinder",
-		"cymbal",
-		"cynicism",
-		"cypress",
-		"cytoplasm",
-		"dachshund",
-		"daffodil",
-		"dagger",
-		"dairy",
-		"dalmatian",
-		"dandelion",
-		"dartboard",
-		"dastardly",
-		"datebook",
-		"daughter",
-		"dawn",
-		"daytime",
-		"dazzler",
-		"dealer",
-		"debris",
-		"decal",
-		"dedicate",
-		"deepness",
-		"defrost",
-		"degree",
-		"dehydrator",
-		"deliverer",
-		"democrat",
-		"dentist",
-		"deodorant",
-		"depot",
-		"deranged",
-		"desktop",
-		"detergent",
-		"device",
-		"dexterity",
-		"diamond",
-		"dibs",
-		"dictionary",
-		"diffuser",
-		"digit",
-		"dilated",
-		"dimple",
-		"dinnerware",
-		"dioxide",
-		"diploma",
-		"directory",
-		"dishcloth",
-		"ditto",
-		"dividers",
-		"dizziness",
-		"doctor",
-		"dodge",
-		"doll",
-		"dominoes",
-		"donut",
-		"doorstep",
-		"dorsal",
-		"double",
-		"downstairs",
-		"dozed",
-		"drainpipe",
-		"dresser",
-		"driftwood",
-		"droppings",
-		"drum",
-		"dryer",
-		"dubiously",
-		"duckling",
-		"duffel",
-		"dugout",
-		"dumpster",
-		"duplex",
-		"durable",
-		"dustpan",
-		"dutiful",
-		"duvet",
-		"dwarfism",
-		"dwelling",
-		"dwindling",
-		"dynamite",
-		"dyslexia",
-		"eagerness",
-		"earlobe",
-		"easel",
-		"eavesdrop",
-		"ebook",
-		"eccentric",
-		"echoless",
-		"eclipse",
-		"ecosystem",
-		"ecstasy",
-		"edged",
-		"editor",
-		"educator",
-		"eelworm",
-		"eerie",
-		"effects",
-		"eggnog",
-		"egomaniac",
-		"ejection",
-		"elastic",
-		"elbow",
-		"elderly",
-		"elephant",
-		"elfishly",
-		"eliminator",
-		"elk",
-		"elliptical",
-		"elongated",
-		"elsewhere",
-		"elusive",
-		"elves",
-		"emancipate",
-		"embroidery",
-		"emcee",
-		"emerald",
-		"emission",
-		"emoticon",
-		"emperor",
-		"emulate",
-		"enactment",
-		"enchilada",
-		"endorphin",
-		"energy",
-		"enforcer",
-		"engine",
-		"enhance",
-		"enigmatic",
-		"enjoyably",
-		"enlarged",
-		"enormous",
-		"enquirer",
-		"enrollment",
-		"ensemble",
-		"entryway",
-		"enunciate",
-		"envoy",
-		"enzyme",
-		"epidemic",
-		"equipment",
-		"erasable",
-		"ergonomic",
-		"erratic",
-		"eruption",
-		"escalator",
-		"eskimo",
-		"esophagus",
-		"espresso",
-		"essay",
-		"estrogen",
-		"etching",
-		"eternal",
-		"ethics",
-		"etiquette",
-		"eucalyptus",
-		"eulogy",
-		"euphemism",
-		"euthanize",
-		"evacuation",
-		"evergreen",
-		"evidence",
-		"evolution",
-		"exam",
-		"excerpt",
-		"exerciser",
-		"exfoliate",
-		"exhale",
-		"exist",
-		"exorcist",
-		"explode",
-		"exquisite",
-		"exterior",
-		"exuberant",
-		"fabric",
-		"factory",
-		"faded",
-		"failsafe",
-		"falcon",
-		"family",
-		"fanfare",
-		"fasten",
-		"faucet",
-		"favorite",
-		"feasibly",
-		"february",
-		"federal",
-		"feedback",
-		"feigned",
-		"feline",
-		"femur",
-		"fence",
-		"ferret",
-		"festival",
-		"fettuccine",
-		"feudalist",
-		"feverish",
-		"fiberglass",
-		"fictitious",
-		"fiddle",
-		"figurine",
-		"fillet",
-		"finalist",
-		"fiscally",
-		"fixture",
-		"flashlight",
-		"fleshiness",
-		"flight",
-		"florist",
-		"flypaper",
-		"foamless",
-		"focus",
-		"foggy",
-		"folksong",
-		"fondue",
-		"footpath",
-		"fossil",
-		"fountain",
-		"fox",
-		"fragment",
-		"freeway",
-		"fridge",
-		"frosting",
-		"fruit",
-		"fryingpan",
-		"gadget",
-		"gainfully",
-		"gallstone",
-		"gamekeeper",
-		"gangway",
-		"garlic",
-		"gaslight",
-		"gathering",
-		"gauntlet",
-		"gearbox",
-		"gecko",
-		"gem",
-		"generator",
-		"geographer",
-		"gerbil",
-		"gesture",
-		"getaway",
-		"geyser",
-		"ghoulishly",
-		"gibberish",
-		"giddiness",
-		"giftshop",
-		"gigabyte",
-		"gimmick",
-		"giraffe",
-		"giveaway",
-		"gizmo",
-		"glasses",
-		"gleeful",
-		"glisten",
-		"glove",
-		"glucose",
-		"glycerin",
-		"gnarly",
-		"gnomish",
-		"goatskin",
-		"goggles",
-		"goldfish",
-		"gong",
-		"gooey",
-		"gorgeous",
-		"gosling",
-		"gothic",
-		"gourmet",
-		"governor",
-		"grape",
-		"greyhound",
-		"grill",
-		"groundhog",
-		"grumbling",
-		"guacamole",
-		"guerrilla",
-		"guitar",
-		"gullible",
-		"gumdrop",
-		"gurgling",
-		"gusto",
-		"gutless",
-		"gymnast",
-		"gynecology",
-		"gyration",
-		"habitat",
-		"hacking",
-		"haggard",
-		"haiku",
-		"halogen",
-		"hamburger",
-		"handgun",
-		"happiness",
-		"hardhat",
-		"hastily",
-		"hatchling",
-		"haughty",
-		"hazelnut",
-		"headband",
-		"hedgehog",
-		"hefty",
-		"heinously",
-		"helmet",
-		"hemoglobin",
-		"henceforth",
-		"herbs",
-		"hesitation",
-		"hexagon",
-		"hubcap",
-		"huddling",
-		"huff",
-		"hugeness",
-		"hullabaloo",
-		"human",
-		"hunter",
-		"hurricane",
-		"hushing",
-		"hyacinth",
-		"hybrid",
-		"hydrant",
-		"hygienist",
-		"hypnotist",
-		"ibuprofen",
-		"icepack",
-		"icing",
-		"iconic",
-		"identical",
-		"idiocy",
-		"idly",
-		"igloo",
-		"ignition",
-		"iguana",
-		"illuminate",
-		"imaging",
-		"imbecile",
-		"imitator",
-		"immigrant",
-		"imprint",
-		"iodine",
-		"ionosphere",
-		"ipad",
-		"iphone",
-		"iridescent",
-		"irksome",
-		"iron",
-		"irrigation",
-		"island",
-		"isotope",
-		"issueless",
-		"italicize",
-		"itemizer",
-		"itinerary",
-		"itunes",
-		"ivory",
-		"jabbering",
-		"jackrabbit",
-		"jaguar",
-		"jailhouse",
-		"jalapeno",
-		"jamboree",
-		"janitor",
-		"jarring",
-		"jasmine",
-		"jaundice",
-		"jawbreaker",
-		"jaywalker",
-		"jazz",
-		"jealous",
-		"jeep",
-		"jelly",
-		"jeopardize",
-		"jersey",
-		"jetski",
-		"jezebel",
-		"jiffy",
-		"jigsaw",
-		"jingling",
-		"jobholder",
-		"jockstrap",
-		"jogging",
-		"john",
-		"joinable",
-		"jokingly",
-		"journal",
-		"jovial",
-		"joystick",
-		"jubilant",
-		"judiciary",
-		"juggle",
-		"juice",
-		"jujitsu",
-		"jukebox",
-		"jumpiness",
-		"junkyard",
-		"juror",
-		"justifying",
-		"juvenile",
-		"kabob",
-		"kamikaze",
-		"kangaroo",
-		"karate",
-		"kayak",
-		"keepsake",
-		"kennel",
-		"kerosene",
-		"ketchup",
-		"khaki",
-		"kickstand",
-		"kilogram",
-		"kimono",
-		"kingdom",
-		"kiosk",
-		"kissing",
-		"kite",
-		"kleenex",
-		"knapsack",
-		"kneecap",
-		"knickers",
-		"koala",
-		"krypton",
-		"laboratory",
-		"ladder",
-		"lakefront",
-		"lantern",
-		"laptop",
-		"laryngitis",
-		"lasagna",
-		"latch",
-		"laundry",
-		"lavender",
-		"laxative",
-		"lazybones",
-		"lecturer",
-		"leftover",
-		"leggings",
-		"leisure",
-		"lemon",
-		"length",
-		"leopard",
-		"leprechaun",
-		"lettuce",
-		"leukemia",
-		"levers",
-		"lewdness",
-		"liability",
-		"library",
-		"licorice",
-		"lifeboat",
-		"lightbulb",
-		"likewise",
-		"lilac",
-		"limousine",
-		"lint",
-		"lioness",
-		"lipstick",
-		"liquid",
-		"listless",
-		"litter",
-		"liverwurst",
-		"lizard",
-		"llama",
-		"luau",
-		"lubricant",
-		"lucidity",
-		"ludicrous",
-		"luggage",
-		"lukewarm",
-		"lullaby",
-		"lumberjack",
-		"lunchbox",
-		"luridness",
-		"luscious",
-		"luxurious",
-		"lyrics",
-		"macaroni",
-		"maestro",
-		"magazine",
-		"mahogany",
-		"maimed",
-		"majority",
-		"makeover",
-		"malformed",
-		"mammal",
-		"mango",
-		"mapmaker",
-		"marbles",
-		"massager",
-		"matchstick",
-		"maverick",
-		"maximum",
-		"mayonnaise",
-		"moaning",
-		"mobilize",
-		"moccasin",
-		"modify",
-		"moisture",
-		"molecule",
-		"momentum",
-		"monastery",
-		"moonshine",
-		"mortuary",
-		"mosquito",
-		"motorcycle",
-		"mousetrap",
-		"movie",
-		"mower",
-		"mozzarella",
-		"muckiness",
-		"mudflow",
-		"mugshot",
-		"mule",
-		"mummy",
-		"mundane",
-		"muppet",
-		"mural",
-		"mustard",
-		"mutation",
-		"myriad",
-		"myspace",
-		"myth",
-		"nail",
-		"namesake",
-		"nanosecond",
-		"napkin",
-		"narrator",
-		"nastiness",
-		"natives",
-		"nautically",
-		"navigate",
-		"nearest",
-		"nebula",
-		"nectar",
-		"nefarious",
-		"negotiator",
-		"neither",
-		"nemesis",
-		"neoliberal",
-		"nephew",
-		"nervously",
-		"nest",
-		"netting",
-		"neuron",
-		"nevermore",
-		"nextdoor",
-		"nicotine",
-		"niece",
-		"nimbleness",
-		"nintendo",
-		"nirvana",
-		"nuclear",
-		"nugget",
-		"nuisance",
-		"nullify",
-		"numbing",
-		"nuptials",
-		"nursery",
-		"nutcracker",
-		"nylon",
-		"oasis",
-		"oat",
-		"obediently",
-		"obituary",
-		"object",
-		"obliterate",
-		"obnoxious",
-		"observer",
-		"obtain",
-		"obvious",
-		"occupation",
-		"oceanic",
-		"octopus",
-		"ocular",
-		"office",
-		"oftentimes",
-		"oiliness",
-		"ointment",
-		"older",
-		"olympics",
-		"omissible",
-		"omnivorous",
-		"oncoming",
-		"onion",
-		"onlooker",
-		"onstage",
-		"onward",
-		"onyx",
-		"oomph",
-		"opaquely",
-		"opera",
-		"opium",
-		"opossum",
-		"opponent",
-		"optical",
-		"opulently",
-		"oscillator",
-		"osmosis",
-		"ostrich",
-		"otherwise",
-		"ought",
-		"outhouse",
-		"ovation",
-		"oven",
-		"owlish",
-		"oxford",
-		"oxidize",
-		"oxygen",
-		"oyster",
-		"ozone",
-		"pacemaker",
-		"padlock",
-		"pageant",
-		"pajamas",
-		"palm",
-		"pamphlet",
-		"pantyhose",
-		"paprika",
-		"parakeet",
-		"passport",
-		"patio",
-		"pauper",
-		"pavement",
-		"payphone",
-		"pebble",
-		"peculiarly",
-		"pedometer",
-		"pegboard",
-		"pelican",
-		"penguin",
-		"peony",
-		"pepperoni",
-		"peroxide",
-		"pesticide",
-		"petroleum",
-		"pewter",
-		"pharmacy",
-		"pheasant",
-		"phonebook",
-		"phrasing",
-		"physician",
-		"plank",
-		"pledge",
-		"plotted",
-		"plug",
-		"plywood",
-		"pneumonia",
-		"podiatrist",
-		"poetic",
-		"pogo",
-		"poison",
-		"poking",
-		"policeman",
-		"poncho",
-		"popcorn",
-		"porcupine",
-		"postcard",
-		"poultry",
-		"powerboat",
-		"prairie",
-		"pretzel",
-		"princess",
-		"propeller",
-		"prune",
-		"pry",
-		"pseudo",
-		"psychopath",
-		"publisher",
-		"pucker",
-		"pueblo",
-		"pulley",
-		"pumpkin",
-		"punchbowl",
-		"puppy",
-		"purse",
-		"pushup",
-		"putt",
-		"puzzle",
-		"pyramid",
-		"python",
-		"quarters",
-		"quesadilla",
-		"quilt",
-		"quote",
-		"racoon",
-		"radish",
-		"ragweed",
-		"railroad",
-		"rampantly",
-		"rancidity",
-		"rarity",
-		"raspberry",
-		"ravishing",
-		"rearrange",
-		"rebuilt",
-		"receipt",
-		"reentry",
-		"refinery",
-		"register",
-		"rehydrate",
-		"reimburse",
-		"rejoicing",
-		"rekindle",
-		"relic",
-		"remote",
-		"renovator",
-		"reopen",
-		"reporter",
-		"request",
-		"rerun",
-		"reservoir",
-		"retriever",
-		"reunion",
-		"revolver",
-		"rewrite",
-		"rhapsody",
-		"rhetoric",
-		"rhino",
-		"rhubarb",
-		"rhyme",
-		"ribbon",
-		"riches",
-		"ridden",
-		"rigidness",
-		"rimmed",
-		"riptide",
-		"riskily",
-		"ritzy",
-		"riverboat",
-		"roamer",
-		"robe",
-		"rocket",
-		"romancer",
-		"ropelike",
-		"rotisserie",
-		"roundtable",
-		"royal",
-		"rubber",
-		"rudderless",
-		"rugby",
-		"ruined",
-		"rulebook",
-		"rummage",
-		"running",
-		"rupture",
-		"rustproof",
-		"sabotage",
-		"sacrifice",
-		"saddlebag",
-		"saffron",
-		"sainthood",
-		"saltshaker",
-		"samurai",
-		"sandworm",
-		"sapphire",
-		"sardine",
-		"sassy",
-		"satchel",
-		"sauna",
-		"savage",
-		"saxophone",
-		"scarf",
-		"scenario",
-		"schoolbook",
-		"scientist",
-		"scooter",
-		"scrapbook",
-		"sculpture",
-		"scythe",
-		"secretary",
-		"sedative",
-		"segregator",
-		"seismology",
-		"selected",
-		"semicolon",
-		"senator",
-		"septum",
-		"sequence",
-		"serpent",
-		"sesame",
-		"settler",
-		"severely",
-		"shack",
-		"shelf",
-		"shirt",
-		"shovel",
-		"shrimp",
-		"shuttle",
-		"shyness",
-		"siamese",
-		"sibling",
-		"siesta",
-		"silicon",
-		"simmering",
-		"singles",
-		"sisterhood",
-		"sitcom",
-		"sixfold",
-		"sizable",
-		"skateboard",
-		"skeleton",
-		"skies",
-		"skulk",
-		"skylight",
-		"slapping",
-		"sled",
-		"slingshot",
-		"sloth",
-		"slumbering",
-		"smartphone",
-		"smelliness",
-		"smitten",
-		"smokestack",
-		"smudge",
-		"snapshot",
-		"sneezing",
-		"sniff",
-		"snowsuit",
-		"snugness",
-		"speakers",
-		"sphinx",
-		"spider",
-		"splashing",
-		"sponge",
-		"sprout",
-		"spur",
-		"spyglass",
-		"squirrel",
-		"statue",
-		"steamboat",
-		"stingray",
-		"stopwatch",
-		"strawberry",
-		"student",
-		"stylus",
-		"suave",
-		"subway",
-		"suction",
-		"suds",
-		"suffocate",
-		"sugar",
-		"suitcase",
-		"sulphur",
-		"superstore",
-		"surfer",
-		"sushi",
-		"swan",
-		"sweatshirt",
-		"swimwear",
-		"sword",
-		"sycamore",
-		"syllable",
-		"symphony",
-		"synagogue",
-		"syringes",
-		"systemize",
-		"tablespoon",
-		"taco",
-		"tadpole",
-		"taekwondo",
-		"tagalong",
-		"takeout",
-		"tallness",
-		"tamale",
-		"tanned",
-		"tapestry",
-		"tarantula",
-		"tastebud",
-		"tattoo",
-		"tavern",
-		"thaw",
-		"theater",
-		"thimble",
-		"thorn",
-		"throat",
-		"thumb",
-		"thwarting",
-		"tiara",
-		"tidbit",
-		"tiebreaker",
-		"tiger",
-		"timid",
-		"tinsel",
-		"tiptoeing",
-		"tirade",
-		"tissue",
-		"tractor",
-		"tree",
-		"tripod",
-		"trousers",
-		"trucks",
-		"tryout",
-		"tubeless",
-		"tuesday",
-		"tugboat",
-		"tulip",
-		"tumbleweed",
-		"tupperware",
-		"turtle",
-		"tusk",
-		"tutorial",
-		"tuxedo",
-		"tweezers",
-		"twins",
-		"tyrannical",
-		"ultrasound",
-		"umbrella",
-		"umpire",
-		"unarmored",
-		"unbuttoned",
-		"uncle",
-		"underwear",
-		"unevenness",
-		"unflavored",
-		"ungloved",
-		"unhinge",
-		"unicycle",
-		"unjustly",
-		"unknown",
-		"unlocking",
-		"unmarked",
-		"unnoticed",
-		"unopened",
-		"unpaved",
-		"unquenched",
-		"unroll",
-		"unscrewing",
-		"untied",
-		"unusual",
-		"unveiled",
-		"unwrinkled",
-		"unyielding",
-		"unzip",
-		"upbeat",
-		"upcountry",
-		"update",
-		"upfront",
-		"upgrade",
-		"upholstery",
-		"upkeep",
-		"upload",
-		"uppercut",
-		"upright",
-		"upstairs",
-		"uptown",
-		"upwind",
-		"uranium",
-		"urban",
-		"urchin",
-		"urethane",
-		"urgent",
-		"urologist",
-		"username",
-		"usher",
-		"utensil",
-		"utility",
-		"utmost",
-		"utopia",
-		"utterance",
-		"vacuum",
-		"vagrancy",
-		"valuables",
-		"vanquished",
-		"vaporizer",
-		"varied",
-		"vaseline",
-		"vegetable",
-		"vehicle",
-		"velcro",
-		"vendor",
-		"vertebrae",
-		"vestibule",
-		"veteran",
-		"vexingly",
-		"vicinity",
-		"videogame",
-		"viewfinder",
-		"vigilante",
-		"village",
-		"vinegar",
-		"violin",
-		"viperfish",
-		"virus",
-		"visor",
-		"vitamins",
-		"vivacious",
-		"vixen",
-		"vocalist",
-		"vogue",
-		"voicemail",
-		"volleyball",
-		"voucher",
-		"voyage",
-		"vulnerable",
-		"waffle",
-		"wagon",
-		"wakeup",
-		"walrus",
-		"wanderer",
-		"wasp",
-		"water",
-		"waving",
-		"wheat",
-		"whisper",
-		"wholesaler",
-		"wick",
-		"widow",
-		"wielder",
-		"wifeless",
-		"wikipedia",
-		"wildcat",
-		"windmill",
-		"wipeout",
-		"wired",
-		"wishbone",
-		"wizardry",
-		"wobbliness",
-		"wolverine",
-		"womb",
-		"woolworker",
-		"workbasket",
-		"wound",
-		"wrangle",
-		"wreckage",
-		"wristwatch",
-		"wrongdoing",
-		"xerox",
-		"xylophone",
-		"yacht",
-		"yahoo",
-		"yard",
-		"yearbook",
-		"yesterday",
-		"yiddish",
-		"yield",
-		"yo-yo",
-		"yodel",
-		"yogurt",
-		"yuppie",
-		"zealot",
-		"zebra",
-		"zeppelin",
-		"zestfully",
-		"zigzagged",
-		"zillion",
-		"zipping",
-		"zirconium",
-		"zodiac",
-		"zombie",
-		"zookeeper",
-		"zucchini",
-	},
-	"got": {
-		"abandon",
-		"abandoning",
-		"abilities",
-		"abject",
-		"aboard",
-		"about",
-		"above",
-		"absence",
-		"absent",
-		"abusing",
-		"accept",
-		"accepted",
-		"accepts",
-		"accident",
-		"accidentally",
-		"accompanied",
-		"accompany",
-		"accomplishment",
-		"accomplishments",
-		"according",
-		"accords",
-		"accosting",
-		"account",
-		"accumulating",
-		"accurately",
-		"accusations",
-		"acknowledge",
-		"acknowledges",
-		"acquiesce",
-		"acquiesces",
-		"acquire",
-		"acrobatic",
-		"across",
-		"act",
-		"acting",
-		"action",
-		"activities",
-		"acts",
-		"actually",
-		"adamant",
-		"adding",
-		"address",
-		"addresses",
-		"addressing",
-		"adds",
-		"adjust",
-		"admiration",
-		"admiring",
-		"admission",
-		"admit",
-		"admits",
-		"admittance",
-		"admonishes",
-		"adopts",
-		"advance",
-		"advances",
-		"advancing",
-		"advantage",
-		"advice",
-		"advised",
-		"adviser",
-		"advises",
-		"advisors",
-		"advocate",
-		"aegon",
-		"aemon",
-		"aerys",
-		"affair",
-		"affairs",
-		"affirmative",
-		"affirms",
-		"afford",
-		"after",
-		"aftermath",
-		"afterwards",
-		"again",
-		"against",
-		"age",
-		"agenda",
-		"aggressive",
-		"aggressively",
-		"agitated",
-		"ago",
-		"agony",
-		"agree",
-		"agreeing",
-		"agreements",
-		"ahead",
-		"aid",
-		"aims",
-		"air",
-		"alayne",
-		"albino",
-		"ale",
-		"alerting",
-		"alight",
-		"alighted",
-		"aligned",
-		"alike",
-		"all",
-		"alleged",
-		"allegedly",
-		"allegiance",
-		"allegiances",
-		"alliance",
-		"alliances",
-		"allies",
-		"alliser",
-		"allowed",
-		"allowing",
-		"allows",
-		"ally",
-		"alone",
-		"along",
-		"alongside",
-		"aloud",
-		"already",
-		"also",
-		"ambitions",
-		"ambush",
-		"amidst",
-		"among",
-		"amory",
-		"amused",
-		"ancestral",
-		"and",
-		"anew",
-		"anger",
-		"angered",
-		"angrily",
-		"angry",
-		"anguish",
-		"animal",
-		"announce",
-		"announces",
-		"annoyance",
-		"annoyed",
-		"annoys",
-		"anointed",
-		"answer",
-		"answered",
-		"answering",
-		"anticipated",
-		"any",
-		"anymore",
-		"anyone",
-		"anything",
-		"apart",
-		"apologetically",
-		"apologize",
-		"apologizes",
-		"apology",
-		"apoplectic",
-		"apparent",
-		"appeals",
-		"appear",
-		"appeared",
-		"appears",
-		"appetites",
-		"applies",
-		"appointed",
-		"appreciates",
-		"apprehended",
-		"apprehensive",
-		"approaches",
-		"approaching",
-		"appropriate",
-		"approve",
-		"approximately",
-		"arable",
-		"archery",
-		"archmaester",
-		"area",
-		"arena",
-		"areo",
-		"argue",
-		"arguing",
-		"argument",
-		"armed",
-		"armies",
-		"armor",
-		"arms",
-		"army",
-		"arose",
-		"around",
-		"arouse",
-		"arrange",
-		"arranged",
-		"arrangement",
-		"arranging",
-		"arrest",
-		"arrive",
-		"arrived",
-		"arrives",
-		"arriving",
-		"arrogant",
-		"arrow",
-		"arrowhead",
-		"arryn",
-		"art",
-		"arthur",
-		"arya",
-		"ashes",
-		"aside",
-		"ask",
-		"asked",
-		"asking",
-		"asks",
-		"asleep",
-		"assailants",
-		"assassination",
-		"assassins",
-		"assault",
-		"assaulted",
-		"assembled",
-		"assertion",
-		"asserts",
-		"asshai",
-		"assist",
-		"assistance",
-		"associate",
-		"associated",
-		"association",
-		"assumes",
-		"assure",
-		"assuredly",
-		"assures",
-		"astapor",
-		"astonished",
-		"astonishment",
-		"atrocities",
-		"attached",
-		"attack",
-		"attacked",
-		"attacking",
-		"attacks",
-		"attempt",
-		"attempted",
-		"attempting",
-		"attempts",
-		"attend",
-		"attendance",
-		"attention",
-		"attentions",
-		"attitude",
-		"attracted",
-		"attracting",
-		"audience",
-		"aunt",
-		"authenticity",
-		"avoid",
-		"avoiding",
-		"await",
-		"awake",
-		"awakens",
-		"awarded",
-		"aware",
-		"away",
-		"awestruck",
-		"awoken",
-		"axe",
-		"axes",
-		"babies",
-		"baby",
-		"back",
-		"background",
-		"backs",
-		"backstory",
-		"backtrack",
-		"bad",
-		"badly",
-		"baelor",
-		"bag",
-		"bags",
-		"baking",
-		"balerion",
-		"balks",
-		"ballista",
-		"balon",
-		"band",
-		"bank",
-		"bannen",
-		"banner",
-		"bannermen",
-		"banners",
-		"banquet",
-		"baratheon",
-		"barbarian",
-		"barbarians",
-		"bare",
-		"barely",
-		"barking",
-		"barring",
-		"barristan",
-		"base",
-		"basic",
-		"bastards",
-		"bath",
-		"bathing",
-		"battle",
-		"bay",
-		"beach",
-		"bearing",
-		"bears",
-		"beautiful",
-		"beauty",
-		"became",
-		"becomes",
-		"becoming",
-		"bed",
-		"bedlam",
-		"beds",
-		"been",
-		"beetles",
-		"before",
-		"beg",
-		"begin",
-		"beginning",
-		"begins",
-		"begs",
-		"behalf",
-		"behavior",
-		"behead",
-		"beheaded",
-		"beheading",
-		"behind",
-		"being",
-		"belatedly",
-		"believable",
-		"believe",
-		"believes",
-		"believing",
-		"belittles",
-		"bellies",
-		"bells",
-		"belong",
-		"belonging",
-		"belongs",
-		"beloved",
-		"below",
-		"bend",
-		"beneath",
-		"benjen",
-		"berate",
-		"beseechingly",
-		"beside",
-		"besiege",
-		"besieged",
-		"best",
-		"betray",
-		"betrayal",
-		"betrayed",
-		"betraying",
-		"betrothal",
-		"betrothed",
-		"better",
-		"between",
-		"bewildered",
-		"bewilderment",
-		"beyond",
-		"bide",
-		"bids",
-		"big",
-		"bigger",
-		"bind",
-		"bird",
-		"birds",
-		"birth",
-		"bit",
-		"bitch",
-		"bitching",
-		"bits",
-		"bitten",
-		"bitter",
-		"black",
-		"blackfish",
-		"blackwater",
-		"blade",
-		"blankly",
-		"blasts",
-		"blatant",
-		"blazing",
-		"bleeding",
-		"blessing",
-		"blew",
-		"blind",
-		"blindly",
-		"blithely",
-		"blizzard",
-		"block",
-		"blocks",
-		"blood-stained",
-		"bloody",
-		"blow",
-		"blue",
-		"bluff",
-		"blunt",
-		"bluntly",
-		"blurts",
-		"boarding",
-		"boat",
-		"bodies",
-		"bodily",
-		"body",
-		"bodyguard",
-		"bolt",
-		"bolton",
-		"boltons",
-		"bolts",
-		"bonds",
-		"bones",
-		"books",
-		"bored",
-		"born",
-		"borrowing",
-		"botches",
-		"both",
-		"bother",
-		"bound",
-		"bounty",
-		"bow",
-		"bowen",
-		"bowl",
-		"box",
-		"boy",
-		"boy-king",
-		"boys",
-		"braavos",
-		"bracken",
-		"bragging",
-		"brandon",
-		"brave",
-		"bravely",
-		"bravery",
-		"brawl",
-		"brazier",
-		"bread",
-		"break",
-		"breakfast",
-		"breaking",
-		"breast",
-		"breath",
-		"breathes",
-		"breeder",
-		"breeds",
-		"bribing",
-		"bride",
-		"bridge",
-		"brief",
-		"briefly",
-		"brienne",
-		"bringing",
-		"brings",
-		"broke",
-		"broken",
-		"brood",
-		"brothel",
-		"brother",
-		"brotherhood",
-		"brothers",
-		"brought",
-		"brushes",
-		"brutal",
-		"brynden",
-		"build",
-		"built",
-		"bullseye",
-		"bumps",
-		"burial",
-		"buried",
-		"burn",
-		"burned",
-		"burning",
-		"burns",
-		"burnt",
-		"business",
-		"butcher",
-		"butchered",
-		"butchering",
-		"buy",
-		"bypassing",
-		"cage",
-		"cailin",
-		"calculating",
-		"call",
-		"called",
-		"calling",
-		"calls",
-		"calmly",
-		"came",
-		"campaign",
-		"can",
-		"cancel",
-		"candidate",
-		"candied",
-		"candles",
-		"cannibalistic",
-		"cannot",
-		"canteen",
-		"capable",
-		"cape",
-		"capital",
-		"captain",
-		"captive",
-		"captives",
-		"captivity",
-		"capture",
-		"captured",
-		"captures",
-		"care",
-		"cared",
-		"cares",
-		"caring",
-		"carnage",
-		"carry",
-		"carrying",
-		"carved",
-		"cases",
-		"cassel",
-		"cast",
-		"castamere",
-		"casterly",
-		"castle",
-		"castle-seat",
-		"castrated",
-		"castration",
-		"casts",
-		"casually",
-		"cat-o-nine-tails",
-		"catacombs",
-		"catapulting",
-		"catches",
-		"catching",
-		"catelyn",
-		"caught",
-		"cause",
-		"caused",
-		"causes",
-		"causing",
-		"cauterizes",
-		"caution",
-		"cautions",
-		"cautiously",
-		"cease",
-		"celebration",
-		"celebratory",
-		"cell",
-		"centuries",
-		"ceremony",
-		"cersei",
-		"certainly",
-		"chagrin",
-		"chains",
-		"challenges",
-		"chamber",
-		"chambers",
-		"champion",
-		"change",
-		"changed",
-		"changes",
-		"changing",
-		"chanting",
-		"chaos",
-		"charge",
-		"charged",
-		"charges",
-		"charging",
-		"charitable",
-		"charity",
-		"charred",
-		"chased",
-		"chases",
-		"chasing",
-		"chastises",
-		"chat",
-		"chats",
-		"cheek",
-		"cheered",
-		"cheerfully",
-		"cheers",
-		"chest",
-		"chides",
-		"chiding",
-		"child",
-		"children",
-		"choice",
-		"choke",
-		"choking",
-		"chooses",
-		"chop",
-		"chose",
-		"chuckles",
-		"circle",
-		"circles",
-		"circular",
-		"circulating",
-		"circumstances",
-		"citadel",
-		"cite",
-		"cities",
-		"citing",
-		"city",
-		"clad",
-		"claim",
-		"claims",
-		"clam-seller",
-		"clans",
-		"clapped",
-		"clarifies",
-		"claw",
-		"clawing",
-		"clean",
-		"clear",
-		"cleared",
-		"cleftjaw",
-		"clegane",
-		"clever",
-		"cliffs",
-		"climb",
-		"climbing",
-		"climbs",
-		"clings",
-		"cloak",
-		"cloaks",
-		"closely",
-		"closing",
-		"clothes",
-		"clothing",
-		"club",
-		"clues",
-		"clumsy",
-		"coast",
-		"coded",
-		"coerce",
-		"coin",
-		"cold",
-		"cold-weather",
-		"coldly",
-		"collapse",
-		"collapses",
-		"collected",
-		"colliding",
-		"color",
-		"coma",
-		"combatants",
-		"combination",
-		"combined",
-		"comes",
-		"comfort",
-		"coming",
-		"commanded",
-		"commander",
-		"commanding",
-		"commemorate",
-		"commemorating",
-		"commence",
-		"commences",
-		"commends",
-		"commenting",
-		"comments",
-		"commit",
-		"committed",
-		"common",
-		"commoners",
-		"communicated",
-		"companions",
-		"company",
-		"comparison",
-		"compile",
-		"complain",
-		"complains",
-		"complete",
-		"completely",
-		"complimenting",
-		"composure",
-		"comrade",
-		"concedes",
-		"concept",
-		"concern",
-		"concerns",
-		"condition",
-		"conditions",
-		"condolences",
-		"conducting",
-		"confess",
-		"confession",
-		"confidence",
-		"confident",
-		"confides",
-		"confined",
-		"confirm",
-		"confirming",
-		"confirms",
-		"confiscates",
-		"confront",
-		"confronted",
-		"confused",
-		"confusion",
-		"conqueror",
-		"conscious",
-		"consciously",
-		"consciousness",
-		"conscripted",
-		"consenting",
-		"consents",
-		"consider",
-		"considerable",
-		"considered",
-		"considering",
-		"considers",
-		"consisting",
-		"consists",
-		"consolation",
-		"conspicuous",
-		"conspire",
-		"constant",
-		"constantly",
-		"consternation",
-		"consummate",
-		"consummated",
-		"contact",
-		"container",
-		"containing",
-		"contains",
-		"contemplates",
-		"contemplating",
-		"contempt",
-		"content",
-		"contents",
-		"continue",
-		"continued",
-		"continues",
-		"continuing",
-		"contract",
-		"contracted",
-		"contrary",
-		"control",
-		"controlled",
-		"controlling",
-		"convalesces",
-		"convenes",
-		"conveniently",
-		"conversation",
-		"convince",
-		"convinced",
-		"convinces",
-		"convincing",
-		"convincingly",
-		"cook",
-		"cool",
-		"cools",
-		"copy",
-		"corbray",
-		"corpse",
-		"corpses",
-		"corrected",
-		"correcting",
-		"correctly",
-		"correspondence",
-		"cost",
-		"cough",
-		"could",
-		"council",
-		"counseling",
-		"counsels",
-		"counters",
-		"country",
-		"countryside",
-		"couple",
-		"course",
-		"court",
-		"courtyards",
-		"cousins",
-		"covering",
-		"cow",
-		"coward",
-		"cowering",
-		"cowers",
-		"crashes",
-		"crassly",
-		"craster",
-		"crawls",
-		"crazed",
-		"created",
-		"creating",
-		"creature",
-		"creatures",
-		"cries",
-		"crime",
-		"crimes",
-		"criminals",
-		"crippled",
-		"criticism",
-		"criticize",
-		"criticizes",
-		"cross",
-		"cross-country",
-		"crossbow",
-		"crossing",
-		"crossroads",
-		"crow",
-		"crowd",
-		"crowded",
-		"crowds",
-		"crown",
-		"crownlands",
-		"crows",
-		"crucified",
-		"crudely",
-		"cry",
-		"crying",
-		"crypts",
-		"cunning",
-		"cupbearer",
-		"cure",
-		"curious",
-		"curiously",
-		"current",
-		"currently",
-		"curse",
-		"cursing",
-		"curtailed",
-		"custom",
-		"customary",
-		"cut",
-		"daario",
-		"daenery",
-		"dagger",
-		"daggers",
-		"dagmer",
-		"dalba",
-		"danger",
-		"dangers",
-		"dany",
-		"dark",
-		"darker",
-		"darkness",
-		"dart",
-		"daughter",
-		"daughter-fucking",
-		"daughters",
-		"dawn",
-		"daybreak",
-		"dayne",
-		"days",
-		"dead",
-		"deal",
-		"dealing",
-		"deals",
-		"dealt",
-		"death",
-		"debate",
-		"decanter",
-		"decapitating",
-		"deceased",
-		"deceit",
-		"decide",
-		"decided",
-		"decides",
-		"decision",
-		"decisions",
-		"decisive",
-		"decisively",
-		"deck",
-		"decks",
-		"declared",
-		"declares",
-		"declaring",
-		"declines",
-		"decree",
-		"decrees",
-		"deduces",
-		"deeply",
-		"defeat",
-		"defeated",
-		"defend",
-		"defended",
-		"defenders",
-		"defends",
-		"defense",
-		"defensive",
-		"defiance",
-		"defied",
-		"definite",
-		"deflect",
-		"deformities",
-		"degenerate",
-		"degree",
-		"delicately",
-		"delight",
-		"delighted",
-		"delivered",
-		"delivering",
-		"demand",
-		"demanding",
-		"demands",
-		"demon",
-		"demonstrating",
-		"demure",
-		"denied",
-		"denies",
-		"denounces",
-		"denys",
-		"depart",
-		"departs",
-		"departure",
-		"depends",
-		"deploy",
-		"depression",
-		"depthless",
-		"deranged",
-		"describe",
-		"described",
-		"describing",
-		"description",
-		"desecrated",
-		"deserter",
-		"deserters",
-		"deserting",
-		"deserve",
-		"deserved",
-		"deserves",
-		"designating",
-		"desire",
-		"desires",
-		"desperately",
-		"desperation",
-		"despite",
-		"despondent",
-		"despondently",
-		"destination",
-		"destroy",
-		"destroyed",
-		"detachment",
-		"determine",
-		"determined",
-		"devastating",
-		"developing",
-		"devolves",
-		"devoted",
-		"diamonds",
-		"dickon",
-		"did",
-		"die",
-		"died",
-		"dies",
-		"difference",
-		"differences",
-		"different",
-		"dignity",
-		"dim",
-		"diminishing",
-		"dine",
-		"direct",
-		"directed",
-		"direction",
-		"directions",
-		"directly",
-		"direwolf",
-		"disagrees",
-		"disappeared",
-		"disappointed",
-		"disappointment",
-		"disarms",
-		"disaster",
-		"disbelief",
-		"discomfort",
-		"discontent",
-		"discover",
-		"discovered",
-		"discovering",
-		"discovers",
-		"discovery",
-		"discuss",
-		"discusses",
-		"discussing",
-		"disemboweled",
-		"disguise",
-		"disheveled",
-		"dismay",
-		"dismembered",
-		"dismiss",
-		"dismisses",
-		"dismissive",
-		"dismissively",
-		"dismounting",
-		"dispatches",
-		"disperses",
-		"display",
-		"displays",
-		"displeased",
-		"disposed",
-		"disregards",
-		"dissatisfaction",
-		"dissatisfied",
-		"dissuade",
-		"distance",
-		"distaste",
-		"distasteful",
-		"distract",
-		"distracted",
-		"distracts",
-		"distresses",
-		"distrusts",
-		"disturbed",
-		"disturbs",
-		"dithers",
-		"diverse",
-		"divert",
-		"divide",
-		"divine",
-		"document",
-		"dodges",
-		"does",
-		"dogs",
-		"doing",
-		"dondarrion",
-		"done",
-		"donned",
-		"donnel",
-		"dons",
-		"door",
-		"doors",
-		"doran",
-		"dorne",
-		"dornish",
-		"dothrak",
-		"dothraki",
-		"double",
-		"double-blow",
-		"double-cross",
-		"doubt",
-		"doubts",
-		"down",
-		"downfall",
-		"downstream",
-		"dozen",
-		"dozens",
-		"dracarys",
-		"drag",
-		"dragged",
-		"dragging",
-		"dragon",
-		"dragonglass",
-		"dragons",
-		"dragonstone",
-		"drags",
-		"drains",
-		"draw",
-		"drawn",
-		"draws",
-		"dread",
-		"dreadfort",
-		"dream",
-		"dream-memory",
-		"dreamed",
-		"dreams",
-		"dress",
-		"dresses",
-		"driftwood",
-		"drily",
-		"drinking",
-		"drinks",
-		"driven",
-		"drogo",
-		"drop",
-		"droplets",
-		"drops",
-		"drove",
-		"drowning",
-		"drunk",
-		"drunken",
-		"drunkenly",
-		"dryly",
-		"duel",
-		"dull",
-		"dullest",
-		"dumbfounded",
-		"dung",
-		"duress",
-		"during",
-		"duties",
-		"dutiful",
-		"duty",
-		"dwarf",
-		"dwarfs",
-		"dwindled",
-		"dwindles",
-		"dying",
-		"dynasty",
-		"each",
-		"eager",
-		"eagle",
-		"earlier",
-		"early",
-		"ease",
-		"easily",
-		"east",
-		"eastwatch",
-		"easy",
-		"eating",
-		"eats",
-		"ebrose",
-		"eccentricities",
-		"edd",
-		"eddard",
-		"eddison",
-		"edmure",
-		"effective",
-		"effectively",
-		"effectiveness",
-		"effects",
-		"effort",
-		"effortlessly",
-		"eggs",
-		"either",
-		"elaborate",
-		"elated",
-		"elder",
-		"elderly",
-		"eldest",
-		"eliminated",
-		"eliminating",
-		"ellaria",
-		"else",
-		"elsewhere",
-		"emasculation",
-		"embark",
-		"embarrassed",
-		"embrace",
-		"embraced",
-		"embroiders",
-		"emerge",
-		"emotion",
-		"emotional",
-		"emphasizes",
-		"emphatically",
-		"empties",
-		"empty",
-		"encounter",
-		"encountered",
-		"encounters",
-		"encouraged",
-		"end",
-		"endanger",
-		"ending",
-		"endlessly",
-		"endured",
-		"enduring",
-		"enemies",
-		"enemy",
-		"engage",
-		"engaged",
-		"engages",
-		"engaging",
-		"engulfing",
-		"enjoyed",
-		"enjoying",
-		"enjoyment",
-		"enjoys",
-		"enough",
-		"enraged",
-		"enrages",
-		"enraging",
-		"enslaved",
-		"ensure",
-		"enter",
-		"entered",
-		"entering",
-		"enters",
-		"entire",
-		"entirely",
-		"equal",
-		"era",
-		"erupts",
-		"escaped",
-		"escapes",
-		"escaping",
-		"escort",
-		"escorts",
-		"especially",
-		"essos",
-		"estate",
-		"estranged",
-		"eulogy",
-		"eunuch",
-		"euron",
-		"evades",
-		"even",
-		"evenly",
-		"event",
-		"events",
-		"eventually",
-		"ever",
-		"ever-increasing",
-		"every",
-		"everyone",
-		"everything",
-		"everywhere",
-		"evidence",
-		"evil",
-		"exactly",
-		"examining",
-		"example",
-		"except",
-		"exchange",
-		"excited",
-		"excludes",
-		"excrement",
-		"excursion",
-		"excuses",
-		"execute",
-		"executed",
-		"executing",
-		"execution",
-		"executions",
-		"exhaustion",
-		"exiled",
-		"exiles",
-		"existed",
-		"expect",
-		"expected",
-		"expedition",
-		"expeditionary",
-		"experience",
-		"experiences",
-		"experiencing",
-		"experimentation",
-		"experiments",
-		"explain",
-		"explained",
-		"explaining",
-		"explains",
-		"explanation",
-		"explanations",
-		"explodes",
-		"exposing",
-		"expressed",
-		"expresses",
-		"expression",
-		"extend",
-		"extended",
-		"extra",
-		"extremely",
-		"eye",
-		"eyes",
-		"eyrie",
-		"fabricates",
-		"faces",
-		"facilitate",
-		"failed",
-		"failings",
-		"failure",
-		"fair",
-		"faith",
-		"fake",
-		"fall",
-		"falling",
-		"falls",
-		"families",
-		"familiy",
-		"family",
-		"famished",
-		"fancied",
-		"fangs",
-		"far",
-		"farewell",
-		"farm",
-		"farmer",
-		"farmstead",
-		"fashion",
-		"fastest",
-		"fatal",
-		"fate",
-		"father",
-		"father-figure",
-		"fathers",
-		"favorably",
-		"favorite",
-		"favors",
-		"fealty",
-		"fearing",
-		"fearless",
-		"fears",
-		"feast",
-		"fee",
-		"feed",
-		"feeding",
-		"feel",
-		"feeling",
-		"feelings",
-		"feels",
-		"feet",
-		"feigning",
-		"feint",
-		"fell",
-		"fellow",
-		"ferry",
-		"fervor",
-		"festivities",
-		"fetch",
-		"feverish",
-		"few",
-		"fiance",
-		"fidgets",
-		"fierce",
-		"fiercely",
-		"fiery",
-		"fifty",
-		"fight",
-		"fighter",
-		"fights",
-		"figure",
-		"fill",
-		"filled",
-		"filthy",
-		"final",
-		"finally",
-		"find",
-		"finding",
-		"finds",
-		"fine",
-		"fingernails",
-		"finish",
-		"fire",
-		"firewood",
-		"firmly",
-		"first",
-		"firstborn",
-		"fist",
-		"fit",
-		"fits",
-		"fitted",
-		"fix",
-		"flag",
-		"flail",
-		"flames",
-		"flaming",
-		"flashback",
-		"flatly",
-		"flatter",
-		"flayed",
-		"fled",
-		"flee",
-		"fleeing",
-		"flees",
-		"fleet",
-		"flies",
-		"flinching",
-		"flings",
-		"flirt",
-		"flirts",
-		"floor",
-		"fly",
-		"flying",
-		"fold",
-		"folk",
-		"follow",
-		"followed",
-		"followers",
-		"following",
-		"follows",
-		"fond",
-		"fondles",
-		"food",
-		"fool",
-		"foolhardy",
-		"foot",
-		"for",
-		"foraging",
-		"forbids",
-		"force",
-		"forced",
-		"forces",
-		"forcing",
-		"ford",
-		"fording",
-		"forefront",
-		"foreign",
-		"foreseeing",
-		"forest",
-		"forested",
-		"forever",
-		"forgave",
-		"forge",
-		"forged",
-		"forgive",
-		"forgiveness",
-		"fork",
-		"forlorn",
-		"form",
-		"formally",
-		"formation",
-		"formed",
-		"former",
-		"forming",
-		"fornication",
-		"fortress",
-		"fortune",
-		"forty",
-		"forward",
-		"fostered",
-		"fought",
-		"found",
-		"four",
-		"fragments",
-		"frantic",
-		"frantically",
-		"free",
-		"freed",
-		"freefall",
-		"freeing",
-		"freely",
-		"frees",
-		"frets",
-		"frey",
-		"freys",
-		"friend",
-		"friendly",
-		"frighten",
-		"from",
-		"front",
-		"frost-covered",
-		"frostfangs",
-		"frosty",
-		"frozen",
-		"fuck",
-		"fulfil",
-		"fulfill",
-		"fulfilling",
-		"full",
-		"fully",
-		"funding",
-		"funeral",
-		"furiously",
-		"further",
-		"furthermore",
-		"fury",
-		"future",
-		"gains",
-		"gallery",
-		"gamble",
-		"game",
-		"games",
-		"gang-rape",
-		"garb",
-		"gardens",
-		"gared",
-		"garrison",
-		"gasps",
-		"gate",
-		"gates",
-		"gathered",
-		"gathering",
-		"gathers",
-		"gave",
-		"gawking",
-		"gazes",
-		"gender",
-		"gendry",
-		"general",
-		"generally",
-		"generously",
-		"gentlemen",
-		"genuinely",
-		"gerold",
-		"get",
-		"getting",
-		"ghiscari",
-		"ghost",
-		"ghosts",
-		"giant",
-		"giantsbane",
-		"gift",
-		"gifted",
-		"gifts",
-		"gilly",
-		"girl",
-		"girls",
-		"give",
-		"given",
-		"gives",
-		"giving",
-		"glad",
-		"glance",
-		"glass",
-		"gleeful",
-		"gleefully",
-		"glimpse",
-		"glimpses",
-		"gloat",
-		"gloating",
-		"glover",
-		"glovers",
-		"glowing",
-		"goads",
-		"god",
-		"gods",
-		"godswood",
-		"goes",
-		"going",
-		"gold",
-		"golden",
-		"gone",
-		"good",
-		"gore",
-		"gossip",
-		"got",
-		"grabs",
-		"grand",
-		"granddaughter",
-		"granddaughters",
-		"grandfather",
-		"grandmother",
-		"grandson",
-		"grateful",
-		"great",
-		"greatjon",
-		"greatly",
-		"greatsword",
-		"greensight",
-		"greet",
-		"greeted",
-		"greets",
-		"gregor",
-		"grenn",
-		"grew",
-		"grey",
-		"greyjoy",
-		"greyjoys",
-		"greyscale",
-		"grief",
-		"grin",
-		"groom",
-		"ground",
-		"group",
-		"groups",
-		"grow",
-		"growing",
-		"grown",
-		"grows",
-		"gruesome",
-		"grumbles",
-		"guarding",
-		"guards",
-		"guest",
-		"guests",
-		"guilt",
-		"guilty",
-		"guzzles",
-		"hack",
-		"hacks",
-		"had",
-		"hail",
-		"hair",
-		"half",
-		"half-brother",
-		"half-delirious",
-		"half-sisters",
-		"halfhand",
-		"hall",
-		"halls",
-		"hamstring",
-		"hand",
-		"handed",
-		"handmaids",
-		"handrail",
-		"hands",
-		"hanged",
-		"hanging",
-		"hangs",
-		"happen",
-		"happened",
-		"happening",
-		"happily",
-		"happy",
-		"harass",
-		"harbor",
-		"hard",
-		"hardened",
-		"hardhome",
-		"hardly",
-		"harlot",
-		"harm",
-		"harpy",
-		"harrenhal",
-		"has",
-		"hastily",
-		"hatch",
-		"hated",
-		"hates",
-		"haunted",
-		"having",
-		"head",
-		"heading",
-		"headlong",
-		"headquarters",
-		"heads",
-		"healthy",
-		"hear",
-		"heard",
-		"hearing",
-		"hears",
-		"heart",
-		"heartbroken",
-		"hearth",
-		"heavily",
-		"heavy",
-		"height",
-		"heights",
-		"heir",
-		"held",
-		"hells",
-		"helm-less",
-		"help",
-		"helping",
-		"helpless",
-		"helplessly",
-		"helps",
-		"her",
-		"here",
-		"hero",
-		"heroes",
-		"hers",
-		"herself",
-		"hesitate",
-		"hid",
-		"hidden",
-		"hide",
-		"hideout",
-		"hideouts",
-		"hides",
-		"hiding",
-		"high",
-		"high-ranking",
-		"higher",
-		"higher-quality",
-		"highgarden",
-		"highlight",
-		"highly",
-		"hill",
-		"hilt",
-		"him",
-		"himself",
-		"hired",
-		"hiring",
-		"his",
-		"hiss",
-		"histories",
-		"history",
-		"hit",
-		"hits",
-		"hizdahr",
-		"hodor",
-		"hold",
-		"holding",
-		"holds",
-		"hollard",
-		"hollers",
-		"hollow",
-		"home",
-		"homosexuality",
-		"honor",
-		"honorable",
-		"hood",
-		"hooded",
-		"hope",
-		"hopeless",
-		"hopes",
-		"hoping",
-		"horde",
-		"hordes",
-		"horn",
-		"horrible",
-		"horrifically",
-		"horrified",
-		"horrify",
-		"horrifying",
-		"horror",
-		"horse",
-		"horse-wight",
-		"horseback",
-		"horses",
-		"hospitality",
-		"hostage",
-		"hoster",
-		"hot",
-		"hours",
-		"house",
-		"household",
-		"houses",
-		"hovers",
-		"how",
-		"however",
-		"huddle",
-		"hugging",
-		"human",
-		"humanoid",
-		"humiliate",
-		"humiliating",
-		"humor",
-		"hundred",
-		"hundreds",
-		"hunt",
-		"hunting",
-		"hurl",
-		"hurry",
-		"hurt",
-		"hut",
-		"hypothermia",
-		"ice",
-		"icy",
-		"idea",
-		"idealistically",
-		"identified",
-		"identifying",
-		"ignored",
-		"ignores",
-		"ill-fated",
-		"illegitimacy",
-		"illegitimate",
-		"illness",
-		"illusions",
-		"illyrio",
-		"imitating",
-		"immediate",
-		"immense",
-		"imp",
-		"impaling",
-		"impatient",
-		"impatiently",
-		"impending",
-		"implication",
-		"implies",
-		"implores",
-		"implying",
-		"important",
-		"imposed",
-		"impossible",
-		"imposter",
-		"impress",
-		"impressed",
-		"impressing",
-		"imprisoned",
-		"impromptu",
-		"improved",
-		"improvement",
-		"impulsiveness",
-		"inappropriate",
-		"incapacitated",
-		"incest",
-		"incident",
-		"incompetence",
-		"indebted",
-		"indeed",
-		"indeterminate",
-		"indicates",
-		"indicating",
-		"indifferent",
-		"individuals",
-		"indulge",
-		"indulges",
-		"inevitable",
-		"infamous",
-		"infant",
-		"infatuation",
-		"infected",
-		"infection",
-		"infiltrate",
-		"inflict",
-		"inflicted",
-		"influence",
-		"informants",
-		"information",
-		"informed",
-		"informs",
-		"infuriated",
-		"inhabitants",
-		"initially",
-		"injure",
-		"injuries",
-		"injuring",
-		"inn",
-		"innocent",
-		"inquires",
-		"inside",
-		"insist",
-		"insistence",
-		"insisting",
-		"insists",
-		"instability",
-		"instead",
-		"instill",
-		"instructing",
-		"instructions",
-		"instructs",
-		"insult",
-		"insulted",
-		"intact",
-		"integrity",
-		"intelligent",
-		"intend",
-		"intending",
-		"intends",
-		"intent",
-		"intention",
-		"intents",
-		"intercept",
-		"interest",
-		"interesting",
-		"interests",
-		"interjects",
-		"internal",
-		"interpretation",
-		"interrogate",
-		"interrogates",
-		"interrupted",
-		"intervene",
-		"intimate",
-		"intimidated",
-		"into",
-		"intrigued",
-		"introducing",
-		"intruder",
-		"invade",
-		"invaded",
-		"invaders",
-		"invasion",
-		"investigate",
-		"investigates",
-		"investigation",
-		"investigations",
-		"investment",
-		"invincible",
-		"invitation",
-		"involves",
-		"inwardly",
-		"iron",
-		"ironborn",
-		"ironmen",
-		"irrelevant",
-		"irri",
-		"irritation",
-		"island",
-		"islands",
-		"isle",
-		"issue",
-		"items",
-		"its",
-		"itself",
-		"jabs",
-		"janos",
-		"jaqen",
-		"jealousy",
-		"jeor",
-		"jewels",
-		"joffrey",
-		"join",
-		"joined",
-		"joining",
-		"jojen",
-		"jokes",
-		"joking",
-		"jon",
-		"jorah",
-		"jory",
-		"joyeuse",
-		"joyful",
-		"jubilantly",
-		"judge",
-		"jumps",
-		"just",
-		"justice",
-		"karsi",
-		"karstark",
-		"keeping",
-		"kenning",
-		"kept",
-		"kevan",
-		"keys",
-		"khalasar",
-		"khaleen",
-		"kick",
-		"kiled",
-		"kill",
-		"killing",
-		"kills",
-		"kind",
-		"king",
-		"king-beyond-the-wall",
-		"kingdom",
-		"kingdoms",
-		"kings",
-		"kingsguard",
-		"kingslayer",
-		"kissing",
-		"knee",
-		"kneels",
-		"knew",
-		"knife",
-		"knight",
-		"knighthood",
-		"knights",
-		"knocked",
-		"knocking",
-		"knocks",
-		"knowing",
-		"known",
-		"knows",
-		"kovarro",
-		"kraken",
-		"krakens",
-		"kraznys",
-		"lack",
-		"lacks",
-		"laden",
-		"ladies",
-		"lady",
-		"laid",
-		"lake",
-		"laments",
-		"land",
-		"landed",
-		"landing",
-		"lands",
-		"language",
-		"languages",
-		"lannister",
-		"lannisters",
-		"larder",
-		"large",
-		"largely",
-		"lashes",
-		"lasting",
-		"late",
-		"later",
-		"latest",
-		"latter",
-		"laughing",
-		"laughs",
-		"laughter",
-		"lavish",
-		"law",
-		"laws",
-		"lay",
-		"laying",
-		"lazy",
-		"leaders",
-		"leading",
-		"leads",
-		"league",
-		"leaping",
-		"learn",
-		"learned",
-		"learning",
-		"learns",
-		"leash",
-		"least",
-		"leather",
-		"leave",
-		"leaves",
-		"leaving",
-		"led",
-		"left",
-		"legal",
-		"legitimization",
-		"legs",
-		"lend",
-		"lending",
-		"lessons",
-		"let",
-		"lethal",
-		"lets",
-		"letter",
-		"letting",
-		"level",
-		"leveled",
-		"levies",
-		"liberate",
-		"liberation",
-		"library",
-		"lie",
-		"lied",
-		"liege",
-		"lies",
-		"lieutenants",
-		"life",
-		"lifeless",
-		"lifelong",
-		"lifts",
-		"light",
-		"lights",
-		"like",
-		"likely",
-		"limited",
-		"limps",
-		"line",
-		"lines",
-		"lingers",
-		"link",
-		"lions",
-		"lip",
-		"liquor",
-		"list",
-		"listening",
-		"literacy",
-		"literally",
-		"litter",
-		"little",
-		"littlefinger",
-		"live",
-		"lively",
-		"living",
-		"loboda",
-		"locke",
-		"locked",
-		"lodged",
-		"log",
-		"lollys",
-		"lone",
-		"long",
-		"long-lost",
-		"long-term",
-		"long-winded",
-		"longclaw",
-		"longingly",
-		"look",
-		"looking",
-		"looks",
-		"lorch",
-		"lord",
-		"lords",
-		"lordship",
-		"losing",
-		"losses",
-		"lot",
-		"lothar",
-		"loud",
-		"loudly",
-		"love",
-		"love-making",
-		"loved",
-		"lover",
-		"loves",
-		"low",
-		"lower",
-		"lowly",
-		"loyal",
-		"loyalty",
-		"luck",
-		"lull",
-		"lunges",
-		"lyanna",
-		"lying",
-		"mace",
-		"mad",
-		"maege",
-		"maester",
-		"maesters",
-		"magic",
-		"magical",
-		"magically",
-		"magister",
-		"maid",
-		"mail",
-		"main",
-		"maintains",
-		"major",
-		"make",
-		"makes",
-		"making",
-		"male",
-		"mallister",
-		"malnourished",
-		"man",
-		"manacled",
-		"manage",
-		"managed",
-		"manages",
-		"mance",
-		"mandon",
-		"maneuvers",
-		"manifest",
-		"manipulate",
-		"manipulating",
-		"manipulative",
-		"manpower",
-		"mansion",
-		"many",
-		"map",
-		"march",
-		"marched",
-		"marches",
-		"marching",
-		"mare",
-		"margaery",
-		"market",
-		"marriage",
-		"marriage-alliance",
-		"marriage-alliances",
-		"marry",
-		"martell",
-		"mask",
-		"massacre",
-		"massacred",
-		"masses",
-		"massive",
-		"massively",
-		"master",
-		"master-at-arms",
-		"masters",
-		"match",
-		"matches",
-		"maternal",
-		"matter",
-		"matter-of-factly",
-		"matters",
-		"mattress",
-		"mauled",
-		"mauls",
-		"maximum",
-		"may",
-		"meal",
-		"mean",
-		"meaning",
-		"meaningfully",
-		"meantime",
-		"meanwhile",
-		"meera",
-		"meereen",
-		"meeting",
-		"meets",
-		"melisandre",
-		"melt",
-		"melted",
-		"melting",
-		"member",
-		"members",
-		"memories",
-		"memory",
-		"men",
-		"menagerie",
-		"mental",
-		"mentioning",
-		"mentions",
-		"mercenaries",
-		"merchant",
-		"mercy",
-		"merely",
-		"message",
-		"messenger",
-		"messenger-raven",
-		"met",
-		"middle",
-		"midst",
-		"might",
-		"mildly",
-		"miles",
-		"militant",
-		"military",
-		"mill",
-		"mind",
-		"minions",
-		"minor",
-		"misconduct",
-		"misery",
-		"misses",
-		"missing",
-		"mission",
-		"mistake",
-		"mistress",
-		"mixing",
-		"moaning",
-		"moat",
-		"mob",
-		"mobility",
-		"mock",
-		"mock-fence",
-		"mocking",
-		"mockingly",
-		"mocks",
-		"mole",
-		"mollified",
-		"moment",
-		"moments",
-		"money",
-		"monster",
-		"monsters",
-		"months",
-		"moon",
-		"moore",
-		"mopatis",
-		"moping",
-		"morale",
-		"more",
-		"moreover",
-		"morghulis",
-		"mormonts",
-		"morning",
-		"moro",
-		"morphs",
-		"mortally",
-		"mossador",
-		"most",
-		"mostly",
-		"mother",
-		"mother-tongue",
-		"mothers",
-		"motions",
-		"motivated",
-		"motives",
-		"motte",
-		"motto",
-		"mottos",
-		"mount",
-		"mountain",
-		"mountains",
-		"mounted",
-		"mounting",
-		"mounts",
-		"mourns",
-		"mouth",
-		"mouthguard",
-		"move",
-		"moved",
-		"moves",
-		"moving",
-		"much",
-		"muffle",
-		"mule",
-		"multiple",
-		"murdered",
-		"murdering",
-		"murders",
-		"muses",
-		"musicians",
-		"musing",
-		"must",
-		"mutilate",
-		"mutilated",
-		"mutilating",
-		"mutineers",
-		"mutiny",
-		"muzzle",
-		"myrcella",
-		"mysterious",
-		"naharis",
-		"nail",
-		"naively",
-		"naked",
-		"nakloz",
-		"name",
-		"named",
-		"names",
-		"naming",
-		"narrow",
-		"narrowly",
-		"natural",
-		"nature",
-		"navigating",
-		"near",
-		"near-poisoning",
-		"nearby",
-		"nearly",
-		"neck",
-		"necklace",
-		"neckline",
-		"ned",
-		"needed",
-		"needle",
-		"needlework",
-		"needs",
-		"negligible",
-		"negotiate",
-		"negotiates",
-		"negotiations",
-		"neighboring",
-		"nephew",
-		"nervously",
-		"never",
-		"new",
-		"new-found",
-		"newborn",
-		"newcomers",
-		"news",
-		"next",
-		"niece",
-		"night",
-		"nightfort",
-		"nightmare",
-		"nights",
-		"ninety",
-		"noble",
-		"nobler",
-		"nobles",
-		"noblewoman",
-		"nominates",
-		"nonchalantly",
-		"none",
-		"nor",
-		"northern",
-		"northerners",
-		"northmen",
-		"note",
-		"noted",
-		"notes",
-		"nothing",
-		"notice",
-		"noticed",
-		"notices",
-		"noticing",
-		"noting",
-		"nudity",
-		"number",
-		"numbers",
-		"numerous",
-		"oath",
-		"oathbreaker",
-		"oaths",
-		"obara",
-		"objection",
-		"obliged",
-		"oblivious",
-		"observe",
-		"observes",
-		"obtained",
-		"obtains",
-		"obvious",
-		"occupiers",
-		"occupy",
-		"odd",
-		"off-screen",
-		"offense",
-		"offer",
-		"offered",
-		"offering",
-		"offers",
-		"office",
-		"officers",
-		"officially",
-		"often",
-		"ointment",
-		"old",
-		"older",
-		"olenna",
-		"olly",
-		"olyvar",
-		"omen",
-		"once",
-		"one",
-		"one-handed",
-		"one-on-one",
-		"one-sided",
-		"onion",
-		"only",
-		"onto",
-		"open",
-		"openly",
-		"opens",
-		"opines",
-		"opinion",
-		"opinions",
-		"opponent",
-		"opportunity",
-		"opposed",
-		"opposes",
-		"opposite",
-		"oppressed",
-		"opting",
-		"options",
-		"orchestrated",
-		"order",
-		"ordered",
-		"ordering",
-		"orders",
-		"orell",
-		"organization",
-		"original",
-		"originally",
-		"origins",
-		"ornately",
-		"orson",
-		"osha",
-		"othell",
-		"other",
-		"others",
-		"otherwise",
-		"out",
-		"outbursts",
-		"outcome",
-		"outdo",
-		"outdoor",
-		"outer",
-		"outlasted",
-		"outmatched",
-		"outnumber",
-		"outnumbered",
-		"outrage",
-		"outraged",
-		"outriders",
-		"outrun",
-		"outside",
-		"outskirts",
-		"outstayed",
-		"outstretched",
-		"over",
-		"overboard",
-		"overcome",
-		"overheard",
-		"overjoyed",
-		"overlooking",
-		"overnight",
-		"overpowers",
-		"overreacting",
-		"overrides",
-		"overseen",
-		"oversees",
-		"overthrown",
-		"overwhelmed",
-		"owes",
-		"own",
-		"ownership",
-		"paces",
-		"pack",
-		"pageantry",
-		"pain",
-		"pains",
-		"pair",
-		"palace",
-		"panics",
-		"parade",
-		"paralyzed",
-		"pardon",
-		"parents",
-		"parley",
-		"parlor",
-		"part",
-		"participants",
-		"participated",
-		"particular",
-		"particularly",
-		"party",
-		"pass",
-		"passage",
-		"passed",
-		"passes",
-		"passing",
-		"past",
-		"path",
-		"pathetically",
-		"paths",
-		"patient",
-		"patriarchy",
-		"patriotic",
-		"patriotism",
-		"pay",
-		"paying",
-		"payne",
-		"pays",
-		"peace",
-		"peals",
-		"peasants",
-		"pedestal",
-		"peers",
-		"pendant",
-		"pentos",
-		"people",
-		"per",
-		"perceive",
-		"perfectly-accented",
-		"perhaps",
-		"perish",
-		"permission",
-		"person",
-		"personal",
-		"personally",
-		"persuading",
-		"pet",
-		"petty",
-		"petulantly",
-		"petyr",
-		"physical",
-		"physically",
-		"picture",
-		"pie",
-		"pie-master",
-		"pieces",
-		"pierces",
-		"piercing",
-		"pigeons",
-		"piggy",
-		"pilfering",
-		"pillage",
-		"pillar",
-		"pinned",
-		"pinning",
-		"piss",
-		"pitied",
-		"placated",
-		"placates",
-		"place",
-		"plan",
-		"planned",
-		"plans",
-		"plant",
-		"plants",
-		"play",
-		"played",
-		"playfully",
-		"playing",
-		"plays",
-		"plea",
-		"pleads",
-		"pleas",
-		"pleasant",
-		"pleasantries",
-		"pleased",
-		"pledge",
-		"pledged",
-		"pledges",
-		"plotting",
-		"ploy",
-		"plums",
-		"plunder",
-		"plunges",
-		"plunging",
-		"pod",
-		"podrick",
-		"poetic",
-		"point",
-		"pointed",
-		"pointing",
-		"points",
-		"poised",
-		"poison",
-		"poisoned",
-		"policy",
-		"polite",
-		"politely",
-		"political",
-		"ponders",
-		"poor",
-		"port",
-		"pose",
-		"posed",
-		"poses",
-		"position",
-		"possess",
-		"possesses",
-		"possessions",
-		"possibility",
-		"possible",
-		"possibly",
-		"pot",
-		"potential",
-		"potentially",
-		"potion",
-		"potions",
-		"pounds",
-		"pour",
-		"poured",
-		"power",
-		"powerless",
-		"powers",
-		"pox",
-		"practical",
-		"practice",
-		"practiced",
-		"practicing",
-		"praise",
-		"pray",
-		"prayed",
-		"prayer",
-		"praying",
-		"preceding",
-		"pree",
-		"preens",
-		"prefer",
-		"preferable",
-		"prefers",
-		"pregnancy",
-		"pregnant",
-		"preparation",
-		"prepare",
-		"prepares",
-		"preparing",
-		"presence",
-		"presented",
-		"presents",
-		"preserve",
-		"presses",
-		"pretend",
-		"pretended",
-		"pretending",
-		"pretty",
-		"prevent",
-		"previous",
-		"priceless",
-		"pricklish",
-		"pride",
-		"priest",
-		"priestess",
-		"prince",
-		"princess",
-		"prior",
-		"prison",
-		"prisoners",
-		"privacy",
-		"private",
-		"privately",
-		"probability",
-		"probably",
-		"problems",
-		"process",
-		"proclaims",
-		"prod",
-		"prodigious",
-		"produce",
-		"produced",
-		"produces",
-		"profitable",
-		"progresses",
-		"project",
-		"promise",
-		"promised",
-		"promises",
-		"promotion",
-		"prompts",
-		"prone",
-		"properly",
-		"prophecy",
-		"proposal",
-		"proposed",
-		"proposes",
-		"prospect",
-		"prostitute",
-		"prostitutes",
-		"protect",
-		"protection",
-		"protests",
-		"protracted",
-		"prove",
-		"proven",
-		"proves",
-		"provide",
-		"provides",
-		"provoke",
-		"prow",
-		"prowess",
-		"prying",
-		"public",
-		"publicly",
-		"pulled",
-		"pulls",
-		"punch",
-		"punches",
-		"punish",
-		"punished",
-		"punishing",
-		"pup",
-		"pups",
-		"purchase",
-		"purpose",
-		"pursue",
-		"pursuit",
-		"pushes",
-		"put",
-		"putting",
-		"puzzles",
-		"pyat",
-		"pycelle",
-		"pyke",
-		"pylos",
-		"pypar",
-		"pyramid",
-		"pyre",
-		"qaggaz",
-		"qarth",
-		"qhorin",
-		"qualities",
-		"quality",
-		"quarters",
-		"queen",
-		"queensgard",
-		"queensguard",
-		"quest",
-		"question",
-		"questioned",
-		"questions",
-		"quick",
-		"quickly",
-		"quietly",
-		"quips",
-		"quite",
-		"qyburn",
-		"rage",
-		"rages",
-		"raid",
-		"raiders",
-		"raiding",
-		"raised",
-		"raises",
-		"raising",
-		"rallied",
-		"rallies",
-		"rally",
-		"rallying",
-		"ram",
-		"ramblings",
-		"ramp",
-		"rampant",
-		"ramsay",
-		"randyll",
-		"range",
-		"ranger",
-		"rangers",
-		"ranging",
-		"raped",
-		"rapidly",
-		"rapidly-growing",
-		"raping",
-		"rapist",
-		"rat",
-		"rather",
-		"raven-haired",
-		"ravens",
-		"rayder",
-		"reach",
-		"reached",
-		"reaches",
-		"reaching",
-		"react",
-		"reaction",
-		"read",
-		"reader",
-		"reading",
-		"reads",
-		"ready",
-		"reaffirm",
-		"real",
-		"realize",
-		"realized",
-		"realizes",
-		"realizing",
-		"really",
-		"realm",
-		"realms",
-		"reanimated",
-		"reason",
-		"reasonable",
-		"reasons",
-		"reassume",
-		"reassures",
-		"rebelling",
-		"rebellion",
-		"rebellious",
-		"rebuff",
-		"rebuffs",
-		"rebuilt",
-		"recalling",
-		"recalls",
-		"recapture",
-		"receive",
-		"received",
-		"receives",
-		"receiving",
-		"recent",
-		"reception",
-		"reciting",
-		"reclaim",
-		"reclusive",
-		"recognition",
-		"recognizing",
-		"reconquer",
-		"recover",
-		"recovered",
-		"recovers",
-		"recruit",
-		"recruits",
-		"red",
-		"red-haired",
-		"reduced",
-		"reduces",
-		"reed",
-		"reeds",
-		"reek",
-		"refer",
-		"reference",
-		"referred",
-		"reflects",
-		"refugee",
-		"refusal",
-		"refuse",
-		"refused",
-		"refuses",
-		"refusing",
-		"refute",
-		"regains",
-		"regarding",
-		"regards",
-		"regent",
-		"regime",
-		"regret",
-		"reigns",
-		"reiterates",
-		"rejected",
-		"rejecting",
-		"rejects",
-		"related",
-		"relations",
-		"relationship",
-		"relationships",
-		"relatively",
-		"relay",
-		"release",
-		"releases",
-		"relief",
-		"relies",
-		"relieved",
-		"religion",
-		"relives",
-		"reluctant",
-		"reluctantly",
-		"remain",
-		"remained",
-		"remaining",
-		"remarkable",
-		"remarks",
-		"remember",
-		"remembering",
-		"remembers",
-		"remind",
-		"reminded",
-		"reminding",
-		"remorse",
-		"remove",
-		"reneged",
-		"renly",
-		"renounced",
-		"reopening",
-		"repeatedly",
-		"repeating",
-		"repel",
-		"replace",
-		"replacing",
-		"replies",
-		"reply",
-		"report",
-		"reported",
-		"reportedly",
-		"reports",
-		"representative",
-		"reputation",
-		"requests",
-		"requires",
-		"requiring",
-		"rescue",
-		"rescued",
-		"resist",
-		"resisted",
-		"resolute",
-		"respect",
-		"respectful",
-		"respectively",
-		"respects",
-		"respond",
-		"response",
-		"responsibilities",
-		"responsible",
-		"rest",
-		"resting",
-		"restored",
-		"restoring",
-		"restrained",
-		"restrains",
-		"restraints",
-		"result",
-		"resume",
-		"resumes",
-		"resurrected",
-		"resurrection",
-		"retainer",
-		"retake",
-		"retaliate",
-		"retorts",
-		"retreat",
-		"retreated",
-		"retreating",
-		"retreats",
-		"retrieves",
-		"return",
-		"returned",
-		"returning",
-		"returns",
-		"reunited",
-		"reveal",
-		"revealed",
-		"revealing",
-		"reveals",
-		"revelation",
-		"revenge",
-		"revival",
-		"rewards",
-		"reyne",
-		"rhaegal",
-		"rhaegar",
-		"rhaenys",
-		"rhetorically",
-		"rich",
-		"rickard",
-		"rickon",
-		"ride",
-		"rider",
-		"riders",
-		"rides",
-		"riding",
-		"right",
-		"rightful",
-		"rights",
-		"ring",
-		"rings",
-		"riot",
-		"rise",
-		"rises",
-		"risk",
-		"risking",
-		"risky",
-		"ritualistic",
-		"rivalries",
-		"river",
-		"riverlands",
-		"riverrun",
-		"rivers",
-		"road",
-		"robb",
-		"robbed",
-		"robe",
-		"robert",
-		"robett",
-		"robin",
-		"rock",
-		"rocks",
-		"rode",
-		"rodrik",
-		"role",
-		"rolled",
-		"rolls",
-		"roof",
-		"rooftops",
-		"room",
-		"roose",
-		"root",
-		"rope",
-		"ros",
-		"rose",
-		"roslin",
-		"roughshod",
-		"route",
-		"row",
-		"royal",
-		"royce",
-		"rudely",
-		"rudimentary",
-		"ruin",
-		"ruined",
-		"ruler",
-		"rules",
-		"ruling",
-		"rum",
-		"rumours",
-		"run",
-		"running",
-		"runs",
-		"runt",
-		"ruse",
-		"rush",
-		"sack",
-		"sacked",
-		"sacking",
-		"sacrifice",
-		"sacrifices",
-		"sacrificing",
-		"sad",
-		"sadism",
-		"sadistically",
-		"sadness",
-		"safe",
-		"safer",
-		"safety",
-		"said",
-		"sail",
-		"sailing",
-		"sailors",
-		"sake",
-		"sally-port",
-		"salvages",
-		"sam",
-		"same",
-		"samwell",
-		"sand",
-		"sandor",
-		"sansa",
-		"sapphire",
-		"sapphires",
-		"sarcastically",
-		"sat",
-		"satisfied",
-		"sausage",
-		"save",
-		"saved",
-		"saving",
-		"savior",
-		"savo",
-		"saw",
-		"sawdust",
-		"say",
-		"saying",
-		"says",
-		"scald",
-		"scalpel",
-		"scans",
-		"scant",
-		"scarf",
-		"scars",
-		"scattered",
-		"scene",
-		"schemes",
-		"scoffs",
-		"scoops",
-		"scoring",
-		"scour",
-		"scout",
-		"scouting",
-		"scouts",
-		"scramble",
-		"scrape",
-		"scream",
-		"screaming",
-		"screams",
-		"sea",
-		"seal",
-		"seals",
-		"search",
-		"seas",
-		"seasick",
-		"seat",
-		"seated",
-		"seaworth",
-		"second",
-		"secret",
-		"secured",
-		"security",
-		"sedative",
-		"see",
-		"seed",
-		"seeing",
-		"seem",
-		"seeming",
-		"seemingly",
-		"seems",
-		"seen",
-		"sees",
-		"seething",
-		"seize",
-		"seizes",
-		"seizing",
-		"selection",
-		"self",
-		"sell",
-		"selling",
-		"sells",
-		"selmy",
-		"selyse",
-		"send",
-		"sends",
-		"sense",
-		"sent",
-		"sentence",
-		"sentenced",
-		"sentences",
-		"separate",
-		"separated",
-		"sept",
-		"septa",
-		"septon",
-		"ser",
-		"serious",
-		"seriously",
-		"servant",
-		"serve",
-		"served",
-		"serves",
-		"service",
-		"services",
-		"serving",
-		"set",
-		"sets",
-		"setting",
-		"settle",
-		"settles",
-		"seven",
-		"seven-pointed",
-		"seventeen",
-		"several",
-		"severe",
-		"severed",
-		"severely",
-		"severing",
-		"sewn",
-		"sex",
-		"sexual",
-		"sexually",
-		"shadow",
-		"shadowy",
-		"shae",
-		"shaft",
-		"shaggydog",
-		"shakes",
-		"shall",
-		"shamefully",
-		"shaped",
-		"share",
-		"shared",
-		"sharpened",
-		"shattered",
-		"shattering",
-		"shatters",
-		"sheep",
-		"sheep-shifting",
-		"shelter",
-		"sheltered",
-		"shield",
-		"shielded",
-		"shining",
-		"ship",
-		"shipped",
-		"ships",
-		"shireen",
-		"shit",
-		"shock",
-		"shocked",
-		"shocking",
-		"shockingly",
-		"shoos",
-		"shoot",
-		"shooting",
-		"shoots",
-		"shore",
-		"short",
-		"shortcomings",
-		"shortly",
-		"shot",
-		"should",
-		"shouting",
-		"shouts",
-		"shoveling",
-		"show",
-		"showed",
-		"showing",
-		"shown",
-		"shows",
-		"shriek",
-		"shrieking",
-		"shrieks",
-		"shut",
-		"siblings",
-		"side",
-		"sides",
-		"siding",
-		"siege",
-		"sigh",
-		"sight",
-		"sights",
-		"sigils",
-		"sign",
-		"signal",
-		"signalling",
-		"signals",
-		"signified",
-		"silence",
-		"silent",
-		"silently",
-		"silver",
-		"similar",
-		"simply",
-		"sin",
-		"since",
-		"singing",
-		"single",
-		"sinks",
-		"sinner",
-		"sins",
-		"sisters",
-		"sit",
-		"site",
-		"sits",
-		"sitting",
-		"situation",
-		"situations",
-		"six",
-		"sixth",
-		"size",
-		"skeptical",
-		"skids",
-		"skilled",
-		"skills",
-		"skin",
-		"skull",
-		"skull-crushed",
-		"skulls",
-		"slain",
-		"slaps",
-		"slash",
-		"slaughter",
-		"slaughtered",
-		"slaughtering",
-		"slave",
-		"slave-master",
-		"slave-masters",
-		"slaver",
-		"slavers",
-		"slaves",
-		"slays",
-		"sleep",
-		"slept",
-		"slinging",
-		"slip",
-		"slumps",
-		"slums",
-		"slynt",
-		"small",
-		"small-talk",
-		"smallfolk",
-		"smalljon",
-		"smashing",
-		"smell",
-		"smelling",
-		"smile",
-		"smiles",
-		"smirking",
-		"smiths",
-		"smoking",
-		"smuggle",
-		"smuggled",
-		"smuggles",
-		"snakes",
-		"snap",
-		"snarls",
-		"snatches",
-		"sneak",
-		"sneaks",
-		"sneering",
-		"sneers",
-		"sniffs",
-		"snigger",
-		"snout",
-		"snow",
-		"snowy",
-		"society",
-		"solar",
-		"sold",
-		"soldiers",
-		"sole",
-		"solely",
-		"solution",
-		"some",
-		"someday",
-		"someone",
-		"something",
-		"somewhat",
-		"son",
-		"song",
-		"songs",
-		"sons",
-		"soon",
-		"sorcerer",
-		"sorry",
-		"sort",
-		"sought",
-		"sound",
-		"sounded",
-		"sounds",
-		"source",
-		"south",
-		"southerners",
-		"sow",
-		"spare",
-		"spared",
-		"spares",
-		"sparing",
-		"sparring",
-		"sparrow",
-		"speak",
-		"speaking",
-		"speaks",
-		"spears",
-		"specifically",
-		"spectacle",
-		"speech",
-		"speed",
-		"spelling",
-		"spending",
-		"spent",
-		"spied",
-		"spies",
-		"spike",
-		"spin",
-		"spins",
-		"spite",
-		"spits",
-		"spokes",
-		"spot",
-		"spreading",
-		"spreads",
-		"spy",
-		"spying",
-		"square",
-		"squire",
-		"stabbed",
-		"stabbing",
-		"stabs",
-		"staff",
-		"stake",
-		"stand",
-		"standing",
-		"stands",
-		"stannis",
-		"stare",
-		"stares",
-		"stark",
-		"starks",
-		"start",
-		"started",
-		"startling",
-		"starts",
-		"starving",
-		"statement",
-		"states",
-		"stating",
-		"status",
-		"stay",
-		"stayed",
-		"stays",
-		"steal",
-		"stealing",
-		"steel",
-		"steers",
-		"stem",
-		"step",
-		"steps",
-		"sternly",
-		"still",
-		"stockpiles",
-		"stokeworth",
-		"stolen",
-		"stomach",
-		"stone",
-		"stoned",
-		"stones",
-		"stoop",
-		"stop",
-		"stopped",
-		"stopping",
-		"stops",
-		"storm",
-		"stormborn",
-		"stormlands",
-		"storms",
-		"stormy",
-		"story",
-		"strained",
-		"strange",
-		"stranger",
-		"strangle",
-		"strangled",
-		"strangles",
-		"street",
-		"streets",
-		"strength",
-		"stresses",
-		"stretch",
-		"stretches",
-		"stricken",
-		"strike",
-		"strikes",
-		"strips",
-		"strong",
-		"strongest",
-		"stronghold",
-		"struck",
-		"struggle",
-		"struggles",
-		"struggling",
-		"stubbornly",
-		"stuck",
-		"study",
-		"studying",
-		"stumbling",
-		"stunned",
-		"stuns",
-		"stupid",
-		"stupidity",
-		"stupor",
-		"style",
-		"styr",
-		"subjects",
-		"subsequent",
-		"subsequently",
-		"succeed",
-		"succeeds",
-		"successes",
-		"successful",
-		"successfully",
-		"succession",
-		"such",
-		"sudden",
-		"suddenly",
-		"suffer",
-		"suffered",
-		"suffering",
-		"sufficiently",
-		"suggested",
-		"suggesting",
-		"suggests",
-		"suicide",
-		"suit",
-		"summarily",
-		"summer",
-		"summoned",
-		"summons",
-		"sunset",
-		"sunspear",
-		"supervision",
-		"supper",
-		"supplies",
-		"supporter",
-		"supporters",
-		"supporting",
-		"supports",
-		"supposed",
-		"supremacy",
-		"sure",
-		"surprise",
-		"surprised",
-		"surprising",
-		"surrender",
-		"surrendering",
-		"surrenders",
-		"surreptitiously",
-		"surrounded",
-		"surrounding",
-		"surrounds",
-		"survival",
-		"survive",
-		"survived",
-		"survives",
-		"surviving",
-		"survivors",
-		"suspects",
-		"suspended",
-		"suspenseful",
-		"suspicious",
-		"swearing",
-		"swears",
-		"sweeps",
-		"sweetly",
-		"swing",
-		"switch",
-		"switching",
-		"sword",
-		"sword-hand",
-		"swords",
-		"swordsmanship",
-		"swore",
-		"sworn",
-		"sycophants",
-		"symbol",
-		"sympathy",
-		"table",
-		"taboos",
-		"tackles",
-		"take",
-		"takes",
-		"taking",
-		"tale",
-		"tales",
-		"talisa",
-		"talk",
-		"talking",
-		"talks",
-		"tall",
-		"tanner",
-		"tapping",
-		"targaryen",
-		"targaryens",
-		"target",
-		"tarly",
-		"tarlys",
-		"tarth",
-		"tastes",
-		"taught",
-		"taunts",
-		"teaches",
-		"teaching",
-		"team",
-		"tear-streaked",
-		"tears",
-		"teeth",
-		"tell",
-		"tells",
-		"temper",
-		"temple",
-		"tempting",
-		"ten",
-		"ten-year",
-		"tendency",
-		"tenses",
-		"tensions",
-		"tent",
-		"tents",
-		"tenuous",
-		"terms",
-		"terrible",
-		"terrified",
-		"terror",
-		"test",
-		"testes",
-		"testify",
-		"testifying",
-		"than",
-		"thanks",
-		"that",
-		"the",
-		"their",
-		"them",
-		"themselves",
-		"then",
-		"then-prince",
-		"thenn",
-		"thenns",
-		"there",
-		"thereafter",
-		"therefore",
-		"these",
-		"they",
-		"thick",
-		"thimble",
-		"thin",
-		"thing",
-		"things",
-		"think",
-		"thinking",
-		"thinks",
-		"thinly",
-		"third",
-		"thirst",
-		"this",
-		"thorne",
-		"thorns",
-		"thoros",
-		"those",
-		"though",
-		"thoughtful",
-		"thousand",
-		"thousands",
-		"thrashes",
-		"threat",
-		"threaten",
-		"threatened",
-		"threatening",
-		"threatens",
-		"threats",
-		"three",
-		"three-eyed",
-		"threesome",
-		"threw",
-		"throat",
-		"throats",
-		"throes",
-		"throne",
-		"through",
-		"throw",
-		"throwing",
-		"thrown",
-		"throws",
-		"thugs",
-		"thumbs",
-		"thunder",
-		"thunderstorm",
-		"tie",
-		"ties",
-		"tightly",
-		"time",
-		"times",
-		"tired",
-		"titan",
-		"toast",
-		"tobho",
-		"today",
-		"together",
-		"told",
-		"tolerates",
-		"tomb",
-		"tommen",
-		"too",
-		"took",
-		"top",
-		"topic",
-		"torch",
-		"torched",
-		"tormenting",
-		"tormund",
-		"torn",
-		"torso",
-		"torture",
-		"tortured",
-		"torturers",
-		"torturing",
-		"tossed",
-		"tossing",
-		"total",
-		"touched",
-		"touches",
-		"touching",
-		"tour",
-		"tournament",
-		"tourney",
-		"toward",
-		"towards",
-		"tower",
-		"towers",
-		"town",
-		"townhall",
-		"towns",
-		"toy",
-		"toying",
-		"toys",
-		"track",
-		"trade",
-		"traders",
-		"trades",
-		"traditional",
-		"trail",
-		"training",
-		"traitor",
-		"translator",
-		"trap",
-		"trapped",
-		"travel",
-		"traveling",
-		"travelled",
-		"travelling",
-		"travels",
-		"treachery",
-		"treated",
-		"treatment",
-		"tree",
-		"trial",
-		"tribes",
-		"tribunal",
-		"tricking",
-		"trident",
-		"tried",
-		"tries",
-		"trio",
-		"trip",
-		"triumphantly",
-		"troops",
-		"trouble",
-		"troupe",
-		"trousers",
-		"trudging",
-		"true",
-		"trueborn",
-		"truly",
-		"trust",
-		"trusted",
-		"trusts",
-		"truth",
-		"truthfully",
-		"try",
-		"trying",
-		"trystane",
-		"tully",
-		"turned",
-		"turning",
-		"turns",
-		"twenty",
-		"twice",
-		"twin",
-		"twins",
-		"twisting",
-		"two",
-		"tyene",
-		"tyrell",
-		"tyrells",
-		"tyrion",
-		"tywin",
-		"umber",
-		"umbers",
-		"unable",
-		"unaffected",
-		"unarmed",
-		"unaware",
-		"unborn",
-		"unburnt",
-		"uncanny",
-		"uncertain",
-		"uncle",
-		"uncomfortable",
-		"unconcerned",
-		"unconscious",
-		"unconvinced",
-		"undaunted",
-		"undead",
-		"undefended",
-		"under",
-		"underestimated",
-		"understand",
-		"understandably",
-		"understanding",
-		"undeterred",
-		"undisturbed",
-		"undress",
-		"undressing",
-		"undying",
-		"unease",
-		"unella",
-		"unfavorably",
-		"unfolds",
-		"unfortunately",
-		"unharmed",
-		"unhesitatingly",
-		"unidentified",
-		"unimpressed",
-		"unique",
-		"unite",
-		"united",
-		"unknown",
-		"unleash",
-		"unofficially",
-		"unpopular",
-		"unprepared",
-		"unprotected",
-		"unrepentant",
-		"unsafe",
-		"unscathed",
-		"unseemly",
-		"unsullied",
-		"unsurprised",
-		"until",
-		"unwilling",
-		"unwinds",
-		"up-coming",
-		"upcoming",
-		"upset",
-		"urge",
-		"urges",
-		"urinates",
-		"use",
-		"used",
-		"useful",
-		"uses",
-		"usher",
-		"ushered",
-		"using",
-		"usually",
-		"utterly",
-		"utters",
-		"vacant",
-		"vaes",
-		"vala",
-		"valar",
-		"vale",
-		"valley",
-		"valuable",
-		"valyrian",
-		"vance",
-		"vapid",
-		"various",
-		"vary",
-		"varys",
-		"vassals",
-		"vast",
-		"vehemently",
-		"veiled",
-		"venturing",
-		"verbally",
-		"verify",
-		"version",
-		"very",
-		"via",
-		"vial",
-		"vices",
-		"viciously",
-		"victory",
-		"viewing",
-		"village",
-		"villagers",
-		"vindication",
-		"violated",
-		"violence",
-		"virtually",
-		"viserion",
-		"viserys",
-		"visibly",
-		"vision",
-		"visions",
-		"visit",
-		"visited",
-		"visiting",
-		"visits",
-		"vivid",
-		"vocabulary",
-		"voice",
-		"voices",
-		"volunteer",
-		"volunteers",
-		"vomits",
-		"vote",
-		"votes",
-		"vouches",
-		"vow",
-		"vows",
-		"vulnerable",
-		"vunerable",
-		"waif",
-		"wait",
-		"waited",
-		"waiting",
-		"waits",
-		"wake",
-		"wakes",
-		"walder",
-		"walker",
-		"walkers",
-		"walking",
-		"walks",
-		"walls",
-		"wanders",
-		"want",
-		"wanted",
-		"wanting",
-		"wants",
-		"war",
-		"ward",
-		"warden",
-		"wardens",
-		"warg",
-		"warm",
-		"warmly",
-		"warmth",
-		"warn",
-		"warned",
-		"warning",
-		"warnings",
-		"warns",
-		"warrior",
-		"warrior-eunuchs",
-		"warrior-queen",
-		"warriors",
-		"wars",
-		"wary",
-		"was",
-		"waste",
-		"watched",
-		"watchers",
-		"watches",
-		"water",
-		"waves",
-		"waxes",
-		"way",
-		"waymar",
-		"ways",
-		"weak",
-		"weakly",
-		"weakness",
-		"weapons",
-		"wear",
-		"wearing",
-		"wears",
-		"wed",
-		"wedding",
-		"weddings",
-		"weeks",
-		"weight",
-		"welcome",
-		"welfare",
-		"well-fed",
-		"went",
-		"wept",
-		"were",
-		"west",
-		"western",
-		"westeros",
-		"westerosi",
-		"what",
-		"whatever",
-		"when",
-		"where",
-		"whereabouts",
-		"wherever",
-		"whichever",
-		"while",
-		"whip",
-		"whisperers",
-		"whispering",
-		"white",
-		"whose",
-		"widely",
-		"widow",
-		"wield",
-		"wielding",
-		"wields",
-		"wife",
-		"wights",
-		"wildling",
-		"wildlings",
-		"wildly",
-		"will",
-		"willas",
-		"willing",
-		"win",
-		"win-win",
-		"wind",
-		"window",
-		"windows",
-		"wine",
-		"wineskin",
-		"winter",
-		"wipe",
-		"wisdom",
-		"wishes",
-		"wistfully",
-		"with",
-		"withdraw",
-		"within",
-		"without",
-		"witness",
-		"witnesses",
-		"wives",
-		"wizard",
-		"woke",
-		"wolf",
-		"wolf-dreams",
-		"wolkan",
-		"wolves",
-		"woman",
-		"women",
-		"won",
-		"wondered",
-		"wondering",
-		"wonders",
-		"wood",
-		"wooden",
-		"woods",
-		"word",
-		"words",
-		"work",
-		"worked",
-		"working",
-		"worm",
-		"worried",
-		"worries",
-		"worry",
-		"worrying",
-		"worshipers",
-		"worst",
-		"worth",
-		"worthwhile",
-		"would",
-		"wound",
-		"wounds",
-		"wreck",
-		"writing",
-		"wrong",
-		"wrote",
-		"wry",
-		"wun",
-		"x-shaped",
-		"xhoan",
-		"yara",
-		"yarwyck",
-		"year",
-		"years",
-		"yelling",
-		"yells",
-		"yes",
-		"yet",
-		"yezzan",
-		"ygritte",
-		"you",
-		"young",
-		"younger",
-		"youngest",
-		"yunkai",
-	},
-	"potter": {
-		"abandon",
-		"abandoning",
-		"abbott",
-		"abilities",
-		"aboard",
-		"about",
-		"above",
-		"abruptly",
-		"absence",
-		"absent",
-		"absent-mindedly",
-		"academic",
-		"accept",
-		"accepted",
-		"accepts",
-		"accident",
-		"accidentally",
-		"accompanying",
-		"accomplice",
-		"accomplices",
-		"accomplish",
-		"according",
-		"accordingly",
-		"accusation",
-		"achievement",
-		"acknowledged",
-		"acknowledges",
-		"acquiesces",
-		"acquired",
-		"across",
-		"act",
-		"acted",
-		"acting",
-		"action",
-		"actions",
-		"activated",
-		"actually",
-		"additional",
-		"address",
-		"adds",
-		"admiration",
-		"admire",
-		"admits",
-		"admitted",
-		"admitting",
-		"adult",
-		"adults",
-		"advance",
-		"advanced",
-		"advancing",
-		"advertise",
-		"advice",
-		"advise",
-		"advised",
-		"advises",
-		"affairs",
-		"affect",
-		"affected",
-		"affecting",
-		"affiliation",
-		"afford",
-		"aflame",
-		"african",
-		"after",
-		"afternoon",
-		"afterwards",
-		"again",
-		"against",
-		"ageing",
-		"agent",
-		"ages",
-		"agree",
-		"agreed",
-		"agreement",
-		"agriculture",
-		"ahead",
-		"aid",
-		"aided",
-		"aiding",
-		"aims",
-		"air",
-		"ajar",
-		"alarm",
-		"alastor",
-		"albania",
-		"albeit",
-		"albert",
-		"albus",
-		"alchemist",
-		"alcohol",
-		"alecto",
-		"alerting",
-		"alerts",
-		"alibi",
-		"alice",
-		"alicia",
-		"alighted",
-		"all",
-		"allegiance",
-		"alley",
-		"allies",
-		"allowed",
-		"allows",
-		"alone",
-		"along",
-		"aloud",
-		"already",
-		"alright",
-		"alters",
-		"always",
-		"amazing",
-		"ambition",
-		"ambush",
-		"ambushed",
-		"amelia",
-		"amok",
-		"among",
-		"amongst",
-		"amos",
-		"amount",
-		"amusement",
-		"amusing",
-		"amycus",
-		"ancestral",
-		"anchorman",
-		"and",
-		"angelina",
-		"anger",
-		"angered",
-		"angering",
-		"angrily",
-		"angry",
-		"animagi",
-		"animagus",
-		"annihilate",
-		"announced",
-		"announcement",
-		"annoying",
-		"anonymous",
-		"another",
-		"answer",
-		"answers",
-		"anthony",
-		"anti-werewolf",
-		"any",
-		"anybody",
-		"anymore",
-		"anyone",
-		"anything",
-		"apart",
-		"apologies",
-		"apologise",
-		"apologises",
-		"apparate",
-		"apparates",
-		"apparating",
-		"apparation",
-		"apparent",
-		"apparition",
-		"appeal",
-		"appear",
-		"appearance",
-		"appeared",
-		"appears",
-		"applying",
-		"appointment",
-		"approaching",
-		"arabella",
-		"arachnophobic",
-		"aragog",
-		"arbitrarily",
-		"arch",
-		"archway",
-		"arcturus",
-		"are",
-		"area",
-		"argue",
-		"arguing",
-		"argument",
-		"argus",
-		"ariana",
-		"arm",
-		"armando",
-		"armour",
-		"arms",
-		"army",
-		"arnold",
-		"around",
-		"arousing",
-		"arranged",
-		"arrangements",
-		"arranges",
-		"arrested",
-		"arrive",
-		"arrived",
-		"arrives",
-		"arriving",
-		"artefacts",
-		"arthur",
-		"article",
-		"artificial",
-		"arts",
-		"ashes",
-		"aside",
-		"ask",
-		"asked",
-		"asking",
-		"asks",
-		"asleep",
-		"assassination",
-		"assault",
-		"assembled",
-		"asserting",
-		"assigned",
-		"assignment",
-		"assigns",
-		"associating",
-		"association",
-		"assume",
-		"assumed",
-		"assumes",
-		"assures",
-		"astonished",
-		"astonishment",
-		"attached",
-		"attacked",
-		"attacking",
-		"attacks",
-		"attempt",
-		"attempted",
-		"attempts",
-		"attention",
-		"attention-seeking",
-		"attitude",
-		"attract",
-		"attracting",
-		"attraction",
-		"audience",
-		"august",
-		"aunt",
-		"auror",
-		"aurors",
-		"author",
-		"authority",
-		"avada",
-		"avail",
-		"available",
-		"avenge",
-		"aversion",
-		"avoiding",
-		"avoids",
-		"awake",
-		"awaken",
-		"awakening",
-		"awakens",
-		"awakes",
-		"award",
-		"awards",
-		"aware",
-		"away",
-		"awoken",
-		"azkaban",
-		"back",
-		"backing",
-		"bacon",
-		"bad",
-		"badgering",
-		"badly",
-		"bag",
-		"bagman",
-		"bagshot",
-		"balcony",
-		"bandaged",
-		"bane",
-		"bank",
-		"banned",
-		"bans",
-		"barbs",
-		"bard",
-		"bare",
-		"barely",
-		"barges",
-		"barrels",
-		"barrier",
-		"bars",
-		"barty",
-		"base",
-		"basement",
-		"basically",
-		"basics",
-		"basin",
-		"bat",
-		"bath",
-		"bathilda",
-		"bathroom",
-		"battered",
-		"battle",
-		"beans",
-		"bearded",
-		"beards",
-		"bearer",
-		"bears",
-		"beater",
-		"beating",
-		"beautiful",
-		"beauxbatons",
-		"became",
-		"becoming",
-		"bedrooms",
-		"beedle",
-		"been",
-		"before",
-		"beforehand",
-		"befriends",
-		"befuddles",
-		"begging",
-		"begin",
-		"beginning",
-		"begins",
-		"begs",
-		"behalf",
-		"behave",
-		"behaving",
-		"beheaded",
-		"behind",
-		"being",
-		"belief",
-		"believe",
-		"believed",
-		"believing",
-		"belittle",
-		"bell",
-		"bellatrix",
-		"belong",
-		"belonging",
-		"belongs",
-		"bench",
-		"benefits",
-		"bereft",
-		"besides",
-		"best",
-		"betray",
-		"betrayal",
-		"betrayed",
-		"betraying",
-		"betrays",
-		"better",
-		"between",
-		"beware",
-		"bewitched",
-		"bewitches",
-		"bewitching",
-		"beyond",
-		"bezoar",
-		"biassed",
-		"bid",
-		"bids",
-		"big",
-		"bigger",
-		"bike",
-		"bill",
-		"binds",
-		"binns",
-		"biography",
-		"birds",
-		"birth",
-		"birthday",
-		"bit",
-		"bitten",
-		"bizarre",
-		"black",
-		"blackmailed",
-		"blackmailing",
-		"blackmails",
-		"blacks",
-		"blaise",
-		"blame",
-		"blamed",
-		"blames",
-		"blank",
-		"blankets",
-		"blasted",
-		"blasts",
-		"bleeding",
-		"blinded",
-		"blinding",
-		"block",
-		"blood-replenishing",
-		"blown",
-		"bludger",
-		"bludgers",
-		"blue",
-		"boa",
-		"boarding",
-		"boards",
-		"boat",
-		"boats",
-		"bob",
-		"boils",
-		"bolster",
-		"bolt",
-		"bombarded",
-		"bone",
-		"booming",
-		"boomslang",
-		"boost",
-		"boosted",
-		"booster",
-		"boot",
-		"bored",
-		"borgin",
-		"born",
-		"borrow",
-		"borrowed",
-		"borrowing",
-		"borrows",
-		"botched",
-		"both",
-		"bothering",
-		"bott",
-		"bottle",
-		"bottles",
-		"bottom",
-		"bouncing",
-		"bound",
-		"boundaries",
-		"bounds",
-		"bow",
-		"box",
-		"boy",
-		"boys",
-		"branded",
-		"bravery",
-		"bravest",
-		"breadboard",
-		"break",
-		"break-in",
-		"breakfast",
-		"breaking",
-		"breaks",
-		"breathe",
-		"breeds",
-		"brewing",
-		"brick",
-		"bricks",
-		"bridge",
-		"brief",
-		"briefly",
-		"bright",
-		"brilliant",
-		"bringing",
-		"brings",
-		"brink",
-		"british",
-		"broadcast",
-		"brockdale",
-		"broke",
-		"broken",
-		"bronze",
-		"broomsticks",
-		"brotherly",
-		"brothers",
-		"brought",
-		"brown",
-		"bruising",
-		"brushes",
-		"brutally",
-		"buddies",
-		"bug",
-		"build",
-		"built",
-		"bulgaria",
-		"bulgarian",
-		"bullied",
-		"bullies",
-		"bump",
-		"bumps",
-		"burial",
-		"buried",
-		"burke",
-		"burkes",
-		"burned",
-		"burning",
-		"burns",
-		"burnt",
-		"burrow",
-		"burst",
-		"bursting",
-		"bursts",
-		"bury",
-		"bush",
-		"business",
-		"busy",
-		"butterbeer",
-		"buys",
-		"bypass",
-		"cabinets",
-		"cadogan",
-		"cafru",
-		"cakes",
-		"call",
-		"called",
-		"calls",
-		"calm",
-		"calmly",
-		"came",
-		"camp",
-		"campsite",
-		"can",
-		"cancelled",
-		"cannon",
-		"cannons",
-		"cannot",
-		"capable",
-		"captain",
-		"captive",
-		"captured",
-		"capturing",
-		"caractacus",
-		"card",
-		"cards",
-		"care",
-		"careers",
-		"careful",
-		"cares",
-		"caretaker",
-		"caring",
-		"carriages",
-		"carried",
-		"carrow",
-		"carry",
-		"cart",
-		"case",
-		"cast",
-		"casting",
-		"castle",
-		"casts",
-		"casualties",
-		"cat",
-		"cat-loving",
-		"catch",
-		"catcher",
-		"catches",
-		"catching",
-		"catchpole",
-		"caterwauling",
-		"cattermole",
-		"caught",
-		"cauldron",
-		"cauldrons",
-		"cause",
-		"caused",
-		"causes",
-		"causing",
-		"cave",
-		"cease",
-		"ceased",
-		"ceases",
-		"cedric",
-		"ceiling",
-		"celebrate",
-		"celebrates",
-		"celebrating",
-		"celebration",
-		"celebrity",
-		"cemetery",
-		"centaurs",
-		"centre",
-		"centres",
-		"century",
-		"ceremony",
-		"certain",
-		"certainly",
-		"chair",
-		"challenges",
-		"chamber",
-		"chambers",
-		"champion",
-		"champions",
-		"championship",
-		"chang",
-		"change",
-		"changed",
-		"changes",
-		"changing",
-		"channel",
-		"chaos",
-		"chapter",
-		"characters",
-		"charges",
-		"charity",
-		"charlie",
-		"charm",
-		"charms",
-		"chase",
-		"chaser",
-		"chasers",
-		"chastened",
-		"cheat",
-		"cheated",
-		"cheating",
-		"check",
-		"cheer",
-		"chess",
-		"chessboard",
-		"chest",
-		"child",
-		"childbirth",
-		"childhood",
-		"children",
-		"chill",
-		"chimney",
-		"china",
-		"cho",
-		"chocolate",
-		"choice",
-		"choke",
-		"chooses",
-		"chores",
-		"chorley",
-		"chose",
-		"christmas",
-		"chunk",
-		"circumstances",
-		"circumstantial",
-		"circumvented",
-		"city",
-		"claims",
-		"clandestine",
-		"classes",
-		"classmate",
-		"classroom",
-		"classrooms",
-		"claw",
-		"cleaning",
-		"clear",
-		"cleared",
-		"clears",
-		"clearwater",
-		"cloak",
-		"closest",
-		"clothes",
-		"club",
-		"clubs",
-		"clues",
-		"clutched",
-		"clutching",
-		"co-operation",
-		"co-worker",
-		"coaxes",
-		"codes",
-		"coffee",
-		"coin",
-		"coincidences",
-		"coincides",
-		"coins",
-		"cold",
-		"coldly",
-		"colin",
-		"collapse",
-		"collapsed",
-		"collect",
-		"collected",
-		"collectively",
-		"colony",
-		"combat",
-		"come",
-		"comes",
-		"comforting",
-		"coming",
-		"commands",
-		"commentating",
-		"comments",
-		"commission",
-		"committed",
-		"committee",
-		"committing",
-		"common",
-		"commotion",
-		"communicate",
-		"communicating",
-		"company",
-		"compared",
-		"comparing",
-		"compartment",
-		"competition",
-		"complaining",
-		"complains",
-		"complete",
-		"completely",
-		"completing",
-		"complex",
-		"compliments",
-		"composed",
-		"compulsion",
-		"conceal",
-		"concedes",
-		"conceived",
-		"concept",
-		"concern",
-		"concerning",
-		"concerns",
-		"conclusion",
-		"condition",
-		"conducting",
-		"conductor",
-		"confident",
-		"confined",
-		"confirm",
-		"confirmed",
-		"confirming",
-		"confirms",
-		"confiscated",
-		"confiscates",
-		"confrontation",
-		"confronts",
-		"confused",
-		"confusion",
-		"congratulates",
-		"conjure",
-		"conjured",
-		"conjures",
-		"conjuring",
-		"connection",
-		"connections",
-		"consequently",
-		"consider",
-		"considered",
-		"considers",
-		"consisting",
-		"console",
-		"conspiracy",
-		"constant",
-		"constrictor",
-		"consumed",
-		"consuming",
-		"contact",
-		"contacts",
-		"contain",
-		"contained",
-		"containing",
-		"contains",
-		"contents",
-		"contest",
-		"continue",
-		"continuing",
-		"continuously",
-		"contraband",
-		"contradicts",
-		"controlled",
-		"convalescing",
-		"convenient",
-		"converses",
-		"convict",
-		"convinced",
-		"convinces",
-		"convincing",
-		"cook",
-		"cooks",
-		"cool",
-		"copy",
-		"corban",
-		"cords",
-		"cores",
-		"cormac",
-		"corner",
-		"cornered",
-		"corporeal",
-		"corpse",
-		"correct",
-		"corrects",
-		"corridor",
-		"corridors",
-		"corroborate",
-		"cost",
-		"costing",
-		"costs",
-		"cottage",
-		"cottages",
-		"couch",
-		"counters",
-		"counting",
-		"country",
-		"countryside",
-		"counts",
-		"county",
-		"coupled",
-		"courage",
-		"court",
-		"courtroom",
-		"cousin",
-		"covering",
-		"coward",
-		"cowering",
-		"cracks",
-		"cramped",
-		"craning",
-		"crashed",
-		"crashes",
-		"crazy-looking",
-		"create",
-		"created",
-		"creates",
-		"creating",
-		"creator",
-		"creature",
-		"creatures",
-		"credentials",
-		"credibility",
-		"creeps",
-		"creevey",
-		"crescent",
-		"crestfallen",
-		"cries",
-		"crimes",
-		"critical",
-		"criticised",
-		"criticises",
-		"criticising",
-		"criticism",
-		"cronies",
-		"crookshanks",
-		"crouch",
-		"crowd",
-		"crowded",
-		"cruciatus",
-		"crumbles",
-		"crush",
-		"cry",
-		"crying",
-		"cryptic",
-		"crystal",
-		"culprit",
-		"culprits",
-		"cup",
-		"cupid-dwarf",
-		"cupids",
-		"curiosity",
-		"curious",
-		"curled",
-		"curly",
-		"current",
-		"curse",
-		"curses",
-		"cursing",
-		"curt",
-		"curtains",
-		"cut",
-		"daily",
-		"damage",
-		"damaged",
-		"dancing",
-		"danger",
-		"dangerously",
-		"dares",
-		"dark",
-		"dark-haired",
-		"darkness",
-		"date",
-		"dates",
-		"dating",
-		"daughter",
-		"dawlish",
-		"days",
-		"dead",
-		"deadly",
-		"deal",
-		"dealing",
-		"dean",
-		"death",
-		"deathly",
-		"debates",
-		"debating",
-		"debt",
-		"decades",
-		"decapitation",
-		"december",
-		"decide",
-		"decided",
-		"decides",
-		"deciding",
-		"decision",
-		"declares",
-		"decline",
-		"declined",
-		"decoys",
-		"decree",
-		"decrees",
-		"deems",
-		"deep",
-		"deepens",
-		"deeper",
-		"deepest",
-		"deeply",
-		"defeated",
-		"defeating",
-		"defeats",
-		"defence",
-		"defenceless",
-		"defences",
-		"defend",
-		"defenders",
-		"defends",
-		"defensive",
-		"deformed",
-		"delays",
-		"delighted",
-		"delirious",
-		"delivered",
-		"demanding",
-		"demands",
-		"demented",
-		"dementor",
-		"dementor-induced",
-		"dementors",
-		"denial",
-		"denied",
-		"denies",
-		"dennis",
-		"denominated",
-		"depend",
-		"deplores",
-		"deployable",
-		"depressed",
-		"depths",
-		"derision",
-		"descend",
-		"descended",
-		"deserted",
-		"deserved",
-		"deserves",
-		"desire",
-		"desperately",
-		"desperation",
-		"despite",
-		"destined",
-		"destroy",
-		"destroyed",
-		"detection",
-		"detention",
-		"detentions",
-		"determine",
-		"determined",
-		"determining",
-		"developed",
-		"devil",
-		"diadem",
-		"diary",
-		"did",
-		"die",
-		"died",
-		"dies",
-		"diet",
-		"difference",
-		"different",
-		"differently",
-		"difficulty",
-		"diggle",
-		"diggory",
-		"digs",
-		"dilapidated",
-		"dimly",
-		"dining",
-		"dinner",
-		"dippet",
-		"direct",
-		"directed",
-		"direction",
-		"directions",
-		"directly",
-		"director",
-		"directs",
-		"dirk",
-		"disable",
-		"disables",
-		"disagreeing",
-		"disagrees",
-		"disallowing",
-		"disapparate",
-		"disapparated",
-		"disapparates",
-		"disappearance",
-		"disappearances",
-		"disappeared",
-		"disappearing",
-		"disappears",
-		"disappointed",
-		"disarmed",
-		"disaster",
-		"disbanded",
-		"disbands",
-		"disciplinary",
-		"disclosed",
-		"disconcerted",
-		"discover",
-		"discovered",
-		"discovering",
-		"discovers",
-		"discovery",
-		"discredit",
-		"discuss",
-		"discussed",
-		"discussing",
-		"discussion",
-		"disdain",
-		"disembodied",
-		"disgrace",
-		"disguise",
-		"disgust",
-		"disgusting",
-		"disillusionment",
-		"disliking",
-		"dismay",
-		"dismayed",
-		"dismissed",
-		"dismisses",
-		"dismounted",
-		"dispatches",
-		"displays",
-		"displeasure",
-		"disrupted",
-		"distances",
-		"distracting",
-		"distraction",
-		"disturb",
-		"disturbing",
-		"dives",
-		"divination",
-		"dobby",
-		"dog",
-		"doge",
-		"domestic-elf",
-		"done",
-		"doom",
-		"door",
-		"doorbell",
-		"doors",
-		"doorstep",
-		"doris",
-		"dorm",
-		"dormitories",
-		"dormitory",
-		"dorms",
-		"dosage",
-		"double",
-		"doubling",
-		"doubt",
-		"doubted",
-		"doubts",
-		"down",
-		"downfall",
-		"dozens",
-		"dragged",
-		"dragon",
-		"dragons",
-		"drags",
-		"drain",
-		"drawn",
-		"dream",
-		"dress",
-		"drinks",
-		"drive",
-		"driven",
-		"driver",
-		"drives",
-		"driving",
-		"drop",
-		"dropped",
-		"drought",
-		"drown",
-		"drunk",
-		"dubious",
-		"dudley",
-		"due",
-		"duel",
-		"dulls",
-		"dumbfounded",
-		"dumbledores",
-		"dungeons",
-		"duo",
-		"during",
-		"durmstrang",
-		"dursleys",
-		"dust",
-		"duties",
-		"duty",
-		"dwarves",
-		"dying",
-		"each",
-		"eagle",
-		"ear",
-		"earlier",
-		"early",
-		"earn",
-		"ears",
-		"ease",
-		"easier",
-		"easily",
-		"easter",
-		"easy",
-		"eaten",
-		"eater",
-		"eaters",
-		"eating",
-		"eavesdropped",
-		"eavesdrops",
-		"echoes",
-		"edge",
-		"editor",
-		"education",
-		"effective",
-		"effects",
-		"efforts",
-		"egg",
-		"egging",
-		"eggs",
-		"ego",
-		"ehru",
-		"either",
-		"ejected",
-		"elder",
-		"elderly",
-		"eldest",
-		"electric",
-		"elf",
-		"eligible",
-		"eliminating",
-		"elite",
-		"elixir",
-		"elphias",
-		"else",
-		"elsewhere",
-		"elves",
-		"embarrassing",
-		"embarrassment",
-		"embedded",
-		"emerges",
-		"emotion",
-		"emotional",
-		"emotions",
-		"employees",
-		"emptied",
-		"empty",
-		"enabled",
-		"enables",
-		"enamoured",
-		"enchanted",
-		"enchanting",
-		"enchantments",
-		"encounter",
-		"encountered",
-		"encounters",
-		"encourage",
-		"encouraging",
-		"end",
-		"end-of-term",
-		"endangering",
-		"ended",
-		"ending",
-		"enemies",
-		"enemy",
-		"engaged",
-		"england",
-		"english",
-		"engraved",
-		"enjoy",
-		"enjoying",
-		"enjoys",
-		"enormous",
-		"enough",
-		"enraged",
-		"enrolling",
-		"ensues",
-		"ensure",
-		"ensured",
-		"enter",
-		"entered",
-		"entering",
-		"enters",
-		"entertaining",
-		"entirely",
-		"entitled",
-		"entrance",
-		"entrants",
-		"entrusted",
-		"entry",
-		"envelope",
-		"envoy",
-		"epilogue",
-		"equal",
-		"equals",
-		"equipment",
-		"equipped",
-		"erase",
-		"erised",
-		"ernie",
-		"escape",
-		"escaping",
-		"escort",
-		"escorting",
-		"escorts",
-		"especially",
-		"essentially",
-		"etc",
-		"eternity",
-		"europe",
-		"evades",
-		"evaluate",
-		"even",
-		"evening",
-		"evenings",
-		"event",
-		"events",
-		"eventually",
-		"ever",
-		"every",
-		"everybody",
-		"everyone",
-		"everything",
-		"everywhere",
-		"evict",
-		"evidence",
-		"evil",
-		"ex-auror",
-		"exactly",
-		"exaggerating",
-		"examinations",
-		"examine",
-		"example",
-		"exams",
-		"except",
-		"exception",
-		"excessive",
-		"exchange",
-		"excited",
-		"excitedly",
-		"excitement",
-		"exciting",
-		"excluding",
-		"excuse",
-		"excuses",
-		"executed",
-		"executioner",
-		"exhausting",
-		"exist",
-		"existence",
-		"exit",
-		"exits",
-		"expect",
-		"expected",
-		"expecting",
-		"expects",
-		"expelled",
-		"expensive",
-		"experience",
-		"explain",
-		"explained",
-		"explains",
-		"explodes",
-		"explosion",
-		"expose",
-		"exposing",
-		"express",
-		"expression",
-		"extendable",
-		"extensive",
-		"extra",
-		"extract",
-		"extracted",
-		"extracurricular",
-		"extraordinary",
-		"extremely",
-		"eye",
-		"eyes",
-		"faced",
-		"fail",
-		"failed",
-		"failing",
-		"fails",
-		"failure",
-		"faint",
-		"fainting",
-		"faints",
-		"fair",
-		"fairy",
-		"faith",
-		"faithful",
-		"fake",
-		"faked",
-		"fall",
-		"fallen",
-		"falling",
-		"falls",
-		"falsely",
-		"fame",
-		"familiar",
-		"family",
-		"famous",
-		"fan",
-		"fang",
-		"farewell",
-		"fat",
-		"fatal",
-		"fates",
-		"father",
-		"fault",
-		"faux-horcrux",
-		"favour",
-		"favourable",
-		"fawn",
-		"feared",
-		"fearing",
-		"feathers",
-		"february",
-		"fed",
-		"feeble",
-		"feebly",
-		"feel",
-		"feeling",
-		"feelings",
-		"feels",
-		"feet",
-		"feigns",
-		"felicis",
-		"fell",
-		"felt",
-		"fence",
-		"fenrir",
-		"few",
-		"fibre",
-		"fictional",
-		"fiddling",
-		"fidelius",
-		"field",
-		"fierce-looking",
-		"fifth",
-		"fifty",
-		"figg",
-		"fight",
-		"fighters",
-		"fights",
-		"figurines",
-		"figuring",
-		"filibuster",
-		"filing",
-		"filius",
-		"fill",
-		"filled",
-		"filling",
-		"film",
-		"finally",
-		"finch-fletchley",
-		"finding",
-		"fine",
-		"fingers",
-		"finish",
-		"finishing",
-		"finnigan",
-		"fire",
-		"firebolt",
-		"fired",
-		"firenze",
-		"fireplace",
-		"fireworks",
-		"firing",
-		"first",
-		"first-year",
-		"first-years",
-		"fish-like",
-		"fishy",
-		"fit",
-		"fix",
-		"flagon",
-		"flame",
-		"flamel",
-		"flames",
-		"flash",
-		"flatly",
-		"flavour",
-		"flayed",
-		"fled",
-		"fleeing",
-		"flees",
-		"fletcher",
-		"fleur",
-		"fliers",
-		"flies",
-		"flight",
-		"flitwick",
-		"flock",
-		"flooded",
-		"floor",
-		"flops",
-		"flown",
-		"fluffy",
-		"fluke",
-		"flushed",
-		"flushing",
-		"flute",
-		"flutter",
-		"fly",
-		"flying",
-		"focus",
-		"fog",
-		"follow",
-		"followed",
-		"follower",
-		"followers",
-		"following",
-		"follows",
-		"fondness",
-		"food",
-		"fool",
-		"foolish",
-		"foot",
-		"footsteps",
-		"for",
-		"forbidden",
-		"forbidding",
-		"forbids",
-		"force",
-		"forced",
-		"forces",
-		"ford",
-		"forearm",
-		"forehead",
-		"foreign",
-		"forerunners",
-		"forever",
-		"forget",
-		"forgetting",
-		"forgot",
-		"forgotten",
-		"form",
-		"former",
-		"forth",
-		"forthcoming",
-		"forty",
-		"forty-two",
-		"forward",
-		"fought",
-		"found",
-		"founder",
-		"founders",
-		"founding",
-		"four",
-		"four-point",
-		"fourth",
-		"fourth-years",
-		"framed",
-		"framing",
-		"france",
-		"frantically",
-		"fraud",
-		"fred",
-		"free",
-		"frequent",
-		"friday",
-		"friend",
-		"friendship",
-		"frogs",
-		"frozen",
-		"fudge",
-		"full",
-		"full-fledged",
-		"fun",
-		"funeral",
-		"funny",
-		"furniture",
-		"further",
-		"furthermore",
-		"fury",
-		"future",
-		"gadgets",
-		"galleons",
-		"gambling",
-		"game",
-		"gamekeeper",
-		"games",
-		"garrick",
-		"gatecrashing",
-		"gathered",
-		"gathering",
-		"gathers",
-		"gaunt",
-		"gave",
-		"gaze",
-		"gellert",
-		"general",
-		"gentleman",
-		"genuine",
-		"george",
-		"get",
-		"ghostly",
-		"ghosts",
-		"giant",
-		"giants",
-		"gift",
-		"gigantic",
-		"gilderoy",
-		"gills",
-		"girlfriend",
-		"girls",
-		"give",
-		"given",
-		"gives",
-		"glad",
-		"glances",
-		"glass",
-		"gleefully",
-		"glide",
-		"glimpse",
-		"gloomy",
-		"gloves",
-		"glowing",
-		"goads",
-		"goblet",
-		"goblins",
-		"godfather",
-		"goes",
-		"going",
-		"going-away",
-		"gold",
-		"golden",
-		"goldstein",
-		"gone",
-		"gonna",
-		"good",
-		"good-byes",
-		"goodbye",
-		"goodbyes",
-		"gornuk",
-		"gossiping",
-		"got",
-		"gotten",
-		"governor",
-		"governors",
-		"grabbed",
-		"grabbing",
-		"grabs",
-		"gradually",
-		"graduated",
-		"graduates",
-		"graduation",
-		"grand",
-		"grandfather",
-		"granger",
-		"grasp",
-		"grass",
-		"grateful",
-		"grave",
-		"graveyard",
-		"grawp",
-		"great-nephew",
-		"greater",
-		"greatest",
-		"green",
-		"greengrass",
-		"greet",
-		"greeted",
-		"greeting",
-		"greets",
-		"gregorovitch",
-		"gregory",
-		"grew",
-		"grey",
-		"grief",
-		"grief-stricken",
-		"grim",
-		"grimmauld",
-		"grindelwald",
-		"grindylows",
-		"gringotts",
-		"griphook",
-		"ground",
-		"groundskeeper",
-		"group",
-		"groups",
-		"grow",
-		"growing",
-		"grown",
-		"grown-up",
-		"grows",
-		"grubbly-plank",
-		"grubby",
-		"grunnings",
-		"guarded",
-		"guardian",
-		"guarding",
-		"guards",
-		"guesses",
-		"guest",
-		"guide",
-		"guides",
-		"guilty",
-		"gun",
-		"habits",
-		"had",
-		"haircut",
-		"hairs",
-		"half",
-		"half-blind",
-		"half-blood",
-		"half-breed",
-		"half-breeds",
-		"half-brother",
-		"half-giant",
-		"half-hour",
-		"halfway",
-		"hall",
-		"hallows",
-		"halls",
-		"hallway",
-		"halts",
-		"hammers",
-		"hand-knit",
-		"hand-me-down",
-		"handed",
-		"handing",
-		"handled",
-		"handling",
-		"handy",
-		"hanging",
-		"hannah",
-		"happen",
-		"happened",
-		"happening",
-		"happily",
-		"happy",
-		"harasses",
-		"harbouring",
-		"harbours",
-		"hard",
-		"hardly",
-		"harm",
-		"harmful",
-		"harp",
-		"harry",
-		"hastiness",
-		"hat",
-		"hatched",
-		"hate",
-		"hated",
-		"hates",
-		"hating",
-		"hatred",
-		"hats",
-		"hauled",
-		"hauling",
-		"haunted",
-		"head",
-		"heading",
-		"headless",
-		"headmaster",
-		"headmasters",
-		"headquarters",
-		"heads",
-		"headwear",
-		"healed",
-		"heals",
-		"health",
-		"hear",
-		"heard",
-		"hearing",
-		"hears",
-		"heartened",
-		"heat",
-		"heated",
-		"heavier",
-		"heavily",
-		"heavy",
-		"hedge",
-		"height",
-		"held",
-		"helga",
-		"help",
-		"helpful",
-		"helplessly",
-		"hepzibah",
-		"her",
-		"herbert",
-		"herbology",
-		"here",
-		"heritage",
-		"hermione",
-		"hero",
-		"heroically",
-		"hers",
-		"hestia",
-		"hexes",
-		"hid",
-		"hidden",
-		"hide",
-		"hideous",
-		"hides",
-		"hill",
-		"hills",
-		"hilt",
-		"him",
-		"himself",
-		"hinges",
-		"hinted",
-		"hippogriff",
-		"hippogriffs",
-		"his",
-		"historian",
-		"history",
-		"hoax",
-		"hog",
-		"hogsmeade",
-		"hold",
-		"holding",
-		"hole",
-		"holidays",
-		"hollow",
-		"homework",
-		"honeydukes",
-		"honour",
-		"honoured",
-		"hooch",
-		"hood",
-		"hooded",
-		"hook",
-		"hopeless",
-		"hopes",
-		"hoping",
-		"hopkirk",
-		"horcrux",
-		"horcruxes",
-		"horizon",
-		"horntail",
-		"horrified",
-		"horrifying",
-		"horror",
-		"horse",
-		"horses",
-		"hospital",
-		"hostages",
-		"hotel",
-		"hour",
-		"hour-long",
-		"hours",
-		"house",
-		"house-elf",
-		"house-elves",
-		"household",
-		"houses",
-		"housework",
-		"hover",
-		"how",
-		"however",
-		"howler",
-		"hufflepuff",
-		"hufflepuffs",
-		"hug",
-		"huge",
-		"hugo",
-		"hugs",
-		"human",
-		"humiliation",
-		"humour",
-		"hump",
-		"hundred",
-		"hung",
-		"hungarian",
-		"hunger",
-		"hurry",
-		"hurt",
-		"hurting",
-		"hurts",
-		"husband",
-		"hushed",
-		"hut",
-		"hybrid",
-		"ice",
-		"icy",
-		"idea",
-		"ideal",
-		"ideals",
-		"identify",
-		"identifying",
-		"ignore",
-		"ignores",
-		"ignoring",
-		"igor",
-		"ill",
-		"ill-reputed",
-		"illness",
-		"image",
-		"imbued",
-		"impact",
-		"impediment",
-		"impending",
-		"imperius",
-		"imperiused",
-		"impersonate",
-		"impersonating",
-		"implanted",
-		"implements",
-		"implications",
-		"implied",
-		"implying",
-		"important",
-		"imposing",
-		"impossible",
-		"imposter",
-		"impostor",
-		"impressed",
-		"imprisoned",
-		"imprisoning",
-		"imprisonment",
-		"improvement",
-		"inadvertently",
-		"incantatem",
-		"incapacitated",
-		"incidents",
-		"included",
-		"includes",
-		"indeed",
-		"indicates",
-		"inevitable",
-		"inexpensive",
-		"infant",
-		"infatuated",
-		"inferi",
-		"inferno",
-		"infiltrate",
-		"inflated",
-		"inflates",
-		"inflicting",
-		"information",
-		"informed",
-		"informing",
-		"informs",
-		"infuriates",
-		"ingenious",
-		"initially",
-		"injuries",
-		"injuring",
-		"injury",
-		"innocent",
-		"insane",
-		"inscription",
-		"insensitive",
-		"inside",
-		"insisted",
-		"insistence",
-		"instinctively",
-		"instruct",
-		"instruction",
-		"instructions",
-		"instructs",
-		"insult",
-		"intended",
-		"intense",
-		"interact",
-		"intercepted",
-		"interchange",
-		"interesting",
-		"interfere",
-		"interference",
-		"interjects",
-		"interlock",
-		"international",
-		"interrogate",
-		"interrogates",
-		"intervals",
-		"intervene",
-		"intervenes",
-		"interviews",
-		"into",
-		"intoxicated",
-		"introduce",
-		"introduction",
-		"intruders",
-		"intrusion",
-		"inventing",
-		"inventory",
-		"investigated",
-		"investigating",
-		"investigation",
-		"invitation",
-		"invite",
-		"invited",
-		"invokes",
-		"involuntarily",
-		"involvement",
-		"involving",
-		"ire",
-		"ireland",
-		"iron",
-		"irreparably",
-		"irresponsibly",
-		"irritated",
-		"island",
-		"isle",
-		"issue",
-		"item",
-		"items",
-		"its",
-		"itself",
-		"jack",
-		"jacket",
-		"jackets",
-		"james",
-		"january",
-		"japanese",
-		"jealous",
-		"jealousy",
-		"jerking",
-		"jet-black",
-		"jobs",
-		"joined",
-		"joining",
-		"joking",
-		"jones",
-		"jordan",
-		"journey",
-		"judge",
-		"judged",
-		"judgement",
-		"judges",
-		"jumped",
-		"jumper",
-		"jumps",
-		"just",
-		"justin",
-		"katie",
-		"kedavra",
-		"keeper",
-		"keepers",
-		"keeping",
-		"kent",
-		"kept",
-		"kettleburn",
-		"keyhole",
-		"keys",
-		"kidnap",
-		"kidnapping",
-		"kids",
-		"kill",
-		"killers",
-		"killing",
-		"kills",
-		"kind",
-		"king",
-		"kings",
-		"kirke",
-		"kissed",
-		"kissing",
-		"kitchen",
-		"kitchens",
-		"knees",
-		"knew",
-		"knife",
-		"knight",
-		"knitted",
-		"knock",
-		"knocked",
-		"knocking",
-		"knocks",
-		"knockturn",
-		"knot",
-		"knowing",
-		"known",
-		"knows",
-		"knuts",
-		"kreacher",
-		"krum",
-		"labels",
-		"lack",
-		"lady",
-		"lair",
-		"laison",
-		"lamps",
-		"land",
-		"lands",
-		"language",
-		"lap",
-		"large",
-		"largest",
-		"last",
-		"last-minute",
-		"lastly",
-		"lasts",
-		"late",
-		"later",
-		"latter",
-		"laugh",
-		"laughing",
-		"laughs",
-		"laughter",
-		"launched",
-		"lavender",
-		"law",
-		"lawn",
-		"lay",
-		"layers",
-		"laying",
-		"lazy",
-		"leading",
-		"leads",
-		"leaky",
-		"leaps",
-		"learn",
-		"learned",
-		"learning",
-		"learns",
-		"least",
-		"leave",
-		"leaves",
-		"lee",
-		"left",
-		"leg-locker",
-		"legend",
-		"legs",
-		"leprechaun",
-		"lesson",
-		"lessons",
-		"lestrange",
-		"lets",
-		"letter",
-		"letting",
-		"level",
-		"levicorpus",
-		"leviosa",
-		"liar",
-		"librarian",
-		"library",
-		"lie",
-		"lied",
-		"lies",
-		"life",
-		"life-threatening",
-		"lift",
-		"lightning-shaped",
-		"lights",
-		"likely",
-		"limbo",
-		"limping",
-		"line",
-		"lingers",
-		"lion",
-		"lips",
-		"list",
-		"listening",
-		"listless",
-		"lit",
-		"little",
-		"live",
-		"lived",
-		"lives",
-		"load",
-		"loads",
-		"location",
-		"locations",
-		"locked",
-		"locket",
-		"lockhart",
-		"logic",
-		"london",
-		"lonely",
-		"long",
-		"longer",
-		"longest",
-		"look",
-		"looked",
-		"looking",
-		"loose",
-		"loosen",
-		"lord",
-		"lose",
-		"losing",
-		"lost",
-		"lot",
-		"lots",
-		"loud",
-		"love",
-		"lovegood",
-		"lovely",
-		"low",
-		"loyal",
-		"luck",
-		"luckily",
-		"ludo",
-		"luggage",
-		"lunatic",
-		"lunges",
-		"lure",
-		"luring",
-		"lurking",
-		"luscious",
-		"lying",
-		"macgonagall",
-		"macmillan",
-		"macnair",
-		"mad",
-		"mad-eye",
-		"mafalda",
-		"magazine",
-		"magical",
-		"magically",
-		"magnolia",
-		"maiden",
-		"mail",
-		"main",
-		"maintain",
-		"major",
-		"majority",
-		"make",
-		"making",
-		"maladies",
-		"male",
-		"malfoys",
-		"malkin",
-		"man",
-		"managed",
-		"manages",
-		"managing",
-		"mandrake",
-		"mandrakes",
-		"mangled",
-		"manner",
-		"many",
-		"map",
-		"marauder",
-		"marble",
-		"march",
-		"marcus",
-		"margaret",
-		"marietta",
-		"marjorie",
-		"mark",
-		"marked",
-		"marks",
-		"marry",
-		"mary",
-		"mascots",
-		"mason",
-		"masons",
-		"mass",
-		"massive",
-		"master",
-		"matches",
-		"materials",
-		"maternal",
-		"mates",
-		"matron",
-		"matter",
-		"mature",
-		"maxime",
-		"maxine",
-		"may",
-		"mayhem",
-		"maze-like",
-		"mcgonagall",
-		"mclaggen",
-		"mead",
-		"meal",
-		"mealtimes",
-		"mean",
-		"meaningful",
-		"meanwhile",
-		"measures",
-		"meddle",
-		"meeting",
-		"meetings",
-		"meets",
-		"melting",
-		"members",
-		"memorial",
-		"memories",
-		"memory",
-		"men",
-		"menacing",
-		"mental",
-		"mentioning",
-		"mentions",
-		"mentor",
-		"mercy",
-		"merely",
-		"merlin",
-		"merope",
-		"merpeople",
-		"mess",
-		"message",
-		"messages",
-		"messed",
-		"met",
-		"metal",
-		"metamorphmagus",
-		"methods",
-		"mice",
-		"middle",
-		"might",
-		"miles",
-		"milk",
-		"mimsy-porpington",
-		"mind",
-		"minds",
-		"minerva",
-		"minions",
-		"minister",
-		"ministry",
-		"ministry-approved",
-		"minor",
-		"minute",
-		"miraculous",
-		"miraculously",
-		"mirror",
-		"miserable",
-		"misfortune",
-		"mishandles",
-		"mismatched",
-		"miss",
-		"missed",
-		"misses",
-		"mission",
-		"mistake",
-		"mistreatment",
-		"mixture",
-		"moaning",
-		"mocking",
-		"model",
-		"modify",
-		"molly",
-		"moment",
-		"momentarily",
-		"monday",
-		"money",
-		"monitored",
-		"monster",
-		"monstrous",
-		"month",
-		"months",
-		"mood",
-		"moody",
-		"moon",
-		"moral",
-		"morale",
-		"more",
-		"morfin",
-		"morning",
-		"morsmordre",
-		"mortally",
-		"mosag",
-		"most",
-		"mostly",
-		"mother",
-		"motorbikes",
-		"motorcycle",
-		"mountain",
-		"mouth",
-		"moved",
-		"moves",
-		"moving",
-		"mrs",
-		"much",
-		"mudblood",
-		"mudbloods",
-		"muggle-born",
-		"muggles",
-		"mulled",
-		"multiple",
-		"mundungus",
-		"mungo",
-		"murder",
-		"murdering",
-		"murders",
-		"must",
-		"mutters",
-		"myrtle",
-		"mysterious",
-		"mystery",
-		"mystified",
-		"nagini",
-		"naked",
-		"name",
-		"narcissa",
-		"narrowly",
-		"nasty",
-		"natural",
-		"nature",
-		"navigates",
-		"near",
-		"nearby",
-		"nearly",
-		"nearly-headless",
-		"necessary",
-		"neck",
-		"necklace",
-		"needle",
-		"needs",
-		"negative",
-		"negotiations",
-		"neighbourhood",
-		"neighbours",
-		"nephew",
-		"nervous",
-		"net",
-		"nettle",
-		"network",
-		"never",
-		"nevertheless",
-		"neville",
-		"new",
-		"new-found",
-		"newcomers",
-		"newfound",
-		"newsman",
-		"newspaper",
-		"newspapers",
-		"nicolas",
-		"nifflers",
-		"night",
-		"nightmares",
-		"nights",
-		"nimbus",
-		"nine",
-		"nineteen",
-		"noble",
-		"nod",
-		"non-stop",
-		"none",
-		"nonetheless",
-		"nonsense",
-		"nor",
-		"normal",
-		"norris",
-		"norwegian",
-		"nose",
-		"note",
-		"nothing",
-		"notice",
-		"noticing",
-		"notified",
-		"noting",
-		"november",
-		"now",
-		"nowhere",
-		"number",
-		"numbers",
-		"numerous",
-		"nurseries",
-		"nymphadora",
-		"obedient",
-		"obituaries",
-		"object",
-		"oblivion",
-		"oblivious",
-		"observation",
-		"observe",
-		"observed",
-		"observes",
-		"obsessed",
-		"obsession",
-		"obstacles",
-		"obtain",
-		"obviously",
-		"occasions",
-		"occupants",
-		"occupy",
-		"occupying",
-		"occur",
-		"occurs",
-		"october",
-		"oddly",
-		"odds",
-		"off-balance",
-		"offence",
-		"offended",
-		"offender",
-		"offer",
-		"offered",
-		"offering",
-		"offers",
-		"office",
-		"offices",
-		"officials",
-		"often",
-		"ogden",
-		"old",
-		"older",
-		"oldest",
-		"oliver",
-		"ollivander",
-		"omen",
-		"one",
-		"only",
-		"onto",
-		"open",
-		"openly",
-		"opens",
-		"opportunity",
-		"opposing",
-		"opt",
-		"orb",
-		"orchestrating",
-		"order",
-		"ordered",
-		"orders",
-		"organisation",
-		"organise",
-		"organised",
-		"original",
-		"originally",
-		"orphanage",
-		"other",
-		"otherwise",
-		"ottery",
-		"outburst",
-		"outbursts",
-		"outmatched",
-		"outrage",
-		"outraged",
-		"outside",
-		"over",
-		"overachieving",
-		"overall",
-		"overcomes",
-		"overhear",
-		"overheard",
-		"overhears",
-		"overpower",
-		"overtakes",
-		"overwhelmed",
-		"owe",
-		"owed",
-		"owes",
-		"owls",
-		"owned",
-		"owner",
-		"oyt",
-		"package",
-		"padded",
-		"paid",
-		"pain",
-		"pained",
-		"painful",
-		"pains",
-		"pair",
-		"pale",
-		"pale-faced",
-		"pan",
-		"pansy",
-		"paper",
-		"paralyse",
-		"paralyses",
-		"paranoia",
-		"paranoid",
-		"parent",
-		"parents",
-		"parkinson",
-		"parseltongue",
-		"part",
-		"participate",
-		"particularly",
-		"partly",
-		"partner",
-		"parvati",
-		"pass",
-		"passage",
-		"passages",
-		"passageway",
-		"passed",
-		"passes",
-		"password",
-		"passwords",
-		"past",
-		"pat",
-		"patch",
-		"patches",
-		"path",
-		"patil",
-		"patronus",
-		"pay",
-		"paying",
-		"peace",
-		"peak",
-		"peers",
-		"peeves",
-		"penalised",
-		"penalty",
-		"pence",
-		"penelope",
-		"penned",
-		"penning",
-		"pensieve",
-		"people",
-		"per",
-		"percival",
-		"percy",
-		"perform",
-		"performances",
-		"performing",
-		"period",
-		"perkins",
-		"permanent",
-		"permanently",
-		"permission",
-		"perp",
-		"persecuted",
-		"persecuting",
-		"person",
-		"personal",
-		"personality",
-		"personally",
-		"persuade",
-		"pertaining",
-		"pests",
-		"pet",
-		"petrified",
-		"pets",
-		"petunia",
-		"phineas",
-		"phoenix",
-		"photo",
-		"photos",
-		"phrases",
-		"physiology",
-		"pick",
-		"picking",
-		"picks",
-		"picture",
-		"pictures",
-		"piers",
-		"pigwidgeon",
-		"piled",
-		"pillowlike",
-		"pills",
-		"pince",
-		"pinch",
-		"pitch",
-		"pius",
-		"place",
-		"placing",
-		"plan",
-		"planned",
-		"planning",
-		"plans",
-		"plant",
-		"platform",
-		"play",
-		"played",
-		"player",
-		"players",
-		"please",
-		"plot",
-		"plots",
-		"plotting",
-		"ploy",
-		"plum",
-		"plumbing",
-		"plunges",
-		"pocket",
-		"podmore",
-		"point",
-		"pointed",
-		"pointedly",
-		"pointing",
-		"points",
-		"pointy",
-		"poised",
-		"poison",
-		"poisoned",
-		"poisoning",
-		"poisonous",
-		"poltergeist",
-		"pomfrey",
-		"pool",
-		"poor",
-		"popping",
-		"popularity",
-		"portkey",
-		"portkeys",
-		"portrait",
-		"portraits",
-		"portraying",
-		"poses",
-		"position",
-		"possess",
-		"possessed",
-		"possesses",
-		"possession",
-		"possibility",
-		"possible",
-		"possibly",
-		"post",
-		"postcard",
-		"posted",
-		"postman",
-		"potential",
-		"potentially",
-		"potion-making",
-		"potions",
-		"potter",
-		"potters",
-		"potterwatch",
-		"pounding",
-		"power",
-		"powers",
-		"practising",
-		"praises",
-		"praising",
-		"prang",
-		"pranks",
-		"precaution",
-		"precautions",
-		"predict",
-		"predictably",
-		"predicted",
-		"prediction",
-		"prefects",
-		"preference",
-		"pregnant",
-		"prejudice",
-		"premises",
-		"presence",
-		"presents",
-		"preserved",
-		"pressed",
-		"presses",
-		"presumably",
-		"presumed",
-		"pretence",
-		"pretend",
-		"pretending",
-		"pretends",
-		"pretext",
-		"prevent",
-		"prevented",
-		"previous",
-		"pride",
-		"prime",
-		"prince",
-		"prior",
-		"priori",
-		"prison",
-		"prisoners",
-		"private",
-		"privately",
-		"privet",
-		"prize",
-		"probably",
-		"probes",
-		"problematic",
-		"proceeds",
-		"proclaiming",
-		"producing",
-		"product",
-		"professor",
-		"professors",
-		"profusely",
-		"programme",
-		"progresses",
-		"promised",
-		"promising",
-		"promoted",
-		"prongs",
-		"properly",
-		"properties",
-		"prophecy",
-		"prophet",
-		"proposed",
-		"proposes",
-		"protean",
-		"protection",
-		"protective",
-		"protest",
-		"protesting",
-		"protests",
-		"proud",
-		"prove",
-		"proved",
-		"proves",
-		"provide",
-		"provided",
-		"provides",
-		"proving",
-		"prowling",
-		"pseudonym",
-		"pub",
-		"public",
-		"publicly",
-		"publish",
-		"published",
-		"puff",
-		"pull",
-		"pulled",
-		"pulls",
-		"pumpkin",
-		"pun",
-		"punchline",
-		"punish",
-		"punished",
-		"punishes",
-		"punishment",
-		"purchased",
-		"purchases",
-		"pure",
-		"pure-blood",
-		"pure-bloods",
-		"pureblood",
-		"purebloods",
-		"purification",
-		"purple",
-		"purposely",
-		"pursue",
-		"pursuit",
-		"push",
-		"pushes",
-		"put",
-		"puzzle",
-		"puzzled",
-		"qualities",
-		"quarters",
-		"quest",
-		"question",
-		"questionable",
-		"questioned",
-		"questioning",
-		"questions",
-		"quibbler",
-		"quick",
-		"quickly",
-		"quietly",
-		"quill",
-		"quirinus",
-		"quirrel",
-		"quite",
-		"quote",
-		"quoted",
-		"race",
-		"racial",
-		"radio",
-		"rage",
-		"raging",
-		"raids",
-		"raining",
-		"raise",
-		"raised",
-		"raises",
-		"raising",
-		"ran",
-		"rang",
-		"rapidly",
-		"rat",
-		"rate",
-		"rather",
-		"rats",
-		"ravenclaws",
-		"reach",
-		"react",
-		"reaction",
-		"read",
-		"reading",
-		"reads",
-		"ready",
-		"real",
-		"realise",
-		"realised",
-		"realising",
-		"reality",
-		"really",
-		"reason",
-		"reasonable",
-		"reasoning",
-		"reasons",
-		"rebirth",
-		"rebounding",
-		"rebounds",
-		"rebuilding",
-		"recalled",
-		"recalls",
-		"received",
-		"receives",
-		"recent",
-		"recently",
-		"recipe",
-		"recognisable",
-		"recognise",
-		"recognises",
-		"recollects",
-		"recommences",
-		"reconciled",
-		"reconciles",
-		"reconciliation",
-		"reconnect",
-		"reconstituted",
-		"recording",
-		"recovered",
-		"recovers",
-		"recreate",
-		"recruit",
-		"recruiting",
-		"recruits",
-		"red-haired",
-		"red-handed",
-		"redecorated",
-		"referring",
-		"reform",
-		"refuse",
-		"refused",
-		"refuses",
-		"refusing",
-		"refutes",
-		"regaining",
-		"regarding",
-		"regime",
-		"reginald",
-		"regret",
-		"regrets",
-		"regretted",
-		"regular",
-		"regulations",
-		"regulus",
-		"regurgitated",
-		"reign",
-		"reignites",
-		"reinforced",
-		"reinforcement",
-		"reinstate",
-		"rejects",
-		"rejoicing",
-		"rejoin",
-		"relationship",
-		"relax",
-		"release",
-		"released",
-		"releases",
-		"relic",
-		"relief",
-		"relieved",
-		"relish",
-		"reluctant",
-		"reluctantly",
-		"remain",
-		"remainder",
-		"remained",
-		"remaining",
-		"remark",
-		"remarks",
-		"rematch",
-		"remember",
-		"remembering",
-		"remembers",
-		"remembrall",
-		"reminded",
-		"reminding",
-		"remnants",
-		"remorse",
-		"removal",
-		"remove",
-		"removed",
-		"removes",
-		"removing",
-		"remus",
-		"renounces",
-		"repair",
-		"repaired",
-		"repeated",
-		"repelled",
-		"replace",
-		"replaced",
-		"replaces",
-		"replies",
-		"reply",
-		"report",
-		"reported",
-		"reporter",
-		"reporting",
-		"reports",
-		"representative",
-		"represented",
-		"representing",
-		"represents",
-		"reprimanding",
-		"reprimands",
-		"reputable",
-		"reputation",
-		"request",
-		"requesting",
-		"rescue",
-		"rescues",
-		"research",
-		"researched",
-		"resembling",
-		"resentment",
-		"reside",
-		"residence",
-		"resident",
-		"resigning",
-		"resist",
-		"resisting",
-		"resorts",
-		"resources",
-		"respect",
-		"respective",
-		"respond",
-		"response",
-		"responsibility",
-		"responsible",
-		"rest",
-		"restarted",
-		"restorative",
-		"restored",
-		"restrains",
-		"restricted",
-		"restricted-books",
-		"restriction",
-		"result",
-		"resulted",
-		"resurrection",
-		"retaliation",
-		"retirement",
-		"retrieve",
-		"return",
-		"returned",
-		"returning",
-		"returns",
-		"reunite",
-		"reunited",
-		"reunites",
-		"reveal",
-		"revealed",
-		"revealing",
-		"reveals",
-		"revenge",
-		"revert",
-		"reverts",
-		"reviving",
-		"revolt",
-		"revolution",
-		"reward",
-		"rewards",
-		"rid",
-		"riddle",
-		"riddle-harry",
-		"rides",
-		"ridgeback",
-		"ridicules",
-		"rifle",
-		"right",
-		"rights",
-		"rise",
-		"rises",
-		"risk",
-		"risking",
-		"risky",
-		"rita",
-		"rival",
-		"road",
-		"rob",
-		"robe",
-		"robes",
-		"rocks",
-		"rocky",
-		"rogue",
-		"role",
-		"roll",
-		"rolling",
-		"rolls",
-		"romania",
-		"romantically",
-		"romilda",
-		"ron",
-		"ronan",
-		"roof",
-		"room",
-		"roosters",
-		"rosmerta",
-		"round",
-		"rounds",
-		"row",
-		"rowena",
-		"rubbishes",
-		"rubeus",
-		"rubies",
-		"ruckus",
-		"rude",
-		"rufus",
-		"ruined",
-		"ruining",
-		"ruins",
-		"rules",
-		"rummaging",
-		"rumours",
-		"run",
-		"runcorn",
-		"rung",
-		"running",
-		"runs",
-		"ruse",
-		"rush",
-		"sabotage",
-		"sack",
-		"sacked",
-		"sacks",
-		"sacrifice",
-		"sacrifices",
-		"sad",
-		"sadistic",
-		"sadly",
-		"safe",
-		"safety",
-		"said",
-		"sail",
-		"salazar",
-		"same",
-		"sandwiches",
-		"sane",
-		"sanity",
-		"sarcastically",
-		"sat",
-		"satisfied",
-		"satisfying",
-		"saturday",
-		"save",
-		"saved",
-		"saves",
-		"saving",
-		"savings",
-		"savoury",
-		"saw",
-		"say",
-		"saying",
-		"says",
-		"scar",
-		"scared",
-		"scaring",
-		"scarred",
-		"scheduled",
-		"school",
-		"schools",
-		"scold",
-		"scolding",
-		"score",
-		"scorpius",
-		"scream",
-		"screaming",
-		"screams",
-		"screeching",
-		"scribbled",
-		"scribe",
-		"scrimgeour",
-		"scurrying",
-		"seal",
-		"seals",
-		"seamus",
-		"search",
-		"searching",
-		"season",
-		"seat",
-		"second",
-		"second-hand",
-		"seconds",
-		"secret",
-		"secret-keeper",
-		"secrets",
-		"section",
-		"secure",
-		"security",
-		"seedlings",
-		"seeing",
-		"seek",
-		"seeker",
-		"seeking",
-		"seem",
-		"seemingly",
-		"seems",
-		"seen",
-		"sees",
-		"select",
-		"selected",
-		"selects",
-		"self",
-		"self-invented",
-		"sell",
-		"selling",
-		"sells",
-		"sender",
-		"sending",
-		"sends",
-		"senior",
-		"sense",
-		"sentence",
-		"sentenced",
-		"sentences",
-		"separate",
-		"separated",
-		"september",
-		"series",
-		"serious",
-		"seriously",
-		"seriousness",
-		"serpent",
-		"serpents",
-		"servant",
-		"serve",
-		"served",
-		"service",
-		"services",
-		"session",
-		"sessions",
-		"set",
-		"setting",
-		"settle",
-		"seven",
-		"seventeen",
-		"seventeenth",
-		"seventh",
-		"several",
-		"severe",
-		"severely",
-		"severus",
-		"shacklebolt",
-		"shadow",
-		"shake",
-		"shape",
-		"shape-shifters",
-		"shard",
-		"shards",
-		"share",
-		"shared",
-		"shares",
-		"sharing",
-		"sharp",
-		"shattered",
-		"sherry",
-		"shifted",
-		"ship",
-		"shocked",
-		"shocks",
-		"shoes",
-		"shoos",
-		"shoots",
-		"shop",
-		"shops",
-		"shores",
-		"short",
-		"shortly",
-		"shoulder",
-		"shouting",
-		"show",
-		"showed",
-		"showing",
-		"shown",
-		"shows",
-		"shrieking",
-		"shrill",
-		"shrink",
-		"shunning",
-		"shush",
-		"sickly",
-		"side",
-		"side-car",
-		"sides",
-		"sight",
-		"sighted",
-		"sign",
-		"signal",
-		"signalling",
-		"signature",
-		"signs",
-		"silver",
-		"similar",
-		"similarities",
-		"simple",
-		"simply",
-		"simultaneously",
-		"since",
-		"singles",
-		"sinister",
-		"sinking",
-		"sinks",
-		"sitting",
-		"situation",
-		"situations",
-		"six",
-		"sixteen",
-		"sixteen-year-old",
-		"sixth",
-		"sixty",
-		"size",
-		"skeeter",
-		"skeleton",
-		"skills",
-		"skin",
-		"skips",
-		"skirmish",
-		"skrewts",
-		"skull",
-		"sky",
-		"slams",
-		"slash",
-		"slashed",
-		"slashing",
-		"slated",
-		"sleep",
-		"sleeping",
-		"sleeps",
-		"slightly",
-		"slink",
-		"slinks",
-		"slipped",
-		"slithers",
-		"sloper",
-		"slowly",
-		"slughorn",
-		"slugs",
-		"slytherin",
-		"smacks",
-		"small",
-		"smaller",
-		"smallest",
-		"smart",
-		"smash",
-		"smashed",
-		"smashes",
-		"smell",
-		"smeltings",
-		"smile",
-		"smith",
-		"smoke",
-		"smothers",
-		"smuggle",
-		"smuggling",
-		"snakes",
-		"snape",
-		"snare",
-		"snatch",
-		"snatches",
-		"sneak",
-		"sneaking",
-		"sneaks",
-		"snitch",
-		"snobbish",
-		"snogging",
-		"snowy",
-		"snr",
-		"soapy",
-		"sober",
-		"societies",
-		"sold",
-		"solid",
-		"solve",
-		"solved",
-		"solves",
-		"some",
-		"somebody",
-		"somehow",
-		"someone",
-		"somewhat",
-		"son",
-		"song",
-		"soon",
-		"sorcery",
-		"sorted",
-		"sorting",
-		"sorts",
-		"sought",
-		"soul",
-		"sound",
-		"sounding",
-		"sounds",
-		"source",
-		"sours",
-		"spare",
-		"sparing",
-		"spat",
-		"speak",
-		"speaking",
-		"speaks",
-		"specialising",
-		"specialty",
-		"species",
-		"spectacularly",
-		"speculate",
-		"speculates",
-		"speculation",
-		"speech",
-		"speed",
-		"speeding",
-		"spellbooks",
-		"spellotape",
-		"spells",
-		"spend",
-		"spending",
-		"spent",
-		"spew",
-		"sphere",
-		"spiders",
-		"spill",
-		"spinnet",
-		"spirit",
-		"spirits",
-		"spite",
-		"spits",
-		"splinched",
-		"split",
-		"spoken",
-		"sports",
-		"spot",
-		"spread",
-		"spreading",
-		"sprints",
-		"sprout",
-		"sprouts",
-		"spy",
-		"spying",
-		"squeezed",
-		"squib",
-		"squire",
-		"stadium",
-		"staffing",
-		"stag",
-		"stag-shaped",
-		"stained",
-		"stair",
-		"staircase",
-		"staircases",
-		"stairs",
-		"stalemate",
-		"stamps",
-		"stand",
-		"standard",
-		"stands",
-		"stare",
-		"stared",
-		"stares",
-		"start",
-		"start-of-term",
-		"starting",
-		"startling",
-		"starts",
-		"starvation",
-		"stash",
-		"statements",
-		"states",
-		"stating",
-		"station",
-		"stationary",
-		"stationed",
-		"statue",
-		"staving",
-		"stayed",
-		"staying",
-		"stays",
-		"stead",
-		"steaks",
-		"stealing",
-		"steers",
-		"step",
-		"steps",
-		"stick",
-		"sticks",
-		"still",
-		"stirs",
-		"stole",
-		"stolen",
-		"stone",
-		"stonewall",
-		"stool",
-		"stopped",
-		"stops",
-		"store",
-		"storm",
-		"storming",
-		"storms",
-		"story",
-		"stra",
-		"straight",
-		"strained",
-		"strangely",
-		"stranger",
-		"strangest",
-		"strangle",
-		"strangles",
-		"strategies",
-		"street",
-		"streets",
-		"strength",
-		"stress",
-		"stresses",
-		"strike",
-		"strikes",
-		"strips",
-		"stroking",
-		"stroll",
-		"strong",
-		"strongly",
-		"struck",
-		"struggle",
-		"struggling",
-		"stuck",
-		"students",
-		"studies",
-		"stumped",
-		"stunning",
-		"stuns",
-		"sturgis",
-		"subdue",
-		"subjects",
-		"submerged",
-		"submit",
-		"submitted",
-		"submitting",
-		"subscription",
-		"substitute",
-		"substituted",
-		"subtly",
-		"succeed",
-		"successful",
-		"successfully",
-		"such",
-		"suck",
-		"sucked",
-		"sucks",
-		"sudden",
-		"suddenly",
-		"suffering",
-		"sufficient",
-		"suffocate",
-		"suggest",
-		"suggesting",
-		"suggestion",
-		"suggestions",
-		"suicide",
-		"suitable",
-		"sulking",
-		"summer",
-		"summon",
-		"summoned",
-		"summoning",
-		"summons",
-		"sun",
-		"sunday",
-		"superiority",
-		"supervision",
-		"supplies",
-		"support",
-		"supporters",
-		"supporting",
-		"supposed",
-		"supposedly",
-		"supposing",
-		"supremacist",
-		"sure",
-		"surprise",
-		"surprised",
-		"surprises",
-		"surprising",
-		"surprisingly",
-		"surrender",
-		"surrey",
-		"surround",
-		"surrounding",
-		"surveillance",
-		"survive",
-		"survived",
-		"survives",
-		"surviving",
-		"susan",
-		"suspect",
-		"suspected",
-		"suspects",
-		"suspend",
-		"suspicion",
-		"suspicions",
-		"suspicious",
-		"suspiciously",
-		"sustained",
-		"swallowing",
-		"swarm",
-		"sway",
-		"sweater",
-		"sweets",
-		"swimming",
-		"switched",
-		"switches",
-		"sybill",
-		"syllabus",
-		"symbol",
-		"sympathetic",
-		"sympathetically",
-		"syphons",
-		"tabby",
-		"tables",
-		"taboo",
-		"tactlessly",
-		"tail",
-		"take",
-		"taken",
-		"takes",
-		"tale",
-		"talented",
-		"tales",
-		"talk",
-		"talked",
-		"talking",
-		"talks",
-		"tallest",
-		"talons",
-		"tampering",
-		"tapestry",
-		"tapping",
-		"task",
-		"tasks",
-		"taught",
-		"taunts",
-		"taxi",
-		"taxing",
-		"tea",
-		"teach",
-		"teacher",
-		"teachers",
-		"teaches",
-		"teaching",
-		"team",
-		"teammates",
-		"tears",
-		"tease",
-		"teased",
-		"teases",
-		"technique",
-		"ted",
-		"teddy",
-		"teenage",
-		"teenagers",
-		"teeth",
-		"telephone",
-		"tell",
-		"tells",
-		"temper",
-		"temperature",
-		"ten",
-		"tended",
-		"tendencies",
-		"tender",
-		"tennis",
-		"tent",
-		"term",
-		"terms",
-		"terrible",
-		"terrified",
-		"terrifying",
-		"terry",
-		"test",
-		"tested",
-		"testified",
-		"testing",
-		"textbook",
-		"texture",
-		"than",
-		"thank",
-		"thankful",
-		"thankfully",
-		"thanks",
-		"that",
-		"the",
-		"them",
-		"then",
-		"thenceforth",
-		"theory",
-		"there",
-		"therefore",
-		"these",
-		"thestrals",
-		"they",
-		"thick",
-		"thicknesse",
-		"thing",
-		"things",
-		"think",
-		"thinking",
-		"thinks",
-		"third",
-		"third-floor",
-		"thirst",
-		"thirteenth",
-		"thirty",
-		"thomas",
-		"those",
-		"though",
-		"thoughts",
-		"thousand",
-		"threat",
-		"threatened",
-		"threatening",
-		"threatens",
-		"threats",
-		"three",
-		"three-headed",
-		"three-quarters",
-		"throat",
-		"through",
-		"throughout",
-		"throw",
-		"throwing",
-		"thrown",
-		"throws",
-		"ths",
-		"thursday",
-		"ticket",
-		"tickets",
-		"tie",
-		"tighter",
-		"tightly",
-		"time",
-		"times",
-		"tin",
-		"tips",
-		"title",
-		"tobias",
-		"toffee",
-		"together",
-		"told",
-		"tom",
-		"tombstone",
-		"tomorrow",
-		"ton-tongue",
-		"tonks",
-		"too",
-		"took",
-		"toothpick",
-		"top",
-		"top-secret",
-		"topics",
-		"topmost",
-		"torch",
-		"tormented",
-		"tortured",
-		"torturing",
-		"total",
-		"tottenham",
-		"touch",
-		"touches",
-		"touching",
-		"tough",
-		"tour",
-		"tournament",
-		"toward",
-		"towards",
-		"tower",
-		"town",
-		"toying",
-		"trace",
-		"trade",
-		"traditional",
-		"tragedy",
-		"trails",
-		"trained",
-		"training",
-		"transferred",
-		"transfiguration",
-		"transfigures",
-		"transform",
-		"transformed",
-		"transforms",
-		"transition",
-		"transplant",
-		"transportation",
-		"transported",
-		"trapdoor",
-		"trapped",
-		"traps",
-		"travel",
-		"travelling",
-		"travels",
-		"treated",
-		"treatment",
-		"treats",
-		"tree",
-		"trees",
-		"trelawney",
-		"trial",
-		"trick",
-		"tricked",
-		"tricks",
-		"tricky",
-		"tried",
-		"tries",
-		"trigger",
-		"trio",
-		"trip",
-		"triple-decker",
-		"triwizard",
-		"troll",
-		"trophies",
-		"trophy",
-		"trouble",
-		"troublesome",
-		"true",
-		"truly",
-		"trunk",
-		"trunks",
-		"trust",
-		"trusted",
-		"trying",
-		"tune",
-		"tunnels",
-		"turned",
-		"turning",
-		"turns",
-		"twelve",
-		"twelve-foot",
-		"two",
-		"two-way",
-		"umbridge",
-		"unable",
-		"unaware",
-		"unbeknownst",
-		"uncertainty",
-		"uncertainy",
-		"unchangeable",
-		"uncle",
-		"uncomfortable",
-		"unconcerned",
-		"unconscious",
-		"unconvinced",
-		"uncover",
-		"uncovered",
-		"under",
-		"under-age",
-		"underage",
-		"undergoes",
-		"underground",
-		"undermine",
-		"underneath",
-		"underpants",
-		"undersecretary",
-		"understands",
-		"undervaluing",
-		"undisclosed",
-		"undoes",
-		"unendurable",
-		"unexpectedly",
-		"unfamiliar",
-		"unfortunately",
-		"unhappy",
-		"unharmed",
-		"unicorn",
-		"uniform",
-		"unimportant",
-		"unknown",
-		"unlikelihood",
-		"unlocking",
-		"unlucky",
-		"unnecessary",
-		"unnoticed",
-		"unpleasant",
-		"unpopular",
-		"unregistered",
-		"unsettled",
-		"unspoken",
-		"unsuccessfully",
-		"unsure",
-		"unsurprisingly",
-		"unsuspecting",
-		"until",
-		"untransformed",
-		"unusual",
-		"unwanted",
-		"unwilling",
-		"unworthy",
-		"upbringings",
-		"update",
-		"upgrade",
-		"uprising",
-		"uproar",
-		"upset",
-		"upstairs",
-		"urge",
-		"used",
-		"useful",
-		"useless",
-		"user",
-		"uses",
-		"usually",
-		"utters",
-		"vacated",
-		"vacuum",
-		"vague",
-		"vain",
-		"valentine",
-		"valentines",
-		"valour",
-		"vance",
-		"vane",
-		"vanished",
-		"vanishes",
-		"vanishing",
-		"vanquished",
-		"various",
-		"vast",
-		"vault",
-		"vaults",
-		"veela",
-		"veiled",
-		"venture",
-		"verbal",
-		"veritaserum",
-		"vernon",
-		"version",
-		"versus",
-		"very",
-		"via",
-		"vicinity",
-		"victims",
-		"victoire",
-		"victoriously",
-		"victory",
-		"view",
-		"viewing",
-		"village",
-		"vincent",
-		"vindictively",
-		"violated",
-		"violations",
-		"violent",
-		"vision",
-		"visit",
-		"visitation",
-		"visiting",
-		"visitors",
-		"voice",
-		"voices",
-		"voldemort",
-		"voldemort-controlled",
-		"volunteered",
-		"vomiting",
-		"vote",
-		"voted",
-		"vulnerable",
-		"waddling",
-		"wage",
-		"wager",
-		"wages",
-		"wait",
-		"waits",
-		"wake",
-		"walden",
-		"walking",
-		"walks",
-		"walls",
-		"wand",
-		"wanders",
-		"wands",
-		"want",
-		"wanted",
-		"wanting",
-		"wants",
-		"war",
-		"ward",
-		"warmly",
-		"warmth",
-		"warn",
-		"warnings",
-		"warns",
-		"wary",
-		"was",
-		"washes",
-		"wasted",
-		"wasting",
-		"watch",
-		"watched",
-		"watching",
-		"water",
-		"waves",
-		"waving",
-		"wax",
-		"way",
-		"ways",
-		"weak",
-		"weakened",
-		"weakness",
-		"wealth",
-		"weapon",
-		"wear",
-		"wearer",
-		"wearing",
-		"wears",
-		"weasley",
-		"weasleys",
-		"webbed",
-		"wedding",
-		"week",
-		"weekend",
-		"weeks",
-		"weighing",
-		"weird",
-		"welcome",
-		"welcoming",
-		"went",
-		"were",
-		"werewolf",
-		"werewolves",
-		"west",
-		"what",
-		"whatever",
-		"wheezes",
-		"when",
-		"whenever",
-		"where",
-		"wherein",
-		"while",
-		"whimpering",
-		"whinging",
-		"whisked",
-		"white",
-		"whoever",
-		"whole",
-		"whom",
-		"whomping",
-		"whooping",
-		"whose",
-		"willow",
-		"window",
-		"windows",
-		"wine",
-		"wingardium",
-		"winged",
-		"winky",
-		"winnings",
-		"wins",
-		"winter",
-		"wishes",
-		"wishing",
-		"witch",
-		"witchcraft",
-		"witches",
-		"with",
-		"withholding",
-		"within",
-		"without",
-		"witnessed",
-		"witnesses",
-		"witnessing",
-		"wizardry",
-		"wizards",
-		"wizengamot",
-		"woken",
-		"wolfsbane",
-		"woman",
-		"wondering",
-		"wood",
-		"woods",
-		"wool",
-		"word",
-		"words",
-		"work",
-		"worked",
-		"working",
-		"workload",
-		"workplace",
-		"world",
-		"worried",
-		"worries",
-		"worry",
-		"worsen",
-		"worst",
-		"worth",
-		"would",
-		"wound",
-		"wrap",
-		"wreath",
-		"wrecks",
-		"wrenched",
-		"wrests",
-		"wrists",
-		"write",
-		"writes",
-		"writing",
-		"written",
-		"wrong",
-		"wrote",
-		"yard",
-		"yaxley",
-		"year",
-		"yearlong",
-		"years",
-		"yelled",
-		"yelling",
-		"yellow",
-		"yes",
-		"yet",
-		"yorkshire",
-		"you",
-		"you-know-what",
-		"you-know-who",
-		"younger",
-		"your",
-		"youth",
-		"yule",
-		"zabini",
-		"zacharias",
-		"zombie",
-		"zoo",
-	},
-	"trek": {
-		"abandon",
-		"abdomen",
-		"abduction",
-		"aberrations",
-		"abilities",
-		"ability",
-		"able",
-		"abnormal",
-		"aboard",
-		"abruptly",
-		"absence",
-		"absolutely",
-		"absorbed",
-		"absorbing",
-		"academy",
-		"accepted",
-		"accepts",
-		"accesses",
-		"accident",
-		"accidentally",
-		"accompanies",
-		"accompany",
-		"according",
-		"accosted",
-		"accumulate",
-		"accusation",
-		"achieved",
-		"achievements",
-		"achieving",
-		"acid",
-		"acknowledge",
-		"acquaintances",
-		"acquired",
-		"across",
-		"act",
-		"acting",
-		"action",
-		"actions",
-		"activate",
-		"activated",
-		"activates",
-		"active",
-		"activities",
-		"activity",
-		"acts",
-		"actual",
-		"actually",
-		"add",
-		"adding",
-		"additional",
-		"addresses",
-		"adds",
-		"adequacy",
-		"adequate",
-		"adjust",
-		"administered",
-		"administers",
-		"admiral",
-		"admirals",
-		"admiralty",
-		"admiration",
-		"admission",
-		"admits",
-		"adolf",
-		"adrenaline",
-		"advance",
-		"advancing",
-		"advantage",
-		"adventures",
-		"adverse",
-		"advice",
-		"advise",
-		"advised",
-		"advises",
-		"advising",
-		"aesthetics",
-		"affair",
-		"affected",
-		"affection",
-		"affirmative",
-		"afford",
-		"afraid",
-		"aft",
-		"after",
-		"afterlife",
-		"afterward",
-		"afterwards",
-		"again",
-		"against",
-		"age",
-		"agitated",
-		"ago",
-		"agonizer",
-		"agony",
-		"agree",
-		"agreeing",
-		"ahead",
-		"aid",
-		"aide",
-		"air",
-		"airiam",
-		"alert",
-		"alerts",
-		"alice",
-		"alien",
-		"align",
-		"all",
-		"allergies",
-		"allergy",
-		"alley",
-		"allow",
-		"allowed",
-		"allowing",
-		"allows",
-		"alludes",
-		"almost",
-		"alone",
-		"along",
-		"alongside",
-		"aloud",
-		"alpha",
-		"already",
-		"also",
-		"alter",
-		"alterations",
-		"altered",
-		"alternative",
-		"alternatives",
-		"always",
-		"amazed",
-		"ambassador",
-		"ambassadors",
-		"ambushed",
-		"american",
-		"among",
-		"amount",
-		"amounts",
-		"amused",
-		"analgesic",
-		"analysis",
-		"analyze",
-		"ancestral",
-		"ancient",
-		"and",
-		"anderson",
-		"andoria",
-		"andorian",
-		"angela",
-		"angrily",
-		"angry",
-		"anicium",
-		"animals",
-		"announces",
-		"announcing",
-		"annoy",
-		"anomaly",
-		"another",
-		"answer",
-		"answers",
-		"antares",
-		"antenna",
-		"anthropomorphizing",
-		"anti-radiation",
-		"anticipated",
-		"anticipates",
-		"antimatter",
-		"any",
-		"anyone",
-		"anything",
-		"anywhere",
-		"apart",
-		"apartment",
-		"apocalyptic",
-		"apologize",
-		"apologizes",
-		"apology",
-		"apparent",
-		"apparently",
-		"appear",
-		"appearance",
-		"appeared",
-		"appearing",
-		"appears",
-		"appraised",
-		"appreciate",
-		"appreciates",
-		"apprises",
-		"approach",
-		"approachable",
-		"approached",
-		"approaches",
-		"approaching",
-		"approve",
-		"approximate",
-		"approximately",
-		"april",
-		"archaic",
-		"archer",
-		"are",
-		"area",
-		"areas",
-		"arena",
-		"argue",
-		"argues",
-		"arguing",
-		"argument",
-		"arises",
-		"arm",
-		"armed",
-		"armory",
-		"arms",
-		"around",
-		"arranged",
-		"arrest",
-		"arrested",
-		"arrival",
-		"arrive",
-		"arrived",
-		"arrives",
-		"arriving",
-		"article",
-		"artificially-created",
-		"arts",
-		"ash",
-		"aside",
-		"ask",
-		"asked",
-		"asking",
-		"asks",
-		"asleep",
-		"assailant",
-		"assassination",
-		"assassins",
-		"assault",
-		"assembles",
-		"assents",
-		"assert",
-		"assessment",
-		"asset",
-		"assigned",
-		"assignment",
-		"assignments",
-		"assigns",
-		"assist",
-		"assistance",
-		"assistants",
-		"associated",
-		"associates",
-		"assortment",
-		"assumed",
-		"assumes",
-		"assumption",
-		"assured",
-		"assures",
-		"astern",
-		"asteroid",
-		"astromycologist",
-		"astrophysics",
-		"atmosphere",
-		"atom",
-		"atomic",
-		"atonement",
-		"atop",
-		"attached",
-		"attack",
-		"attacked",
-		"attacker",
-		"attackers",
-		"attacking",
-		"attacks",
-		"attempt",
-		"attempted",
-		"attempting",
-		"attempts",
-		"attend",
-		"attending",
-		"attends",
-		"attention",
-		"attitude",
-		"attracts",
-		"attributes",
-		"augment",
-		"authorization",
-		"authorized",
-		"automatic",
-		"autopilot",
-		"autopsy",
-		"available",
-		"avalanche",
-		"avoid",
-		"avoiding",
-		"avoids",
-		"awaited",
-		"awaits",
-		"awakens",
-		"aware",
-		"away",
-		"awesome",
-		"awestruck",
-		"awful",
-		"awkwardly",
-		"babbling",
-		"babel",
-		"back",
-		"backhands",
-		"backs",
-		"backtrack",
-		"backwards",
-		"baffled",
-		"balanced",
-		"band",
-		"bank",
-		"bar",
-		"barbarians",
-		"barely",
-		"baris",
-		"barman",
-		"barrage",
-		"based",
-		"basement",
-		"bases",
-		"basidiosac",
-		"battle",
-		"bay",
-		"beam",
-		"beamed",
-		"beams",
-		"bear",
-		"beard",
-		"bears",
-		"beaten",
-		"beating",
-		"beatles",
-		"beautiful",
-		"beauty",
-		"became",
-		"becomes",
-		"becoming",
-		"bed",
-		"bedside",
-		"been",
-		"beep",
-		"beer",
-		"before",
-		"begged",
-		"begging",
-		"beginning",
-		"begins",
-		"begs",
-		"behalf",
-		"behavior",
-		"being",
-		"belayed",
-		"belief",
-		"believable",
-		"believes",
-		"believing",
-		"belligerent",
-		"belong",
-		"beloved",
-		"benefits",
-		"benzar",
-		"berates",
-		"besides",
-		"best",
-		"betazoid",
-		"betrays",
-		"betrothal",
-		"between",
-		"beyond",
-		"bicker",
-		"bid",
-		"bids",
-		"big",
-		"binary",
-		"biochemistry",
-		"biological",
-		"biology",
-		"bird",
-		"birth",
-		"birthplace",
-		"bit",
-		"black",
-		"blank",
-		"blankly",
-		"blast",
-		"blasts",
-		"blew",
-		"blindsiding",
-		"blocked",
-		"blocks",
-		"blood",
-		"bloodstream",
-		"bloodworm",
-		"bloom",
-		"blow",
-		"blown",
-		"blueberries",
-		"blueberry",
-		"blurry",
-		"blurts",
-		"board",
-		"boarding",
-		"bodies",
-		"body",
-		"bold",
-		"bolts",
-		"bomb",
-		"bond",
-		"bonding",
-		"bonus",
-		"booming",
-		"booms",
-		"booth",
-		"bordering",
-		"bored",
-		"born",
-		"borrowed",
-		"borrows",
-		"both",
-		"bottom",
-		"bought",
-		"boundary",
-		"bow",
-		"boyfriends",
-		"brace",
-		"bragged",
-		"brakes",
-		"brand-new",
-		"brawl",
-		"brawlers",
-		"breach",
-		"breaching",
-		"bread",
-		"break",
-		"breaking",
-		"breaks",
-		"breakthrough",
-		"breath",
-		"brett",
-		"brewing",
-		"bribe",
-		"bride",
-		"bridge",
-		"brief",
-		"briefed",
-		"briefing",
-		"briefly",
-		"brig",
-		"bright",
-		"brilliant",
-		"bring",
-		"bringing",
-		"brings",
-		"broadcast",
-		"broke",
-		"broken",
-		"broker",
-		"brought",
-		"bruises",
-		"brushes",
-		"brushing",
-		"brutal",
-		"brutally",
-		"bryce",
-		"bugs",
-		"build",
-		"building",
-		"built",
-		"bunrham",
-		"buran",
-		"burham",
-		"buries",
-		"burn",
-		"burned",
-		"burnham",
-		"burns",
-		"business",
-		"busy",
-		"butcher",
-		"button",
-		"buttons",
-		"bypass",
-		"cabaret",
-		"cabin",
-		"cabinet",
-		"cadet",
-		"caesar",
-		"calculated",
-		"calculates",
-		"calibrating",
-		"call",
-		"called",
-		"caller",
-		"calling",
-		"calls",
-		"calmly",
-		"came",
-		"can",
-		"canceled",
-		"cancels",
-		"cannot",
-		"capable",
-		"capacity",
-		"captain",
-		"captaincy",
-		"captains",
-		"captured",
-		"care",
-		"career",
-		"carefully",
-		"carelessness",
-		"cares",
-		"caresses",
-		"caretaker",
-		"carry",
-		"carrying",
-		"case",
-		"cast",
-		"catalysts",
-		"catastrophic",
-		"catatonic",
-		"catch",
-		"catches",
-		"caught",
-		"cause",
-		"caused",
-		"causes",
-		"causing",
-		"caution",
-		"cease",
-		"ceasing",
-		"cell",
-		"cellmates",
-		"cent",
-		"center",
-		"central",
-		"centurion",
-		"century",
-		"ceramic",
-		"ceremonies",
-		"ceremony",
-		"certain",
-		"chair",
-		"challenge",
-		"chamber",
-		"champion",
-		"chances",
-		"change",
-		"changed",
-		"changes",
-		"changing",
-		"channel",
-		"chaos",
-		"chapel",
-		"character",
-		"characteristic",
-		"charge",
-		"charges",
-		"charm",
-		"charon",
-		"chased",
-		"chastises",
-		"check",
-		"checks",
-		"chekov",
-		"chemistry",
-		"chest",
-		"chicken",
-		"chides",
-		"chief",
-		"childhood",
-		"children",
-		"chimes",
-		"choice",
-		"choices",
-		"choose",
-		"chooses",
-		"chores",
-		"chose",
-		"chosen",
-		"christine",
-		"christopher",
-		"chute",
-		"circa",
-		"circuit",
-		"circuits",
-		"circulating",
-		"circumstance",
-		"circumstances",
-		"cities",
-		"citing",
-		"citizens",
-		"city",
-		"civilian",
-		"civilization",
-		"civilizations",
-		"civilized",
-		"claim",
-		"claimed",
-		"claims",
-		"clarifies",
-		"clark",
-		"class",
-		"classified",
-		"classify",
-		"clean",
-		"cleaning",
-		"clear",
-		"cleared",
-		"clearly",
-		"clenched",
-		"clicking",
-		"climate",
-		"climb",
-		"climbed",
-		"climbs",
-		"cloak",
-		"cloaked",
-		"cloaking",
-		"clock",
-		"close",
-		"closer",
-		"closes",
-		"closing",
-		"clothes",
-		"clouds",
-		"co-opted",
-		"co-pilot",
-		"code",
-		"code-named",
-		"coffee",
-		"coffins",
-		"cold",
-		"coldly",
-		"collapse",
-		"collapses",
-		"colleague",
-		"collects",
-		"collide",
-		"collides",
-		"collision",
-		"colonies",
-		"colonists",
-		"colony",
-		"colorful",
-		"combat",
-		"combination",
-		"combines",
-		"come",
-		"comes",
-		"comet",
-		"comfortable",
-		"coming",
-		"comings",
-		"comm",
-		"commander",
-		"commanders",
-		"commanding",
-		"commands",
-		"comment",
-		"commenting",
-		"comments",
-		"commissioned",
-		"committed",
-		"common",
-		"communicate",
-		"communicating",
-		"communication",
-		"communications",
-		"communicator",
-		"communicators",
-		"companions",
-		"company",
-		"comparable",
-		"compare",
-		"comparing",
-		"compartment",
-		"compartments",
-		"compensate",
-		"complaining",
-		"complements",
-		"complete",
-		"completely",
-		"completes",
-		"complex",
-		"complicated",
-		"complies",
-		"complimentary",
-		"complimenting",
-		"compliments",
-		"comply",
-		"complying",
-		"component",
-		"compound",
-		"comprised",
-		"compromised",
-		"computer",
-		"concept",
-		"concern",
-		"concerned",
-		"concerns",
-		"conclude",
-		"concludes",
-		"conclusion",
-		"concussion",
-		"condense",
-		"condition",
-		"conditions",
-		"conduct",
-		"conducting",
-		"conducts",
-		"conference",
-		"conferred",
-		"confers",
-		"confesses",
-		"confidently",
-		"confides",
-		"configuration",
-		"confine",
-		"confined",
-		"confirmation",
-		"confirms",
-		"confiscating",
-		"conflict",
-		"confrontation",
-		"confronting",
-		"confronts",
-		"confused",
-		"confusing",
-		"confusion",
-		"congratulate",
-		"congratulates",
-		"conn",
-		"connect",
-		"connecting",
-		"conquered",
-		"conquest",
-		"consciousness",
-		"conscript",
-		"consequence",
-		"consequences",
-		"conservative",
-		"consider",
-		"considered",
-		"considering",
-		"considers",
-		"consist",
-		"consort",
-		"conspicuous",
-		"constellation",
-		"constitution",
-		"consulted",
-		"consults",
-		"consumed",
-		"contacts",
-		"contain",
-		"container",
-		"containing",
-		"containment",
-		"contains",
-		"contemplate",
-		"contemplates",
-		"contemplatively",
-		"contemporary",
-		"content",
-		"contents",
-		"context",
-		"continue",
-		"continues",
-		"continuing",
-		"contrary",
-		"contrasted",
-		"control",
-		"controlling",
-		"controls",
-		"conversation",
-		"conversational",
-		"conversely",
-		"conversing",
-		"convict",
-		"conviction",
-		"convicts",
-		"convinced",
-		"convinces",
-		"cookie",
-		"coolant",
-		"coolly",
-		"cooperate",
-		"coordinates",
-		"coos",
-		"cordrazine",
-		"cordrazine-induced",
-		"coridan",
-		"corner",
-		"cornered",
-		"cornwell",
-		"corpse",
-		"correct",
-		"correctly",
-		"corrects",
-		"correlating",
-		"correlation",
-		"correspondence",
-		"corridors",
-		"corrupted",
-		"corruption",
-		"corvan",
-		"cost",
-		"costumed",
-		"cot",
-		"could",
-		"council",
-		"counsel",
-		"counsels",
-		"counterpart",
-		"counterparts",
-		"counters",
-		"couple",
-		"course",
-		"courses",
-		"court",
-		"court-martial",
-		"courtesy",
-		"cousin",
-		"cover",
-		"covered",
-		"craft",
-		"cranial",
-		"crate",
-		"crawling",
-		"crawls",
-		"crazy",
-		"creak",
-		"create",
-		"created",
-		"creating",
-		"creature",
-		"creatures",
-		"credentials",
-		"credit",
-		"creditors",
-		"credits",
-		"creepy",
-		"crepusculan",
-		"crew",
-		"crewman",
-		"crewmembers",
-		"crewmen",
-		"crime",
-		"crimes",
-		"crippled",
-		"cripples",
-		"critical",
-		"critically",
-		"cross",
-		"crossed",
-		"crossfire",
-		"crowd",
-		"crucial",
-		"cruise",
-		"cruiser",
-		"crush",
-		"cry",
-		"cryptobiosis",
-		"crystal",
-		"cube",
-		"culture",
-		"cumulative",
-		"cup",
-		"cure",
-		"curing",
-		"curious",
-		"curiously",
-		"current",
-		"currently",
-		"custody",
-		"custom",
-		"cut",
-		"cuts",
-		"cutting",
-		"cybernetic",
-		"cycle",
-		"damage",
-		"damaged",
-		"damages",
-		"damaging",
-		"dance",
-		"dancing",
-		"danger",
-		"dare",
-		"dark",
-		"darkened",
-		"darsek",
-		"darseks",
-		"darts",
-		"darvin",
-		"dash",
-		"data",
-		"date",
-		"daughter",
-		"dawn",
-		"day",
-		"days",
-		"deactivates",
-		"dead",
-		"deadline",
-		"deal",
-		"dealer",
-		"dear",
-		"death",
-		"debate",
-		"debris",
-		"deceased",
-		"decency",
-		"decide",
-		"decided",
-		"decides",
-		"decision",
-		"decius",
-		"deck",
-		"decker",
-		"decks",
-		"declare",
-		"declares",
-		"declaring",
-		"decline",
-		"declines",
-		"decorated",
-		"decoration",
-		"decoy",
-		"decrypt",
-		"decrypted",
-		"deduces",
-		"deep",
-		"deeply",
-		"defeat",
-		"defeating",
-		"defect",
-		"defended",
-		"defense",
-		"defiant",
-		"deflect",
-		"deflecting",
-		"deflector",
-		"deflects",
-		"degradation",
-		"delayed",
-		"delegate",
-		"delegates",
-		"delegation",
-		"deliberately",
-		"delicacy",
-		"delighted",
-		"delighting",
-		"demand",
-		"demanding",
-		"demands",
-		"dematerializes",
-		"demonstrates",
-		"demotes",
-		"demurs",
-		"denies",
-		"dense",
-		"densities",
-		"deny",
-		"departed",
-		"department",
-		"departs",
-		"departure",
-		"depleted",
-		"deposited",
-		"depression",
-		"derelict",
-		"derogatory",
-		"descend",
-		"descended",
-		"describes",
-		"desert",
-		"designation",
-		"desire",
-		"desired",
-		"desires",
-		"desk",
-		"desperate",
-		"desperately",
-		"desperation",
-		"despite",
-		"destination",
-		"destinations",
-		"destroy",
-		"destroyed",
-		"destroying",
-		"destruction",
-		"destructive",
-		"detailed",
-		"detailing",
-		"detained",
-		"detains",
-		"detect",
-		"detected",
-		"detecting",
-		"detection",
-		"detects",
-		"deteriorating",
-		"deterioration",
-		"determine",
-		"determined",
-		"determines",
-		"determining",
-		"detmer",
-		"detonating",
-		"detonator",
-		"develop",
-		"developed",
-		"development",
-		"deviations",
-		"device",
-		"devices",
-		"devotion",
-		"diagnoses",
-		"did",
-		"die",
-		"died",
-		"dies",
-		"different",
-		"difficulty",
-		"dignitaries",
-		"dilithium",
-		"dim",
-		"diminishes",
-		"dinner",
-		"direct",
-		"direction",
-		"directly",
-		"directs",
-		"dis",
-		"disables",
-		"disagrees",
-		"disappear",
-		"disappearing",
-		"disappears",
-		"disappointment",
-		"disarms",
-		"disaster",
-		"discharge",
-		"discharges",
-		"disciplined",
-		"discomfort",
-		"disconnected",
-		"discounts",
-		"discover",
-		"discovered",
-		"discovering",
-		"discovers",
-		"discovery",
-		"discuss",
-		"discussing",
-		"discussion",
-		"discussions",
-		"diseases",
-		"disintegrates",
-		"disintegrating",
-		"dislikes",
-		"dismissed",
-		"dismisses",
-		"dismissing",
-		"dismissively",
-		"disobeying",
-		"disobeys",
-		"dispatching",
-		"dispenses",
-		"displacement",
-		"displaying",
-		"displays",
-		"disposal",
-		"disputed",
-		"disrupt",
-		"disrupted",
-		"disruptions",
-		"disruptor",
-		"disruptors",
-		"dissected",
-		"dissembles",
-		"dissipate",
-		"dissipated",
-		"distance",
-		"distances",
-		"distaste",
-		"distinguish",
-		"distortions",
-		"distracted",
-		"distracting",
-		"distraction",
-		"distracts",
-		"distress",
-		"disturbed",
-		"diversion",
-		"divert",
-		"diverted",
-		"dizziness",
-		"dizzy",
-		"dna",
-		"docking",
-		"doctari",
-		"doctor",
-		"doctoring",
-		"doing",
-		"donatu",
-		"done",
-		"donor",
-		"doomed",
-		"door",
-		"doors",
-		"doorway",
-		"dormant",
-		"double",
-		"double-reinforced",
-		"down",
-		"download",
-		"dozen",
-		"dozens",
-		"drafts",
-		"drag",
-		"dragged",
-		"dragging",
-		"draw",
-		"drawn",
-		"draws",
-		"dream",
-		"dreamed",
-		"drills",
-		"drinks",
-		"driving",
-		"dropped",
-		"drops",
-		"drought",
-		"drug",
-		"dry",
-		"dsc",
-		"dubious",
-		"duct",
-		"ducts",
-		"due",
-		"duplicated",
-		"duration",
-		"during",
-		"dust",
-		"duties",
-		"duty",
-		"dying",
-		"each",
-		"eagerly",
-		"ear-piercing",
-		"earlier",
-		"early",
-		"earth",
-		"earth-romulan",
-		"earth-type",
-		"easier",
-		"easily",
-		"easy",
-		"eaten",
-		"eating",
-		"echo",
-		"economic",
-		"economy",
-		"edge",
-		"edith",
-		"eel-bird",
-		"effect",
-		"effective",
-		"effectively",
-		"effects",
-		"efficiently",
-		"efforts",
-		"egg",
-		"eight",
-		"either",
-		"election",
-		"electricity",
-		"electromagnetic",
-		"elects",
-		"element",
-		"eleven",
-		"eliminate",
-		"ellen",
-		"else",
-		"elsewhere",
-		"embassy",
-		"embrace",
-		"embraces",
-		"emerge",
-		"emergency",
-		"emotion",
-		"emotions",
-		"emphasis",
-		"empire",
-		"employ",
-		"empty",
-		"emptying",
-		"enables",
-		"enchanted",
-		"encompasses",
-		"encounter",
-		"encountered",
-		"encounters",
-		"encourages",
-		"end",
-		"endanger",
-		"endangered",
-		"ended",
-		"ending",
-		"endless",
-		"ends",
-		"enemies",
-		"enemy",
-		"energizes",
-		"energy",
-		"engage",
-		"engages",
-		"engine",
-		"engineer",
-		"engineering",
-		"english",
-		"enjoy",
-		"enormous",
-		"enough",
-		"ensign",
-		"ensues",
-		"ensure",
-		"enter",
-		"entered",
-		"entering",
-		"enterprise",
-		"enters",
-		"enthusiastic",
-		"entrance",
-		"entries",
-		"entry",
-		"environment",
-		"environmental",
-		"episode",
-		"equations",
-		"equipment",
-		"equivalent",
-		"error",
-		"erupts",
-		"escape",
-		"escaped",
-		"escaping",
-		"escort",
-		"escorted",
-		"especially",
-		"espionage",
-		"essence",
-		"establish",
-		"established",
-		"establishes",
-		"estimates",
-		"etching",
-		"ethical",
-		"ethically",
-		"ethics",
-		"eugenics",
-		"eulogy",
-		"europa",
-		"eva",
-		"evacuated",
-		"evades",
-		"even",
-		"evening",
-		"event",
-		"events",
-		"eventually",
-		"ever",
-		"everett",
-		"every",
-		"everyday",
-		"everyone",
-		"everything",
-		"evidence",
-		"exact",
-		"exactly",
-		"examination",
-		"examine",
-		"examined",
-		"examines",
-		"example",
-		"examples",
-		"exasperated",
-		"excellent",
-		"except",
-		"exchange",
-		"exchanged",
-		"exchanges",
-		"excited",
-		"excitedly",
-		"exclaiming",
-		"excuse",
-		"excuses",
-		"executed",
-		"executing",
-		"execution",
-		"exercising",
-		"exhausted",
-		"exist",
-		"existed",
-		"existence",
-		"exit",
-		"exited",
-		"exits",
-		"expect",
-		"expecting",
-		"expendable",
-		"experience",
-		"experiences",
-		"experiencing",
-		"experimental",
-		"experiments",
-		"explain",
-		"explaining",
-		"explains",
-		"explanation",
-		"explodes",
-		"exploration",
-		"explore",
-		"explores",
-		"explosion",
-		"explosive",
-		"expose",
-		"exposed",
-		"express",
-		"expresses",
-		"expression",
-		"expressly",
-		"expunge",
-		"extending",
-		"extensive",
-		"extinct",
-		"extinction",
-		"extraction",
-		"extrapolate",
-		"extreme",
-		"extremely",
-		"eye",
-		"eye-view",
-		"eyes",
-		"fabricated",
-		"face",
-		"facilitate",
-		"facility",
-		"facing",
-		"fact",
-		"factor",
-		"facts",
-		"faded",
-		"fail",
-		"failed",
-		"failing",
-		"fails",
-		"failure",
-		"faint",
-		"fair",
-		"fairly",
-		"faith",
-		"fakes",
-		"fall",
-		"falling",
-		"falls",
-		"familiar",
-		"family",
-		"famous",
-		"fangs",
-		"far",
-		"farr",
-		"farrell",
-		"fascinated",
-		"fascinating",
-		"fast",
-		"fast-tracked",
-		"faster",
-		"fat",
-		"father",
-		"fault",
-		"favor",
-		"fearing",
-		"fears",
-		"feasible",
-		"fed",
-		"federation",
-		"feed",
-		"feeding",
-		"feeds",
-		"feel",
-		"feeling",
-		"feelings",
-		"feels",
-		"feet",
-		"feigns",
-		"fell",
-		"fellow",
-		"felt",
-		"fend",
-		"few",
-		"field",
-		"fifteen",
-		"fifty",
-		"fight",
-		"figure",
-		"figured",
-		"figures",
-		"file",
-		"fill",
-		"filled",
-		"fills",
-		"filter",
-		"final",
-		"finally",
-		"find",
-		"finding",
-		"finds",
-		"fine",
-		"finely",
-		"finished",
-		"finishing",
-		"fire",
-		"fired",
-		"fires",
-		"firing",
-		"first",
-		"fist",
-		"fit",
-		"fitzpatrick",
-		"fix",
-		"flagship",
-		"flamboyant",
-		"flame",
-		"flash",
-		"flaw",
-		"fled",
-		"flees",
-		"fleet",
-		"flickers",
-		"flinging",
-		"floating",
-		"floats",
-		"floor",
-		"flop",
-		"flotilla",
-		"flower",
-		"flutter",
-		"flying",
-		"focal",
-		"focus",
-		"focused",
-		"foiled",
-		"follow",
-		"followed",
-		"followers",
-		"following",
-		"follows",
-		"food",
-		"fool",
-		"foot",
-		"for",
-		"forbidden",
-		"force",
-		"forced",
-		"forcefield",
-		"forcefully",
-		"forces",
-		"forcibly",
-		"foresight",
-		"forever",
-		"forgetting",
-		"forgiveness",
-		"forgotten",
-		"form",
-		"formal",
-		"former",
-		"formerly",
-		"formidable",
-		"forthcoming",
-		"fortunately",
-		"fortune",
-		"forward",
-		"found",
-		"foundation",
-		"four",
-		"fraction",
-		"franklin",
-		"frankly",
-		"free",
-		"freeway",
-		"frenzy",
-		"frequency",
-		"frequent",
-		"fresh",
-		"frets",
-		"friend",
-		"friendly",
-		"friendship",
-		"frighten",
-		"frightened",
-		"from",
-		"front",
-		"frontal",
-		"fucking",
-		"fulfill",
-		"full",
-		"fumes",
-		"fun",
-		"functioning",
-		"fungal",
-		"fungi",
-		"fur",
-		"furious",
-		"further",
-		"furthermore",
-		"future",
-		"gabriel",
-		"gaining",
-		"galactic",
-		"galaxies",
-		"galaxy",
-		"galileo",
-		"galley",
-		"gambled",
-		"game",
-		"games",
-		"ganglia",
-		"garnering",
-		"gas",
-		"gasses",
-		"gateway",
-		"gather",
-		"gathering",
-		"gathers",
-		"gav",
-		"gave",
-		"geared",
-		"gem",
-		"gene",
-		"general",
-		"generating",
-		"generation",
-		"genetic",
-		"genius",
-		"genocide",
-		"gentleman",
-		"gently",
-		"georgiou",
-		"germany",
-		"get",
-		"geyser",
-		"ghosts",
-		"giant",
-		"gift",
-		"giggling",
-		"girl",
-		"give",
-		"given",
-		"gives",
-		"giving",
-		"glance",
-		"gleefully",
-		"glenn",
-		"glimpse",
-		"glory",
-		"glowing",
-		"goal",
-		"god",
-		"goes",
-		"going",
-		"gone",
-		"good",
-		"goodnight",
-		"gorged",
-		"gormaganders",
-		"got",
-		"gotten",
-		"grab",
-		"grabs",
-		"gradually",
-		"grain",
-		"grant",
-		"granted",
-		"granting",
-		"grants",
-		"grateful",
-		"grayson",
-		"great",
-		"greatly",
-		"greet",
-		"greetings",
-		"greets",
-		"grief",
-		"grief-stricken",
-		"grieves",
-		"grimes",
-		"grimly",
-		"grip",
-		"groom",
-		"groth",
-		"ground",
-		"grounded",
-		"group",
-		"groups",
-		"grow",
-		"growing",
-		"growls",
-		"grown",
-		"guaranteed",
-		"guarantees",
-		"guardian",
-		"guess",
-		"guest",
-		"guests",
-		"guilt",
-		"guilty",
-		"habit",
-		"hack",
-		"had",
-		"hail",
-		"hails",
-		"half",
-		"half-hour",
-		"halkan",
-		"hall",
-		"halls",
-		"hallway",
-		"hand",
-		"hand-to-hand",
-		"handing",
-		"handle",
-		"handshake",
-		"hansen",
-		"happened",
-		"happening",
-		"happily",
-		"happy",
-		"harcourt",
-		"hard",
-		"hardly",
-		"harmful",
-		"harmless",
-		"harness",
-		"harry",
-		"has",
-		"hatch",
-		"hate",
-		"hates",
-		"have",
-		"having",
-		"head",
-		"headache",
-		"headed",
-		"heading",
-		"heads",
-		"heal",
-		"healed",
-		"health",
-		"hear",
-		"heard",
-		"hearing",
-		"hears",
-		"heart",
-		"heated",
-		"heavy",
-		"height",
-		"heightened",
-		"held",
-		"helical",
-		"hell",
-		"helm",
-		"helmet",
-		"helmsman",
-		"help",
-		"helped",
-		"helps",
-		"henchmen",
-		"her",
-		"here",
-		"hers",
-		"herself",
-		"hesitant",
-		"hesitate",
-		"hesitation",
-		"hide",
-		"hiding",
-		"high",
-		"high-yield",
-		"higher",
-		"highest",
-		"highly",
-		"hikaru",
-		"him",
-		"himself",
-		"hint",
-		"his",
-		"historical",
-		"history",
-		"hit",
-		"hitler",
-		"hits",
-		"hold",
-		"holding",
-		"holds",
-		"hole",
-		"holographic",
-		"homeless",
-		"homeworld",
-		"honesty",
-		"honey",
-		"honor",
-		"honoring",
-		"honors",
-		"hope",
-		"hopes",
-		"horrible",
-		"horror",
-		"horrors",
-		"hospitable",
-		"hospital",
-		"host",
-		"hostile",
-		"hostility",
-		"hot",
-		"hour",
-		"hours",
-		"house",
-		"houses",
-		"how",
-		"however",
-		"hugh",
-		"hull",
-		"human",
-		"humanity",
-		"humans",
-		"humbled",
-		"humiliate",
-		"humiliated",
-		"humming",
-		"humor",
-		"hunched",
-		"hundred",
-		"hundreds",
-		"hunger",
-		"hungry",
-		"hunt",
-		"hunting",
-		"hurt",
-		"hurts",
-		"husband",
-		"hydro",
-		"hypo",
-		"hypospray",
-		"hypothermia",
-		"i-chaya",
-		"ice",
-		"idea",
-		"idealistic",
-		"ideally",
-		"identified",
-		"identifies",
-		"identify",
-		"identifying",
-		"identity",
-		"ignorance",
-		"ignore",
-		"ignoring",
-		"iii",
-		"iks",
-		"ilari",
-		"ill",
-		"illegal",
-		"illogic",
-		"illusory",
-		"image",
-		"images",
-		"imitate",
-		"immediate",
-		"imminent",
-		"impact",
-		"impacting",
-		"impaling",
-		"impending",
-		"impersonate",
-		"implant",
-		"implication",
-		"importance",
-		"important",
-		"impossible",
-		"impressed",
-		"impression",
-		"imprisoned",
-		"improvement",
-		"improving",
-		"impulse",
-		"inauguration",
-		"incapacitates",
-		"incident",
-		"include",
-		"included",
-		"including",
-		"inconclusive",
-		"incorrect",
-		"increase",
-		"increasingly",
-		"incredulous",
-		"indeed",
-		"indestructible",
-		"index",
-		"indicates",
-		"indicating",
-		"individual",
-		"induce",
-		"inert",
-		"inevitable",
-		"inexplicable",
-		"infamous",
-		"infers",
-		"inflexible",
-		"influence",
-		"inform",
-		"information",
-		"informed",
-		"informing",
-		"informs",
-		"ing",
-		"inhabit",
-		"inhale",
-		"inhaled",
-		"inherent",
-		"initial",
-		"initiate",
-		"initiated",
-		"initiates",
-		"initiating",
-		"inject",
-		"injected",
-		"injection",
-		"injuries",
-		"injuring",
-		"injury",
-		"inmates",
-		"innumerable",
-		"input",
-		"inquires",
-		"inquiry",
-		"insect",
-		"inside",
-		"insist",
-		"insists",
-		"inspecting",
-		"installed",
-		"instantly",
-		"instead",
-		"instincts",
-		"instructed",
-		"instructions",
-		"instructors",
-		"instructs",
-		"instrumentation",
-		"instruments",
-		"insufficient",
-		"insulted",
-		"insulting",
-		"intact",
-		"integrity",
-		"intellect",
-		"intelligence",
-		"intend",
-		"intended",
-		"intends",
-		"intent",
-		"intentions",
-		"interactions",
-		"intercept",
-		"intercepts",
-		"intercom",
-		"interdimensional",
-		"interest",
-		"interested",
-		"interesting",
-		"interface",
-		"interference",
-		"intergalactic",
-		"interior",
-		"interphase",
-		"interphasic",
-		"interplanetary",
-		"interpret",
-		"interrogates",
-		"interrupted",
-		"interruption",
-		"interrupts",
-		"interval",
-		"intervals",
-		"intervene",
-		"into",
-		"intones",
-		"intricately-designed",
-		"intrigued",
-		"introduce",
-		"introduced",
-		"introducing",
-		"intruder",
-		"intrusion",
-		"intuition",
-		"investigate",
-		"investigated",
-		"investigates",
-		"invincible",
-		"invisibility",
-		"invitation",
-		"invited",
-		"invites",
-		"invokes",
-		"involve",
-		"involved",
-		"iowa",
-		"iss",
-		"issue",
-		"issued",
-		"issues",
-		"issuing",
-		"items",
-		"its",
-		"itself",
-		"jacket",
-		"jammed",
-		"janice",
-		"jealous",
-		"jealousy",
-		"jefferies",
-		"jefferson",
-		"jeopardize",
-		"jeopardizes",
-		"jim",
-		"joann",
-		"job",
-		"jobs",
-		"john",
-		"join",
-		"joined",
-		"jokes",
-		"jonathan",
-		"jones",
-		"josephs",
-		"journals",
-		"journeys",
-		"joy",
-		"jpg",
-		"judder",
-		"jump",
-		"jumped",
-		"jumping",
-		"jumps",
-		"just",
-		"justice",
-		"justifies",
-		"kahless",
-		"kamikaze",
-		"kasseelian",
-		"katrina",
-		"keeler",
-		"keen",
-		"keep",
-		"keeping",
-		"kelpians",
-		"kelpien",
-		"kelpiens",
-		"kenner",
-		"keyla",
-		"kicked",
-		"kidnap",
-		"kill",
-		"killed",
-		"killers",
-		"killing",
-		"kills",
-		"kilometer",
-		"kilometers",
-		"kind",
-		"kings",
-		"kirk",
-		"kirk-spock",
-		"kiss",
-		"kissing",
-		"klaxon",
-		"klingon",
-		"klingons",
-		"knew",
-		"knife",
-		"knocked",
-		"knocking",
-		"knocks",
-		"knowing",
-		"knowledge",
-		"known",
-		"knows",
-		"koloth",
-		"komack",
-		"koon-ut-kal-if-fee",
-		"korax",
-		"kowski",
-		"kyle",
-		"lab",
-		"laboratory",
-		"lack",
-		"ladder",
-		"lamb",
-		"laments",
-		"land",
-		"landing",
-		"landry",
-		"lands",
-		"language",
-		"languages",
-		"large",
-		"larger",
-		"last",
-		"late",
-		"later",
-		"latter",
-		"laugh",
-		"laughed",
-		"laughing",
-		"laughter",
-		"launch",
-		"launches",
-		"law",
-		"lay",
-		"lead",
-		"leaders",
-		"leadership",
-		"leading",
-		"leads",
-		"leans",
-		"learn",
-		"learned",
-		"learning",
-		"learns",
-		"least",
-		"leave",
-		"leaves",
-		"leaving",
-		"lectures",
-		"led",
-		"left",
-		"leg",
-		"legacy",
-		"legend",
-		"leland",
-		"lennon",
-		"leonard",
-		"less",
-		"lesser",
-		"lets",
-		"letting",
-		"level",
-		"leveling",
-		"levels",
-		"liar",
-		"liberty",
-		"library",
-		"lie",
-		"lies",
-		"life",
-		"lifeform",
-		"lifeless",
-		"lifelong",
-		"light",
-		"light-skinned",
-		"lighting",
-		"lightning",
-		"lights",
-		"like",
-		"liked",
-		"likely",
-		"likes",
-		"limited",
-		"limits",
-		"line",
-		"liner",
-		"linked",
-		"liquid",
-		"lirpa",
-		"list",
-		"listen",
-		"listens",
-		"listing",
-		"literally",
-		"litter",
-		"little",
-		"live",
-		"lives",
-		"living",
-		"loaded",
-		"local",
-		"located",
-		"locating",
-		"location",
-		"lock",
-		"locked",
-		"locks",
-		"log",
-		"logic",
-		"logical",
-		"logically",
-		"logs",
-		"long",
-		"long-range",
-		"longer",
-		"look",
-		"looking",
-		"lookout",
-		"looks",
-		"loop",
-		"loot",
-		"lorca",
-		"lose",
-		"losing",
-		"losses",
-		"lost",
-		"lot",
-		"loudly",
-		"love",
-		"loved",
-		"loves",
-		"loving",
-		"low",
-		"luck",
-		"lucky",
-		"lunch",
-		"lure",
-		"lurry",
-		"luxury",
-		"lying",
-		"machine",
-		"machinery",
-		"maddox",
-		"madly",
-		"madness",
-		"mahna",
-		"main",
-		"maintain",
-		"maintained",
-		"maintains",
-		"make",
-		"makes",
-		"makeup",
-		"making",
-		"malaysia",
-		"male",
-		"malfunctioned",
-		"man",
-		"manage",
-		"managed",
-		"manager",
-		"manages",
-		"maneuvers",
-		"maniac",
-		"manic",
-		"mankind",
-		"manner",
-		"manning",
-		"manual",
-		"manually",
-		"many",
-		"map",
-		"market",
-		"markings",
-		"marlena",
-		"marriage",
-		"marriages",
-		"marry",
-		"mars",
-		"martine",
-		"martine-tomlinson",
-		"martyr",
-		"masses",
-		"massive",
-		"matching",
-		"mate",
-		"material",
-		"materialize",
-		"materialized",
-		"materializing",
-		"materials",
-		"mathematical",
-		"mating",
-		"matt",
-		"matter",
-		"maximum",
-		"may",
-		"maybe",
-		"mccoy",
-		"meal",
-		"mean",
-		"meaning",
-		"means",
-		"meant",
-		"meantime",
-		"meanwhile",
-		"measures",
-		"meat",
-		"medal",
-		"medical",
-		"meditate",
-		"melds",
-		"members",
-		"memories",
-		"mempa",
-		"men",
-		"mending",
-		"mentions",
-		"mentor",
-		"mercy",
-		"merely",
-		"mess",
-		"message",
-		"messages",
-		"messed",
-		"met",
-		"metabolism",
-		"metal",
-		"metal-cased",
-		"methodically",
-		"methods",
-		"metrics",
-		"michael",
-		"mickey",
-		"microscopic",
-		"mid",
-		"midst",
-		"might",
-		"miles",
-		"military",
-		"milk",
-		"milky",
-		"million",
-		"millions",
-		"mimics",
-		"mind",
-		"mine",
-		"mines",
-		"mining",
-		"ministrations",
-		"minor",
-		"mintaka",
-		"minute",
-		"mirror",
-		"mirrors",
-		"missing",
-		"mission",
-		"missions",
-		"mistress",
-		"misused",
-		"moaning",
-		"mode",
-		"modifications",
-		"mollify",
-		"molor",
-		"moment",
-		"momentarily",
-		"momentary",
-		"money",
-		"moniker",
-		"monitor",
-		"monitored",
-		"monitoring",
-		"montgomery",
-		"month",
-		"months",
-		"moon",
-		"moons",
-		"moral",
-		"morale",
-		"more",
-		"moreau",
-		"morning",
-		"mortal",
-		"most",
-		"motes",
-		"mother",
-		"motivated",
-		"motivational",
-		"mourning",
-		"mouth",
-		"move",
-		"movement",
-		"movements",
-		"moves",
-		"movie",
-		"moving",
-		"much",
-		"much-larger",
-		"multidimensonal",
-		"multiplying",
-		"murder",
-		"murdered",
-		"murderers",
-		"muscles",
-		"muses",
-		"music",
-		"musician",
-		"must",
-		"mutilated",
-		"mutineer",
-		"mutineers",
-		"mutiny",
-		"mutually",
-		"mycelial",
-		"mycelium",
-		"mysterious",
-		"mystery",
-		"name",
-		"named",
-		"namely",
-		"names",
-		"nap",
-		"narrowed",
-		"nasty",
-		"native",
-		"natives",
-		"natural",
-		"nature",
-		"nausicaan",
-		"naval",
-		"navigate",
-		"navigation",
-		"navigational",
-		"navigator",
-		"navy",
-		"nazi",
-		"nazi-esque",
-		"nazis",
-		"ndash",
-		"near",
-		"nearby",
-		"nearest",
-		"nearly",
-		"neck",
-		"need",
-		"needed",
-		"needing",
-		"needle",
-		"needs",
-		"negatively",
-		"negotiations",
-		"neither",
-		"nerve",
-		"nervous",
-		"nervousness",
-		"network",
-		"network-constructed",
-		"neural",
-		"neuro-paralyzer",
-		"never",
-		"new",
-		"newly-built",
-		"news",
-		"newspaper",
-		"next",
-		"night",
-		"nightmare",
-		"nights",
-		"nilz",
-		"nods",
-		"noise",
-		"non",
-		"non-human",
-		"non-humanoid",
-		"none",
-		"nonetheless",
-		"nor",
-		"not",
-		"notable",
-		"note",
-		"notes",
-		"nothing",
-		"notice",
-		"noticed",
-		"notified",
-		"notifies",
-		"noting",
-		"now",
-		"now-former",
-		"nowhere",
-		"nuclear",
-		"nugget",
-		"numb",
-		"number",
-		"numerous",
-		"nurse",
-		"nutrients",
-		"nyota",
-		"object",
-		"objections",
-		"objective",
-		"objects",
-		"oblivious",
-		"observation",
-		"observed",
-		"observes",
-		"observing",
-		"occur",
-		"occurred",
-		"octopus",
-		"odd",
-		"off-guard",
-		"off-line",
-		"offer",
-		"offering",
-		"offers",
-		"offhand",
-		"office",
-		"officer",
-		"officers",
-		"offices",
-		"officiating",
-		"offspring",
-		"often",
-		"old",
-		"ominously",
-		"on-line",
-		"once",
-		"oncoming",
-		"one",
-		"ones",
-		"only",
-		"onto",
-		"open",
-		"opening",
-		"opens",
-		"opera",
-		"operate",
-		"operational",
-		"operations",
-		"operative",
-		"operatives",
-		"operator",
-		"ophiucus",
-		"opinion",
-		"opinions",
-		"opponents",
-		"opportunities",
-		"opposing",
-		"optimism",
-		"optimistic",
-		"options",
-		"orbiting",
-		"order",
-		"ordered",
-		"ordering",
-		"orders",
-		"organian",
-		"organic",
-		"organism",
-		"organized",
-		"organs",
-		"original",
-		"origins",
-		"orion",
-		"orions",
-		"ostensibly",
-		"other",
-		"others",
-		"ourselves",
-		"out",
-		"outburst",
-		"outcome",
-		"outer",
-		"outfit",
-		"outnumbered",
-		"outpost",
-		"outposts",
-		"outraged",
-		"outside",
-		"outworlder",
-		"over",
-		"over-breed",
-		"overall",
-		"overcome",
-		"overdose",
-		"overhead",
-		"overhear",
-		"overhears",
-		"overload",
-		"overloads",
-		"overpowers",
-		"overridden",
-		"overseeing",
-		"overthrow",
-		"overwhelmed",
-		"owes",
-		"owosekun",
-		"pacifist",
-		"pacifists",
-		"pack",
-		"pad",
-		"padd",
-		"pads",
-		"pain",
-		"pained",
-		"pair",
-		"pairing",
-		"palace",
-		"panic",
-		"panspermia",
-		"parallel",
-		"paralyzed",
-		"parameters",
-		"pardon",
-		"parents",
-		"paris",
-		"parroted",
-		"parsec",
-		"part",
-		"participate",
-		"particle",
-		"particular",
-		"particularly",
-		"parties",
-		"partly-open",
-		"partner",
-		"partners",
-		"parts",
-		"pass",
-		"passed",
-		"passersby",
-		"passes",
-		"passing",
-		"passionately",
-		"past",
-		"path",
-		"patients",
-		"pattern",
-		"pauses",
-		"pavel",
-		"peace",
-		"peaceful",
-		"peculiar",
-		"penetrate",
-		"people",
-		"per",
-		"perfection",
-		"perfectly",
-		"perform",
-		"performance",
-		"perimeter",
-		"period",
-		"permanent",
-		"permeates",
-		"permission",
-		"permit",
-		"permitted",
-		"perpetrator",
-		"perplexed",
-		"persecute",
-		"persists",
-		"person",
-		"personal",
-		"personnel",
-		"perspective",
-		"persuade",
-		"pet",
-		"petition",
-		"phase",
-		"phaser",
-		"phasers",
-		"phenomenon",
-		"philippa",
-		"photograph",
-		"photon",
-		"phrase",
-		"physical",
-		"physically",
-		"physics",
-		"physiology",
-		"pick",
-		"picked",
-		"picking",
-		"picks",
-		"picture",
-		"piezoelectricity",
-		"piezolectric",
-		"pile",
-		"pilot",
-		"pinch",
-		"pinpointed",
-		"pirate",
-		"place",
-		"placed",
-		"placing",
-		"plak",
-		"plan",
-		"planet",
-		"planetary",
-		"planetoid",
-		"planets",
-		"planned",
-		"plasma",
-		"plateau",
-		"platform",
-		"platform-like",
-		"play",
-		"played",
-		"playfully",
-		"playing",
-		"plays",
-		"plea",
-		"plead",
-		"pleads",
-		"pleased",
-		"pleases",
-		"pleasing",
-		"plomeek",
-		"plot",
-		"plots",
-		"pna-episode",
-		"pocket",
-		"pod",
-		"point",
-		"pointedly",
-		"pointing",
-		"points",
-		"poison",
-		"poisoned",
-		"police",
-		"policeman",
-		"politically",
-		"pon",
-		"ponder",
-		"ponders",
-		"pong",
-		"poorly",
-		"populated",
-		"population",
-		"port",
-		"portable",
-		"portal",
-		"position",
-		"posits",
-		"possibility",
-		"possible",
-		"possibly",
-		"post",
-		"posthumously",
-		"posts",
-		"posturing",
-		"potential",
-		"pouring",
-		"poverty-stricken",
-		"power",
-		"powered",
-		"powerful",
-		"pre-warp",
-		"precipitating",
-		"precise",
-		"predator",
-		"predators",
-		"prediction",
-		"predictive",
-		"preemptive",
-		"preferring",
-		"prefers",
-		"pregnant",
-		"prematurely",
-		"preoccupied",
-		"prepare",
-		"prepared",
-		"prepares",
-		"preparing",
-		"presence",
-		"present",
-		"present-day",
-		"presented",
-		"presents",
-		"preserve",
-		"president",
-		"pressed",
-		"presses",
-		"pressing",
-		"presumably",
-		"pretending",
-		"prevent",
-		"prevented",
-		"preventing",
-		"prevention",
-		"prevents",
-		"previous",
-		"price",
-		"pride",
-		"primary",
-		"prime",
-		"principal",
-		"principals",
-		"principles",
-		"print",
-		"prior",
-		"priority",
-		"prison",
-		"prisoner",
-		"prisoners",
-		"private",
-		"privately",
-		"privilege",
-		"prize",
-		"probability",
-		"probably",
-		"procedure",
-		"proceed",
-		"proceeding",
-		"process",
-		"produced",
-		"production",
-		"profitable",
-		"progenitors",
-		"program",
-		"programming",
-		"prohibits",
-		"project",
-		"promised",
-		"promises",
-		"promising",
-		"promoted",
-		"promotion",
-		"promptly",
-		"proof",
-		"propel",
-		"prophecy",
-		"proprietor",
-		"propulsion",
-		"prosecute",
-		"protecting",
-		"protection",
-		"protective",
-		"protest",
-		"protests",
-		"protocol",
-		"prototaxites",
-		"prove",
-		"proven",
-		"provide",
-		"providing",
-		"provoke",
-		"provoking",
-		"proximity",
-		"psychiatrist",
-		"psycho",
-		"public",
-		"pulled",
-		"pulls",
-		"punch",
-		"punches",
-		"punching",
-		"punished",
-		"punishment",
-		"purchases",
-		"purpose",
-		"pursued",
-		"pursues",
-		"pursuing",
-		"push",
-		"pushes",
-		"pushing",
-		"put",
-		"puts",
-		"putting",
-		"puzzled",
-		"quadrotriticale",
-		"quantity",
-		"quantum",
-		"quarrel",
-		"quarters",
-		"queries",
-		"question",
-		"questioned",
-		"questioning",
-		"questions",
-		"quick",
-		"quickly",
-		"quiet",
-		"quietly",
-		"quite",
-		"quizzes",
-		"quizzing",
-		"quotes",
-		"race",
-		"races",
-		"radiation",
-		"radio",
-		"raider",
-		"raiders",
-		"raise",
-		"raised",
-		"ramifications",
-		"rammed",
-		"rampant",
-		"rand",
-		"range",
-		"rank",
-		"rapid",
-		"rapidly",
-		"rather",
-		"reach",
-		"reached",
-		"reaches",
-		"reaching",
-		"react",
-		"reacting",
-		"reaction",
-		"reacts",
-		"read",
-		"readings",
-		"readout",
-		"ready",
-		"real",
-		"reality",
-		"realization",
-		"realized",
-		"realizes",
-		"realizing",
-		"really",
-		"reappeared",
-		"reappearing",
-		"reappears",
-		"reason",
-		"reasoning",
-		"reassured",
-		"reassures",
-		"rebellion",
-		"rebuffed",
-		"rebukes",
-		"recalling",
-		"recalls",
-		"receive",
-		"received",
-		"receives",
-		"recent",
-		"reception",
-		"recites",
-		"reckless",
-		"recognizing",
-		"recommends",
-		"reconcile",
-		"reconsider",
-		"recording",
-		"recounts",
-		"recover",
-		"recovered",
-		"recovering",
-		"recovery",
-		"recreation",
-		"red",
-		"red-handed",
-		"redacted",
-		"redeeming",
-		"redirecting",
-		"reduce",
-		"reduced",
-		"reducing",
-		"reemerge",
-		"reentering",
-		"reference",
-		"referencing",
-		"referred",
-		"referring",
-		"reflect",
-		"reflexively",
-		"refreshing",
-		"refused",
-		"refuses",
-		"refusing",
-		"regains",
-		"regard",
-		"regarding",
-		"regards",
-		"region",
-		"regret",
-		"regretfully",
-		"regrettable",
-		"regulan",
-		"regularly",
-		"regulation",
-		"regulations",
-		"regulus",
-		"rehabilitation",
-		"rehydrate",
-		"reinforcements",
-		"reiterate",
-		"related",
-		"relation",
-		"relationship",
-		"relationships",
-		"relatives",
-		"relax",
-		"relay",
-		"relayed",
-		"relays",
-		"release",
-		"released",
-		"releases",
-		"relief",
-		"relieve",
-		"relinquish",
-		"relinquishes",
-		"reluctantly",
-		"remain",
-		"remains",
-		"remark",
-		"remarking",
-		"remarks",
-		"remastered",
-		"remember",
-		"remembers",
-		"remind",
-		"reminded",
-		"reminder",
-		"reminding",
-		"remove",
-		"removed",
-		"removing",
-		"render",
-		"rendering",
-		"repaired",
-		"repairs",
-		"repeatedly",
-		"repeats",
-		"replay",
-		"replication",
-		"replies",
-		"reply",
-		"report",
-		"reports",
-		"represent",
-		"reprieve",
-		"reproaches",
-		"reproduction",
-		"reproductive",
-		"reputation",
-		"request",
-		"requests",
-		"require",
-		"required",
-		"requirement",
-		"requires",
-		"requiring",
-		"rescue",
-		"rescued",
-		"rescuing",
-		"research",
-		"resemblance",
-		"resemble",
-		"resembling",
-		"reservations",
-		"reset",
-		"resetting",
-		"resignedly",
-		"resist",
-		"resisting",
-		"resort",
-		"resources",
-		"respect",
-		"respectable",
-		"respected",
-		"respond",
-		"responded",
-		"responding",
-		"responds",
-		"response",
-		"responsibility",
-		"rest",
-		"restart",
-		"restless",
-		"restored",
-		"restrained",
-		"restrains",
-		"restraints",
-		"result",
-		"resulted",
-		"resulting",
-		"resume",
-		"resumed",
-		"resumes",
-		"resuscitation",
-		"retreats",
-		"retrieve",
-		"retrieving",
-		"retrospect",
-		"return",
-		"returned",
-		"returning",
-		"returns",
-		"reunite",
-		"reunited",
-		"reveal",
-		"revealed",
-		"revealing",
-		"reveals",
-		"revenge",
-		"reverse",
-		"review",
-		"revive",
-		"revived",
-		"revives",
-		"revolt",
-		"revolution",
-		"revolutionary",
-		"rhys",
-		"rich",
-		"rid",
-		"ride",
-		"rifle",
-		"rifles",
-		"right",
-		"rightful",
-		"rights",
-		"ripples",
-		"rise",
-		"risk",
-		"risking",
-		"risks",
-		"ritual",
-		"robbing",
-		"robert",
-		"rodent",
-		"role",
-		"roman",
-		"roman-like",
-		"romulan",
-		"romulans",
-		"romulus",
-		"room",
-		"roommate",
-		"roosevelt",
-		"roots",
-		"rotating",
-		"rotation",
-		"round",
-		"routing",
-		"ruin",
-		"ruined",
-		"rules",
-		"run",
-		"running",
-		"runs",
-		"rupture",
-		"rush",
-		"ruthlessly",
-		"sabotage",
-		"sac",
-		"sacred",
-		"sacrificed",
-		"sadly",
-		"safe",
-		"safely",
-		"safety",
-		"said",
-		"sales",
-		"saliva",
-		"salmon",
-		"salt",
-		"salute",
-		"salutes",
-		"same",
-		"sample",
-		"sandwich",
-		"sarcastically",
-		"sarcophagi",
-		"sarcophagus",
-		"sarcophagus-like",
-		"sarek",
-		"saru",
-		"savage",
-		"save",
-		"saved",
-		"saves",
-		"saving",
-		"saw",
-		"say",
-		"saying",
-		"says",
-		"scale",
-		"scan",
-		"scanning",
-		"scans",
-		"scattering",
-		"scene",
-		"scepter",
-		"scheme",
-		"science",
-		"scientific",
-		"scientist",
-		"scoff",
-		"scott",
-		"scotty",
-		"scow",
-		"scramble",
-		"scrap",
-		"screaming",
-		"screams",
-		"screen",
-		"scrutinize",
-		"sculpture-like",
-		"scurry",
-		"seal",
-		"search",
-		"seat",
-		"seating",
-		"secondary",
-		"seconds",
-		"secret",
-		"section",
-		"sector",
-		"secure",
-		"secured",
-		"security",
-		"seductive",
-		"see",
-		"seeing",
-		"seek",
-		"seeking",
-		"seeks",
-		"seem",
-		"seemed",
-		"seeming",
-		"seemingly",
-		"seems",
-		"seen",
-		"sees",
-		"sehlat",
-		"self-defense",
-		"self-destruct",
-		"sell",
-		"sending",
-		"sends",
-		"sense",
-		"senses",
-		"sensing",
-		"sensitive",
-		"sensitivities",
-		"sensitivity",
-		"sensor",
-		"sensors",
-		"sent",
-		"sentence",
-		"sentenced",
-		"sentiment",
-		"sentimental",
-		"sequences",
-		"sequitur",
-		"series",
-		"serious",
-		"serve",
-		"serves",
-		"serving",
-		"session",
-		"set",
-		"setting",
-		"seven",
-		"several",
-		"severe",
-		"severed",
-		"severely",
-		"shadows",
-		"shake",
-		"shaken",
-		"shakes",
-		"shall",
-		"shame",
-		"shameful",
-		"shape",
-		"share",
-		"shared",
-		"shares",
-		"sharply",
-		"shaven",
-		"she",
-		"sheepish",
-		"shenzhou",
-		"sherman",
-		"shield",
-		"shielded",
-		"shielding",
-		"shields",
-		"shift",
-		"shifting",
-		"shifts",
-		"ship",
-		"ships",
-		"shock",
-		"shocked",
-		"shocks",
-		"shoot",
-		"shoots",
-		"shore",
-		"short",
-		"shortly",
-		"shot",
-		"should",
-		"shoulder",
-		"shouts",
-		"shoves",
-		"show",
-		"shown",
-		"shows",
-		"shriek",
-		"shrine",
-		"shuddering",
-		"shudders",
-		"shushing",
-		"shut",
-		"shuts",
-		"shutter",
-		"shuttered",
-		"shuttle",
-		"shuttlebay",
-		"shuttlecraft",
-		"shuttlepod",
-		"sickbay",
-		"side",
-		"sidearm",
-		"sides",
-		"sidewalk",
-		"siding",
-		"sight",
-		"sign",
-		"signal",
-		"signals",
-		"signatures",
-		"signs",
-		"silence",
-		"silenced",
-		"silences",
-		"silent",
-		"similar",
-		"simple",
-		"simply",
-		"simulate",
-		"simulation",
-		"simultaneously",
-		"since",
-		"single",
-		"sister",
-		"sit",
-		"sitting",
-		"situation",
-		"six",
-		"six-inch",
-		"skeletal",
-		"skeptical",
-		"skepticism",
-		"slack",
-		"slams",
-		"slaps",
-		"sleep",
-		"slides",
-		"slight",
-		"slip",
-		"slow",
-		"slowly",
-		"slows",
-		"slump",
-		"slumps",
-		"small",
-		"smaller",
-		"smarter",
-		"smashes",
-		"smell",
-		"smile",
-		"smiles",
-		"smiling",
-		"snapping",
-		"sneak",
-		"sneers",
-		"snoring",
-		"sobbing",
-		"softly",
-		"sol",
-		"solace",
-		"solar",
-		"sold",
-		"soldier",
-		"sole",
-		"solitude",
-		"somber",
-		"somberly",
-		"some",
-		"somehow",
-		"someone",
-		"something",
-		"sometime",
-		"sometimes",
-		"somewhere",
-		"son",
-		"soon",
-		"soothing",
-		"sorry",
-		"sort",
-		"sound",
-		"sounds",
-		"soup",
-		"source",
-		"spa",
-		"space",
-		"spaceborne",
-		"spacesuit",
-		"sparing",
-		"spatial",
-		"speak",
-		"speaking",
-		"speaks",
-		"special",
-		"specialist",
-		"specially",
-		"species",
-		"speculated",
-		"speculates",
-		"speech",
-		"speechless",
-		"speed",
-		"speeds",
-		"speirein",
-		"spend",
-		"spican",
-		"spies",
-		"splendid",
-		"split",
-		"spock",
-		"sporal",
-		"spore",
-		"spores",
-		"spot",
-		"sprang",
-		"spread",
-		"spreads",
-		"sprint",
-		"spt",
-		"spur",
-		"spy",
-		"stabbed",
-		"stage",
-		"staggers",
-		"staircase",
-		"staments",
-		"stamets",
-		"stand",
-		"standard",
-		"standby",
-		"standing",
-		"stands",
-		"starbase",
-		"stardate",
-		"stares",
-		"starfleet",
-		"starfleet-related",
-		"stars",
-		"starship",
-		"start",
-		"started",
-		"starting",
-		"starts",
-		"starved",
-		"state",
-		"states",
-		"station",
-		"stations",
-		"status",
-		"steal",
-		"steals",
-		"stella",
-		"stellaviatori",
-		"step",
-		"stepping",
-		"steps",
-		"steven",
-		"stick",
-		"stiles",
-		"still",
-		"stolen",
-		"stone",
-		"stonn",
-		"stop",
-		"stopped",
-		"stopping",
-		"stops",
-		"storage",
-		"storm",
-		"storms",
-		"story",
-		"straal",
-		"strain",
-		"stranded",
-		"stranding",
-		"strange",
-		"stranger",
-		"strangles",
-		"strapped",
-		"strategy",
-		"street",
-		"streets",
-		"strength",
-		"strenuous",
-		"stress",
-		"stresses",
-		"strike",
-		"strikes",
-		"striking",
-		"strip",
-		"stripped",
-		"strong",
-		"struck",
-		"struggle",
-		"struggles",
-		"stuart",
-		"stuck",
-		"study",
-		"stuff",
-		"stuffed",
-		"stumbles",
-		"stunned",
-		"stupid",
-		"subdue",
-		"subdued",
-		"subdues",
-		"subjugating",
-		"submitting",
-		"subsequently",
-		"subsiding",
-		"subspace",
-		"substitute",
-		"subtly",
-		"succeed",
-		"succeeded",
-		"succeeding",
-		"succeeds",
-		"successes",
-		"successful",
-		"successfully",
-		"successive",
-		"such",
-		"sudden",
-		"suddenly",
-		"suddently",
-		"suffered",
-		"suffering",
-		"sufficiently",
-		"suffocation",
-		"suggesting",
-		"suggests",
-		"suicide",
-		"suit",
-		"suited",
-		"suites",
-		"sulu",
-		"sum",
-		"summarizes",
-		"summon",
-		"summoned",
-		"sun",
-		"sunday",
-		"suns",
-		"superior",
-		"superiority",
-		"superiors",
-		"supernova",
-		"supplemental",
-		"supply",
-		"support",
-		"suppose",
-		"supposed",
-		"suppresses",
-		"suppressing",
-		"sure",
-		"surface",
-		"surgeon",
-		"surgery",
-		"surgical",
-		"surmises",
-		"surprise",
-		"surprised",
-		"surprises",
-		"surround",
-		"surrounded",
-		"surrounding",
-		"surrounds",
-		"survival",
-		"survived",
-		"survives",
-		"survivor",
-		"survivors",
-		"suspect",
-		"suspects",
-		"sustain",
-		"sustained",
-		"suture",
-		"suus",
-		"swamping",
-		"swaps",
-		"sweeping",
-		"swings",
-		"sword",
-		"sylvia",
-		"symbiotic",
-		"synthesizing",
-		"system",
-		"systematically",
-		"systems",
-		"t-negative",
-		"table",
-		"tactical",
-		"tail",
-		"take",
-		"takes",
-		"taking",
-		"talk",
-		"talking",
-		"tanks",
-		"tantalus",
-		"tape",
-		"tardigrade",
-		"target",
-		"targeted",
-		"targets",
-		"tartigrade",
-		"task",
-		"tasked",
-		"taught",
-		"taunts",
-		"tax",
-		"tea",
-		"teach",
-		"teachings",
-		"team",
-		"teams",
-		"tearfully",
-		"tease",
-		"teaser",
-		"technical",
-		"technique",
-		"technology",
-		"teddy",
-		"teeth",
-		"telemetry",
-		"telepathic",
-		"telescope",
-		"tell",
-		"tellarite",
-		"telling",
-		"tells",
-		"tellun",
-		"temporal",
-		"temporary",
-		"ten",
-		"tendrils",
-		"tends",
-		"term",
-		"terminal",
-		"terminates",
-		"terminology",
-		"terms",
-		"terran",
-		"terrible",
-		"territory",
-		"test",
-		"tested",
-		"tests",
-		"tether",
-		"than",
-		"thanking",
-		"thanks",
-		"tharn",
-		"that",
-		"the",
-		"theft",
-		"their",
-		"theirs",
-		"thelev",
-		"them",
-		"themselves",
-		"then",
-		"theoretical",
-		"theorizes",
-		"theorizing",
-		"theory",
-		"therapy",
-		"there",
-		"thereby",
-		"these",
-		"they",
-		"thing",
-		"things",
-		"think",
-		"thinking",
-		"thinks",
-		"third",
-		"thirteen",
-		"this",
-		"those",
-		"though",
-		"thoughtfully",
-		"thousand",
-		"threat",
-		"threatening",
-		"threatens",
-		"threats",
-		"three",
-		"throat",
-		"throne-like",
-		"through",
-		"throughout",
-		"throw",
-		"throwing",
-		"thrown",
-		"throws",
-		"thruster",
-		"thrusters",
-		"thumb",
-		"tie",
-		"till",
-		"tilts",
-		"time",
-		"timeline",
-		"times",
-		"tired",
-		"title",
-		"toast",
-		"today",
-		"together",
-		"told",
-		"tolerated",
-		"toll",
-		"tons",
-		"tonsillectomy",
-		"too",
-		"took",
-		"tool",
-		"toolbox",
-		"tools",
-		"top",
-		"top-secret",
-		"torchbearer",
-		"torpedo",
-		"torture",
-		"tortured",
-		"tosses",
-		"total",
-		"totally",
-		"touch",
-		"touches",
-		"tour",
-		"touts",
-		"tow",
-		"toward",
-		"towards",
-		"trace",
-		"track",
-		"tractor",
-		"trade",
-		"traditional",
-		"traditions",
-		"traffic",
-		"trail",
-		"trained",
-		"training",
-		"trains",
-		"trait",
-		"transfer",
-		"transference",
-		"transferred",
-		"transfixed",
-		"transfusion",
-		"transit",
-		"transmission",
-		"transmissions",
-		"transmitter",
-		"transport",
-		"transporter",
-		"transporting",
-		"transports",
-		"transpose",
-		"trapped",
-		"travel",
-		"traveling",
-		"travels",
-		"treating",
-		"treatment",
-		"treaty",
-		"tribble",
-		"tribbles",
-		"trick",
-		"tricking",
-		"tried",
-		"tries",
-		"trill",
-		"trip",
-		"trouble",
-		"truck",
-		"true",
-		"trust",
-		"trusted",
-		"truth",
-		"trying",
-		"tube",
-		"tubes",
-		"turbolift",
-		"turn",
-		"turned",
-		"turning",
-		"turns",
-		"twelve",
-		"twelve-hour",
-		"twenty",
-		"twenty-seven",
-		"twenty-three",
-		"twice",
-		"twisted",
-		"two",
-		"two-way",
-		"tyler",
-		"type",
-		"typically",
-		"tyranny",
-		"uhura",
-		"unable",
-		"unadorned",
-		"unannounced",
-		"unanticipated",
-		"unauthorized",
-		"unbelievably",
-		"uncaring",
-		"uncertain",
-		"unchanged",
-		"uncharacteristically",
-		"uncivilized",
-		"uncle",
-		"uncomfortable",
-		"unconscious",
-		"unconvinced",
-		"uncooperative",
-		"uncovered",
-		"uncrewed",
-		"under",
-		"undergoing",
-		"undersecretary",
-		"understand",
-		"understanding",
-		"understands",
-		"understood",
-		"undertaking",
-		"undetected",
-		"unease",
-		"uneasily",
-		"unexplored",
-		"unfazed",
-		"unforgettable",
-		"unfortunate",
-		"unfortunately",
-		"unidentified",
-		"uniform",
-		"uniforms",
-		"unify",
-		"uninhabitable",
-		"uninterested",
-		"unique",
-		"united",
-		"universe",
-		"universes",
-		"unknown",
-		"unless",
-		"unlikely",
-		"unlocking",
-		"unnecessary",
-		"unnoticed",
-		"unpacks",
-		"unpleasantness",
-		"unprecedented",
-		"unpredictable",
-		"unprincipled",
-		"unsafe",
-		"unseen",
-		"unsurprised",
-		"unthinkable",
-		"until",
-		"unusual",
-		"unwittingly",
-		"unworthy",
-		"updates",
-		"upheaval",
-		"upper",
-		"upset",
-		"urges",
-		"use",
-		"used",
-		"useful",
-		"useless",
-		"uses",
-		"usually",
-		"vacant",
-		"vacation",
-		"vacuum",
-		"vain",
-		"value",
-		"values",
-		"vapor",
-		"vaporized",
-		"vaporizes",
-		"vaporizing",
-		"vapors",
-		"various",
-		"varying",
-		"vast",
-		"vastly",
-		"vectors",
-		"veers",
-		"vega",
-		"veins",
-		"veneer",
-		"ventilation",
-		"venting",
-		"verifier",
-		"very",
-		"vessel",
-		"vessels",
-		"via",
-		"vicinity",
-		"victim",
-		"victims",
-		"victory",
-		"video",
-		"view",
-		"viewing",
-		"viewport",
-		"viewscreen",
-		"violate",
-		"violating",
-		"violation",
-		"violent",
-		"violently",
-		"virtually",
-		"virus",
-		"visible",
-		"visibly",
-		"vision",
-		"visit",
-		"visits",
-		"visual",
-		"vitally",
-		"voice",
-		"voices",
-		"volcanic",
-		"volcano",
-		"volcanoes",
-		"volunteers",
-		"voq",
-		"vortex",
-		"vote",
-		"vows",
-		"vulcan",
-		"vulcans",
-		"wait",
-		"waited",
-		"waiting",
-		"waits",
-		"wakes",
-		"waking",
-		"walk",
-		"walking",
-		"walks",
-		"wall",
-		"want",
-		"wanted",
-		"wanting",
-		"wants",
-		"war",
-		"ward",
-		"warfare",
-		"warhead",
-		"warlike",
-		"warn",
-		"warning",
-		"warns",
-		"warp",
-		"warrior",
-		"warriors",
-		"wartime",
-		"was",
-		"waste",
-		"watch",
-		"watches",
-		"watching",
-		"water",
-		"wave",
-		"waves",
-		"way",
-		"ways",
-		"weak",
-		"weakened",
-		"weapons",
-		"wearing",
-		"wedding",
-		"wedge",
-		"week",
-		"weeks",
-		"weet",
-		"welcomes",
-		"welcoming",
-		"went",
-		"were",
-		"what",
-		"whatever",
-		"when",
-		"whenever",
-		"where",
-		"whereabouts",
-		"wherever",
-		"whether",
-		"which",
-		"while",
-		"white",
-		"who",
-		"whoever",
-		"wholesale",
-		"whom",
-		"whose",
-		"why",
-		"wife",
-		"wiggles",
-		"wild",
-		"will",
-		"willing",
-		"willingly",
-		"willingness",
-		"wilson",
-		"win",
-		"window",
-		"windows",
-		"winds",
-		"winning",
-		"wins",
-		"wipe",
-		"wiped",
-		"wiping",
-		"wisely",
-		"wish",
-		"wishes",
-		"with",
-		"within",
-		"without",
-		"witnesses",
-		"woken",
-		"woman",
-		"won",
-		"wondering",
-		"wonderland",
-		"wondrous",
-		"word",
-		"words",
-		"work",
-		"workaround",
-		"worked",
-		"working",
-		"world",
-		"worlds",
-		"worry",
-		"worrying",
-		"worsened",
-		"worth",
-		"would",
-		"wound",
-		"wounded",
-		"wounds",
-		"wrapped",
-		"wrestles",
-		"wrong",
-		"xenological",
-		"yeager",
-		"year",
-		"years",
-		"yellow",
-		"yells",
-		"yeoman",
-		"yes",
-		"yet",
-		"york",
-		"you",
-		"young",
-		"your",
-		"yours",
-		"yourself",
-		"yurium",
-		"zee-magnees",
-		"zone",
-	},
-	"wars": {
-		"aayla",
-		"abandon",
-		"abandoned",
-		"abandoning",
-		"abdomen",
-		"abednedo",
-		"abilities",
-		"ability",
-		"ablaze",
-		"able",
-		"aboard",
-		"about",
-		"above",
-		"absence",
-		"absolutely",
-		"abyss",
-		"academy",
-		"accelerate",
-		"accept",
-		"accepts",
-		"access",
-		"accidentally",
-		"accompanied",
-		"accompany",
-		"accompanying",
-		"accomplished",
-		"according",
-		"accurately",
-		"accusations",
-		"accuses",
-		"accustomed",
-		"achieve",
-		"ackbar",
-		"acknowledged",
-		"across",
-		"act",
-		"acting",
-		"action",
-		"actions",
-		"activate",
-		"activates",
-		"activating",
-		"activities",
-		"acts",
-		"actual",
-		"adapted",
-		"add",
-		"additional",
-		"adds",
-		"adjourns",
-		"administrator",
-		"admiral",
-		"admit",
-		"admits",
-		"adopted",
-		"adult",
-		"advance",
-		"advanced",
-		"advantage",
-		"adventures",
-		"advice",
-		"advise",
-		"advises",
-		"advocate",
-		"affecting",
-		"aflame",
-		"afraid",
-		"after",
-		"aftermath",
-		"afternoon",
-		"afterward",
-		"again",
-		"against",
-		"aged",
-		"agen",
-		"aggressive",
-		"agile",
-		"ago",
-		"agony",
-		"agree",
-		"agreed",
-		"agrees",
-		"ahch-to",
-		"aid",
-		"aided",
-		"aides",
-		"aids",
-		"aim",
-		"air",
-		"aircraft",
-		"airstrike",
-		"albeit",
-		"alderaan",
-		"alerted",
-		"alerts",
-		"alias",
-		"alienating",
-		"alike",
-		"alive",
-		"all",
-		"allegiance",
-		"alliance",
-		"allie",
-		"allies",
-		"allow",
-		"allowing",
-		"allows",
-		"ally",
-		"almost",
-		"alone",
-		"along",
-		"already",
-		"also",
-		"alternative",
-		"although",
-		"amanda",
-		"ambush",
-		"ambushed",
-		"ambushes",
-		"amidala",
-		"amidst",
-		"amilyn",
-		"among",
-		"amusement",
-		"anakin",
-		"ancient",
-		"and",
-		"andor",
-		"anew",
-		"anger",
-		"angered",
-		"angle",
-		"angrily",
-		"angry",
-		"anguish",
-		"animal",
-		"animation",
-		"annihilated",
-		"announce",
-		"announced",
-		"announces",
-		"announcing",
-		"annoyance",
-		"another",
-		"answers",
-		"antagonizing",
-		"antechamber",
-		"antenna",
-		"anticipated",
-		"antilles",
-		"antoc",
-		"any",
-		"anything",
-		"apart",
-		"apartment",
-		"apologize",
-		"apologizes",
-		"apology",
-		"apparently",
-		"appeals",
-		"appear",
-		"appearing",
-		"appears",
-		"applause",
-		"appo",
-		"appointment",
-		"appoints",
-		"apprehended",
-		"apprehending",
-		"apprentice",
-		"approach",
-		"approached",
-		"approaches",
-		"approaching",
-		"approval",
-		"approved",
-		"approximately",
-		"archduke",
-		"are",
-		"area",
-		"argues",
-		"arm",
-		"armada",
-		"armed",
-		"armitage",
-		"armor",
-		"armored",
-		"arms",
-		"army",
-		"around",
-		"arrest",
-		"arrested",
-		"arrival",
-		"arrive",
-		"arrived",
-		"arrives",
-		"arriving",
-		"artoo",
-		"aside",
-		"asking",
-		"asks",
-		"asleep",
-		"asperations",
-		"aspiring",
-		"assault",
-		"assaults",
-		"assembling",
-		"assess",
-		"asset",
-		"assigned",
-		"assignment",
-		"assumes",
-		"assures",
-		"asteroid",
-		"asteroids",
-		"astride",
-		"astromech",
-		"astronomical",
-		"at-act",
-		"at-at",
-		"at-ats",
-		"at-st",
-		"at-sts",
-		"atmospheric",
-		"atop",
-		"attached",
-		"attaches",
-		"attack",
-		"attacked",
-		"attacking",
-		"attacks",
-		"attempt",
-		"attempting",
-		"attempts",
-		"attention",
-		"audacious",
-		"audience",
-		"aunt",
-		"automated",
-		"avail",
-		"available",
-		"avoid",
-		"avoids",
-		"await",
-		"awaken",
-		"awakened",
-		"awakens",
-		"awakes",
-		"aware",
-		"away",
-		"awoke",
-		"awoken",
-		"axe",
-		"axes",
-		"babies",
-		"baby",
-		"back",
-		"back-to-back",
-		"backed",
-		"backfires",
-		"background",
-		"backing",
-		"backpack",
-		"backs",
-		"bacta",
-		"bad",
-		"badlands",
-		"badly",
-		"bail",
-		"bala-tik",
-		"balancing",
-		"balbianello",
-		"balcony",
-		"ball",
-		"ballet",
-		"band",
-		"bandage",
-		"bank",
-		"banquet",
-		"bantha",
-		"barc",
-		"bare",
-		"barely",
-		"bargain",
-		"bargaining",
-		"barge",
-		"bargwill",
-		"bark",
-		"base",
-		"basic",
-		"bass",
-		"battalion",
-		"battering",
-		"battle",
-		"battled",
-		"battles",
-		"battleship",
-		"battling",
-		"bay",
-		"baze",
-		"bazine",
-		"beach",
-		"beaches",
-		"beacon",
-		"beam",
-		"beast",
-		"beaten",
-		"beauty",
-		"became",
-		"because",
-		"beckett",
-		"become",
-		"becomes",
-		"becoming",
-		"been",
-		"before",
-		"began",
-		"begin",
-		"beginning",
-		"begins",
-		"begrudgingly",
-		"begs",
-		"begun",
-		"behalf",
-		"behead",
-		"beheading",
-		"beheads",
-		"behind",
-		"being",
-		"belch",
-		"believe",
-		"believes",
-		"believing",
-		"belly",
-		"belonged",
-		"beloved",
-		"below",
-		"ben",
-		"beneath",
-		"benefit",
-		"benthic",
-		"beru",
-		"beside",
-		"bespin",
-		"bestowing",
-		"bests",
-		"betrayal",
-		"betrayed",
-		"betraying",
-		"better",
-		"between",
-		"beyond",
-		"bib",
-		"bid",
-		"big",
-		"biggs",
-		"bight",
-		"bike",
-		"binary",
-		"binder",
-		"binks",
-		"birth",
-		"bitter",
-		"black",
-		"blackmails",
-		"blade",
-		"blagg",
-		"blame",
-		"blaming",
-		"blanket",
-		"blast",
-		"blaster",
-		"blasters",
-		"blasting",
-		"blasts",
-		"bleeding",
-		"blind",
-		"blizzard",
-		"block",
-		"blockade",
-		"blockaded",
-		"blood",
-		"blood-red",
-		"bloodthirsty",
-		"blow",
-		"blue",
-		"blueprints",
-		"board",
-		"boarded",
-		"boarding",
-		"boards",
-		"boba",
-		"bodhi",
-		"bodies",
-		"body",
-		"boga",
-		"bohdi",
-		"bolt",
-		"bolts",
-		"bombardment",
-		"bombards",
-		"bombers",
-		"bombing",
-		"bond",
-		"booby",
-		"books",
-		"booster",
-		"bor",
-		"born",
-		"bossk",
-		"both",
-		"bottom",
-		"bought",
-		"bouhlel",
-		"bounty",
-		"boushh",
-		"bowcaster",
-		"bowels",
-		"bows",
-		"box",
-		"boy",
-		"bravo",
-		"brawl",
-		"breached",
-		"breaches",
-		"break",
-		"breaks",
-		"breath",
-		"breathe",
-		"breathing",
-		"breaths",
-		"breha",
-		"bribe",
-		"bridge",
-		"brief",
-		"briefly",
-		"bright",
-		"bring",
-		"bringing",
-		"brings",
-		"broadcasts",
-		"broken",
-		"broom",
-		"brother",
-		"brought",
-		"brush",
-		"brutally",
-		"budget",
-		"building",
-		"built",
-		"bunker",
-		"buried",
-		"burn",
-		"burned",
-		"burning",
-		"burst",
-		"bursts",
-		"bury",
-		"business",
-		"businessman",
-		"bustling",
-		"but",
-		"butt",
-		"button",
-		"buy",
-		"buzz",
-		"bypassing",
-		"cable",
-		"cables",
-		"cackling",
-		"cadera",
-		"cadet",
-		"calamari",
-		"calculations",
-		"call",
-		"called",
-		"calling",
-		"calls",
-		"callsign",
-		"calm",
-		"calrissian",
-		"came",
-		"can",
-		"cannon",
-		"cannons",
-		"cannot",
-		"cantina",
-		"canto",
-		"cantonica",
-		"canyon",
-		"capable",
-		"capital",
-		"capsules",
-		"captain",
-		"captains",
-		"captive",
-		"captives",
-		"captivity",
-		"captors",
-		"capture",
-		"captured",
-		"carbon",
-		"carbon-freezing",
-		"carbonite",
-		"carbonization",
-		"cards",
-		"care",
-		"cared",
-		"carefully",
-		"cares",
-		"cargo",
-		"carida",
-		"carkoon",
-		"carnage",
-		"carried",
-		"carries",
-		"carrying",
-		"case",
-		"caserta",
-		"casino",
-		"cassian",
-		"casting",
-		"castle",
-		"casually",
-		"casualties",
-		"catacombs",
-		"catalyst",
-		"catch",
-		"catches",
-		"cato",
-		"caught",
-		"cause",
-		"caused",
-		"causes",
-		"causing",
-		"caution",
-		"cave",
-		"cavern",
-		"ceiling",
-		"celebrate",
-		"celebrates",
-		"celebration",
-		"cell",
-		"cellblock",
-		"cells",
-		"cement",
-		"center",
-		"ceremony",
-		"certain",
-		"chain",
-		"chained",
-		"chair",
-		"chairman",
-		"challenges",
-		"chamber",
-		"chambers",
-		"chance",
-		"chancellor",
-		"change",
-		"changed",
-		"changing",
-		"chaos",
-		"charge",
-		"charges",
-		"charred",
-		"charted",
-		"chase",
-		"chased",
-		"chases",
-		"chasez",
-		"chasm",
-		"checks",
-		"chest",
-		"chewbacca",
-		"chewie",
-		"chides",
-		"child",
-		"childbirth",
-		"children",
-		"chirrut",
-		"choice",
-		"choke",
-		"chokes",
-		"choose",
-		"chooses",
-		"chosen",
-		"chris",
-		"chronicles",
-		"chuckles",
-		"chute",
-		"cin",
-		"cinema",
-		"citadel",
-		"citing",
-		"citizens",
-		"city",
-		"civil",
-		"claim",
-		"claiming",
-		"claims",
-		"clasp",
-		"class",
-		"clean",
-		"cleaning",
-		"cleanly",
-		"clear",
-		"clearance",
-		"clearly",
-		"cleaving",
-		"cliff",
-		"cliffside",
-		"climb",
-		"climbing",
-		"climbs",
-		"cloak",
-		"clone",
-		"clones",
-		"close",
-		"closer",
-		"closes",
-		"cloud",
-		"cloud-riders",
-		"clutches",
-		"co-pilot",
-		"coaxium",
-		"cobalt",
-		"code",
-		"codebreaker",
-		"coded",
-		"codenamed",
-		"cody",
-		"coerced",
-		"coffin",
-		"cold",
-		"collapse",
-		"collapses",
-		"collapsing",
-		"collared",
-		"colleagues",
-		"collect",
-		"collection",
-		"colony",
-		"comatose",
-		"combat",
-		"combatants",
-		"come",
-		"comes",
-		"comfort",
-		"comfortable",
-		"comforted",
-		"coming",
-		"comlink",
-		"command",
-		"commanded",
-		"commandeers",
-		"commander",
-		"commanders",
-		"commanding",
-		"commands",
-		"commence",
-		"commences",
-		"commends",
-		"commented",
-		"commit",
-		"committing",
-		"communicate",
-		"communicates",
-		"communicating",
-		"communication",
-		"communications",
-		"como",
-		"compactor",
-		"companion",
-		"companions",
-		"company",
-		"compartments",
-		"compels",
-		"competence",
-		"complement",
-		"complete",
-		"completed",
-		"completely",
-		"completing",
-		"complex",
-		"complimenting",
-		"compound",
-		"compressor",
-		"compromised",
-		"computer",
-		"comrade",
-		"comrades",
-		"conceivable",
-		"concentrated",
-		"concentrating",
-		"concentration",
-		"concern",
-		"concerned",
-		"concerning",
-		"concludes",
-		"concussion",
-		"condemned",
-		"conditions",
-		"conducted",
-		"confederacy",
-		"conference",
-		"confesses",
-		"confidence",
-		"confides",
-		"confirmation",
-		"confirmed",
-		"confirms",
-		"conflicted",
-		"confront",
-		"confronted",
-		"confronting",
-		"confronts",
-		"confused",
-		"confusion",
-		"congratulates",
-		"connected",
-		"connection",
-		"consciousness",
-		"consensus",
-		"consents",
-		"consider",
-		"considered",
-		"consignment",
-		"console",
-		"construction",
-		"consumed",
-		"consumes",
-		"contact",
-		"contacted",
-		"contacts",
-		"containing",
-		"contemplate",
-		"contemplated",
-		"contempt",
-		"content",
-		"context",
-		"continuation",
-		"continue",
-		"continued",
-		"continues",
-		"continuing",
-		"contrast",
-		"control",
-		"controls",
-		"conversation",
-		"converted",
-		"converting",
-		"convince",
-		"convinced",
-		"convinces",
-		"convincing",
-		"convocation",
-		"convoy",
-		"cooperate",
-		"cooperated",
-		"coordinated",
-		"coordinates",
-		"core",
-		"corellia",
-		"cornered",
-		"corners",
-		"corporate",
-		"corps",
-		"corpses",
-		"corridor",
-		"corridors",
-		"corrupted",
-		"corruption",
-		"coruscant",
-		"corvette",
-		"could",
-		"council",
-		"counsel",
-		"count",
-		"counter",
-		"counter-attack",
-		"counter-attacked",
-		"counterattack",
-		"counters",
-		"course",
-		"cover",
-		"covered",
-		"covers",
-		"covertly",
-		"cowardly",
-		"craft",
-		"crait",
-		"crash",
-		"crash-land",
-		"crash-lands",
-		"crashes",
-		"crashing",
-		"crater",
-		"craves",
-		"crawl",
-		"create",
-		"creates",
-		"creating",
-		"creation",
-		"creature",
-		"creatures",
-		"credits",
-		"crew",
-		"cries",
-		"crime",
-		"criminal",
-		"criminals",
-		"crimson",
-		"crippled",
-		"criticizes",
-		"crolute",
-		"crosses",
-		"crowd",
-		"cruiser",
-		"crumb",
-		"crush",
-		"crushed",
-		"crushing",
-		"cry",
-		"crystals",
-		"cuffs",
-		"curio",
-		"curious",
-		"current",
-		"cursing",
-		"custody",
-		"cut",
-		"cuts",
-		"cutting",
-		"cyborg",
-		"dagobah",
-		"dais",
-		"dak",
-		"damage",
-		"damaged",
-		"damages",
-		"dameron",
-		"dancing",
-		"danger",
-		"dangerous",
-		"dangers",
-		"dangles",
-		"dark",
-		"dark-side",
-		"darklighter",
-		"darkness",
-		"darksider",
-		"darth",
-		"dashes",
-		"data",
-		"databank",
-		"daughter",
-		"daughters",
-		"davits",
-		"dawn",
-		"day",
-		"days",
-		"de-stabilize",
-		"deactivate",
-		"deactivated",
-		"deactivates",
-		"deactivating",
-		"dead",
-		"deadly",
-		"deal",
-		"dealings",
-		"deals",
-		"dear",
-		"death",
-		"deaths",
-		"debriefs",
-		"debt",
-		"debts",
-		"decapitates",
-		"deceased",
-		"december",
-		"deception",
-		"decide",
-		"decided",
-		"decides",
-		"deciding",
-		"decision",
-		"decisively",
-		"deck",
-		"declares",
-		"declaring",
-		"declines",
-		"decoration",
-		"deduced",
-		"deduces",
-		"deems",
-		"deep",
-		"deeper",
-		"deeply",
-		"defeat",
-		"defeated",
-		"defeating",
-		"defected",
-		"defection",
-		"defector",
-		"defend",
-		"defending",
-		"defends",
-		"defense",
-		"defenseless",
-		"defenses",
-		"defensive",
-		"defiance",
-		"defined",
-		"deflected",
-		"deflector",
-		"deflects",
-		"deformed",
-		"del",
-		"delays",
-		"deleted",
-		"delighted",
-		"deliver",
-		"delivered",
-		"delivers",
-		"demand",
-		"demands",
-		"demise",
-		"democracy",
-		"demolished",
-		"demonstrate",
-		"demonstrates",
-		"demonstration",
-		"demotes",
-		"demotion",
-		"dengar",
-		"denial",
-		"denied",
-		"denies",
-		"deny",
-		"depart",
-		"departing",
-		"department",
-		"departs",
-		"departure",
-		"depend",
-		"depicted",
-		"deployed",
-		"deploys",
-		"descend",
-		"descends",
-		"descent",
-		"describe",
-		"describes",
-		"desert",
-		"deserter",
-		"deserting",
-		"design",
-		"designation",
-		"designed",
-		"desire",
-		"despair",
-		"despairing",
-		"desperate",
-		"desperately",
-		"despite",
-		"destabilize",
-		"destined",
-		"destiny",
-		"destroy",
-		"destroyed",
-		"destroyer",
-		"destroyers",
-		"destroying",
-		"destroys",
-		"destruction",
-		"detach",
-		"detaching",
-		"detained",
-		"detect",
-		"detection",
-		"detention",
-		"determines",
-		"detonates",
-		"detonator",
-		"detonators",
-		"devastator",
-		"developments",
-		"device",
-		"devil",
-		"devising",
-		"devour",
-		"devours",
-		"dianoga",
-		"did",
-		"die",
-		"dies",
-		"different",
-		"difficult",
-		"digital",
-		"diminutive",
-		"diplomatic",
-		"direct",
-		"directions",
-		"director",
-		"directors",
-		"dirty",
-		"disable",
-		"disabled",
-		"disables",
-		"disabling",
-		"disagrees",
-		"disappearance",
-		"disappeared",
-		"disappearing",
-		"disappears",
-		"disappointed",
-		"disapproving",
-		"disarms",
-		"disaster",
-		"disbelief",
-		"discarding",
-		"discharges",
-		"discloses",
-		"discover",
-		"discovered",
-		"discovering",
-		"discovers",
-		"discreetly",
-		"discuss",
-		"disengages",
-		"disfiguring",
-		"disguise",
-		"disguised",
-		"disgust",
-		"dismay",
-		"dismembered",
-		"dismisses",
-		"disobeying",
-		"disobeys",
-		"dispatched",
-		"dispatches",
-		"disperses",
-		"displayed",
-		"displays",
-		"disposing",
-		"disregards",
-		"dissipate",
-		"distance",
-		"distant",
-		"distaste",
-		"distract",
-		"distracted",
-		"distraction",
-		"distress",
-		"district",
-		"distrust",
-		"distrustful",
-		"disturbance",
-		"ditches",
-		"dive",
-		"diversionary",
-		"docked",
-		"docking",
-		"dodonna",
-		"does",
-		"dogfight",
-		"done",
-		"dooku",
-		"door",
-		"dopheld",
-		"dormant",
-		"dotted",
-		"double",
-		"doubts",
-		"down",
-		"downed",
-		"downfall",
-		"draft",
-		"drag",
-		"drags",
-		"draining",
-		"drains",
-		"drallig",
-		"draven",
-		"draw",
-		"drawer",
-		"drawing",
-		"draws",
-		"dreaded",
-		"dreadnought",
-		"dream",
-		"dreaming",
-		"dreams",
-		"dressed",
-		"drink",
-		"drinking",
-		"drinks",
-		"drive",
-		"driven",
-		"driving",
-		"droid",
-		"droids",
-		"drop",
-		"dropped",
-		"dropping",
-		"drops",
-		"dryden",
-		"dubbing",
-		"ducks",
-		"duel",
-		"dueling",
-		"duels",
-		"dumbfounded",
-		"dump",
-		"dune",
-		"duo",
-		"during",
-		"dwarf",
-		"dwindle",
-		"dying",
-		"each",
-		"eadu",
-		"ealing",
-		"earlier",
-		"early",
-		"easily",
-		"east",
-		"eating",
-		"eccentric",
-		"echo",
-		"edge",
-		"edit",
-		"effective",
-		"effectively",
-		"effects",
-		"effort",
-		"efforts",
-		"eisley",
-		"either",
-		"eject",
-		"ejects",
-		"elder",
-		"elected",
-		"electing",
-		"electrostaff",
-		"element",
-		"elements",
-		"elevator",
-		"else",
-		"elsewhere",
-		"eluding",
-		"embankment",
-		"embrace",
-		"embraced",
-		"emerged",
-		"emergency",
-		"emerges",
-		"emotional",
-		"emotions",
-		"emperor",
-		"emphasizing",
-		"empire",
-		"empty",
-		"encased",
-		"encounter",
-		"encounters",
-		"encouragement",
-		"encourages",
-		"encroaching",
-		"end",
-		"endanger",
-		"ended",
-		"ending",
-		"endor",
-		"ends",
-		"enemies",
-		"enemy",
-		"energy",
-		"enforcer",
-		"enfys",
-		"engage",
-		"engaged",
-		"engages",
-		"engines",
-		"enlists",
-		"enough",
-		"enraged",
-		"ensues",
-		"ensuing",
-		"ensure",
-		"ensuring",
-		"enter",
-		"entered",
-		"enters",
-		"entertains",
-		"entire",
-		"entirety",
-		"entitled",
-		"entombed",
-		"entrance",
-		"entry",
-		"eopie",
-		"epic",
-		"episode",
-		"equipment",
-		"equipped",
-		"eravana",
-		"erecting",
-		"erso",
-		"erupts",
-		"escalated",
-		"escape",
-		"escaped",
-		"escapes",
-		"escaping",
-		"escort",
-		"escorting",
-		"escorts",
-		"espa",
-		"essentially",
-		"establish",
-		"estimated",
-		"evacuate",
-		"evacuated",
-		"evacuation",
-		"evade",
-		"evaded",
-		"evades",
-		"evading",
-		"even",
-		"evening",
-		"events",
-		"eventually",
-		"ever",
-		"every",
-		"everyone",
-		"everything",
-		"evidence",
-		"evil",
-		"ewok",
-		"ewoks",
-		"exactly",
-		"example",
-		"except",
-		"exchange",
-		"exchanging",
-		"excoriates",
-		"excruciating",
-		"execute",
-		"executes",
-		"execution",
-		"executioners",
-		"executor",
-		"executrix",
-		"exhaust",
-		"exhaustion",
-		"exhausts",
-		"exile",
-		"existence",
-		"exit",
-		"exits",
-		"exodus",
-		"expects",
-		"expelled",
-		"experience",
-		"experiences",
-		"experiment",
-		"explain",
-		"explaining",
-		"explains",
-		"explicitly",
-		"explode",
-		"explodes",
-		"exploitation",
-		"explosion",
-		"explosions",
-		"explosives",
-		"expose",
-		"exposed",
-		"exposure",
-		"expresses",
-		"extensively",
-		"extent",
-		"exterior",
-		"extract",
-		"extraction",
-		"extras",
-		"extreme",
-		"extremely",
-		"eye",
-		"eyes",
-		"face",
-		"faced",
-		"facility",
-		"facing",
-		"fact",
-		"factions",
-		"fades",
-		"fail",
-		"failed",
-		"failing",
-		"fails",
-		"failure",
-		"failures",
-		"faith",
-		"fake",
-		"faking",
-		"falcon",
-		"fall",
-		"fallen",
-		"falling",
-		"falls",
-		"false",
-		"family",
-		"fan",
-		"fans",
-		"far",
-		"farewell",
-		"farmer",
-		"farther",
-		"fast",
-		"fastest",
-		"fatal",
-		"fatally",
-		"fate",
-		"father",
-		"fathier",
-		"fathiers",
-		"fatone",
-		"favor",
-		"favorite",
-		"fear",
-		"feared",
-		"fearful",
-		"fearfully",
-		"fearing",
-		"fears",
-		"federation",
-		"feeds",
-		"feel",
-		"feeling",
-		"feelings",
-		"feels",
-		"fell",
-		"fellow",
-		"felucia",
-		"fending",
-		"fends",
-		"ferocious",
-		"fett",
-		"few",
-		"fiddles",
-		"field",
-		"fiendish",
-		"fierce",
-		"fiercely",
-		"fiery",
-		"fight",
-		"fighter",
-		"fighters",
-		"fighting",
-		"figure",
-		"figures",
-		"filled",
-		"film",
-		"filmed",
-		"filming",
-		"films",
-		"final",
-		"finalizer",
-		"finally",
-		"find",
-		"finding",
-		"finds",
-		"fingernails",
-		"finish",
-		"finished",
-		"finishes",
-		"finishing",
-		"finn",
-		"fire",
-		"fired",
-		"firefight",
-		"firepower",
-		"fires",
-		"firespeeders",
-		"fireworks",
-		"firing",
-		"firmly",
-		"firmus",
-		"first",
-		"fisto",
-		"fit",
-		"fitted",
-		"fix",
-		"fixing",
-		"flagship",
-		"flames",
-		"flaming",
-		"flawed",
-		"flawlessly",
-		"fled",
-		"flee",
-		"fleeing",
-		"flees",
-		"fleet",
-		"flies",
-		"flight",
-		"flipping",
-		"float",
-		"floor",
-		"flung",
-		"fly",
-		"flying",
-		"foe",
-		"foiled",
-		"follow",
-		"followed",
-		"following",
-		"follows",
-		"food",
-		"foodstuff",
-		"foolishly",
-		"foot",
-		"for",
-		"force",
-		"force-choking",
-		"force-flip",
-		"force-sensitive",
-		"force-tricked",
-		"forced",
-		"forces",
-		"forcibly",
-		"forcing",
-		"foreman",
-		"foreseen",
-		"forest",
-		"forests",
-		"forever",
-		"form",
-		"formally",
-		"formation",
-		"former",
-		"forms",
-		"formulates",
-		"fortress",
-		"fortuna",
-		"fortunately",
-		"forward",
-		"fought",
-		"foul",
-		"found",
-		"four",
-		"foursome",
-		"fox",
-		"fractional",
-		"frames",
-		"frantically",
-		"free",
-		"free-fall",
-		"freed",
-		"freedom",
-		"freeing",
-		"frees",
-		"freeze",
-		"freezing",
-		"freighter",
-		"friend",
-		"friends",
-		"friendship",
-		"frigate",
-		"frightened",
-		"fringes",
-		"from",
-		"front",
-		"frustrated",
-		"fuel",
-		"fugitives",
-		"fulfill",
-		"full",
-		"fully",
-		"fumes",
-		"function",
-		"funeral",
-		"furious",
-		"furiously",
-		"further",
-		"fury",
-		"futile",
-		"future",
-		"gain",
-		"gained",
-		"gaining",
-		"gains",
-		"galactic",
-		"galaxies",
-		"galaxy",
-		"galen",
-		"game",
-		"gamorrean",
-		"gang",
-		"gangs",
-		"gangster",
-		"garbage",
-		"garrison",
-		"gas-mining",
-		"gate",
-		"gathered",
-		"gathering",
-		"gathers",
-		"gave",
-		"gazes",
-		"gear",
-		"general",
-		"generals",
-		"generator",
-		"geonosian",
-		"george",
-		"gerrera",
-		"get",
-		"gets",
-		"getting",
-		"ghost",
-		"gial",
-		"giants",
-		"gifts",
-		"girl",
-		"give",
-		"given",
-		"gives",
-		"giving",
-		"glancing",
-		"glares",
-		"glee",
-		"gloatingly",
-		"goazon",
-		"god",
-		"goes",
-		"going",
-		"gold",
-		"gone",
-		"good",
-		"goodbye",
-		"got",
-		"government",
-		"governor",
-		"graceful",
-		"gradually",
-		"grand",
-		"grandfather",
-		"grant",
-		"granted",
-		"grants",
-		"grasped",
-		"grate",
-		"grateful",
-		"gravely",
-		"graveyard",
-		"gravitational",
-		"gravity",
-		"gray-hulled",
-		"great",
-		"greater",
-		"greatest",
-		"gree",
-		"green",
-		"green-skinned",
-		"greeted",
-		"grenade",
-		"grew",
-		"grief",
-		"grievous",
-		"grin",
-		"grind",
-		"ground",
-		"group",
-		"groveling",
-		"growing",
-		"grows",
-		"guarantee",
-		"guard",
-		"guardians",
-		"guards",
-		"guavian",
-		"guests",
-		"guidance",
-		"guide",
-		"guided",
-		"guides",
-		"guise",
-		"gullet",
-		"gunfight",
-		"gunned",
-		"gunner",
-		"gunray",
-		"guns",
-		"gunship",
-		"gunships",
-		"gut",
-		"gyrations",
-		"haako",
-		"hacks",
-		"had",
-		"hale",
-		"half",
-		"half-blinded",
-		"half-completed",
-		"half-witted",
-		"halik",
-		"halt",
-		"halves",
-		"hamper",
-		"han",
-		"hand",
-		"hands",
-		"hang",
-		"hangar",
-		"hanger",
-		"hanging",
-		"happened",
-		"happens",
-		"hard",
-		"hardworking",
-		"harpoons",
-		"harshly",
-		"has",
-		"hatch",
-		"hauls",
-		"haunt",
-		"have",
-		"having",
-		"head",
-		"heading",
-		"headquarters",
-		"heads",
-		"healing",
-		"heard",
-		"hearing",
-		"hears",
-		"heart",
-		"heavily",
-		"heavy",
-		"heist",
-		"held",
-		"helmet",
-		"help",
-		"helped",
-		"helping",
-		"helpless",
-		"helplessly",
-		"henchmen",
-		"her",
-		"herded",
-		"herder",
-		"heroes",
-		"heroics",
-		"herself",
-		"hesitates",
-		"hever",
-		"hexagonal",
-		"hidden",
-		"hideously",
-		"hideout",
-		"hides",
-		"hiding",
-		"high",
-		"high-security",
-		"higher",
-		"hilt",
-		"him",
-		"himself",
-		"hinder",
-		"hires",
-		"his",
-		"history",
-		"hit",
-		"hits",
-		"hoist",
-		"hold",
-		"holding",
-		"holdo",
-		"holds",
-		"hole",
-		"hologram",
-		"holographic",
-		"home",
-		"homestead",
-		"hone",
-		"honing",
-		"honor",
-		"hope",
-		"hopefully",
-		"hopelessly",
-		"hopes",
-		"hoping",
-		"horizon",
-		"horribly",
-		"horrified",
-		"horror",
-		"hosnian",
-		"hostage",
-		"hostile",
-		"hosts",
-		"hot-head",
-		"hot-wires",
-		"hoth",
-		"house",
-		"housing",
-		"hovering",
-		"how",
-		"howard",
-		"however",
-		"howls",
-		"hue",
-		"huge",
-		"hugging",
-		"hull",
-		"human",
-		"humanoid",
-		"humiliated",
-		"hundreds",
-		"hunted",
-		"hunter",
-		"hunters",
-		"hurling",
-		"hurls",
-		"hurriedly",
-		"hurries",
-		"hurt",
-		"husband",
-		"hut",
-		"hutt",
-		"hux",
-		"hyperdrive",
-		"hyperfuel",
-		"hyperspace",
-		"i-class",
-		"ice",
-		"idea",
-		"identify",
-		"identity",
-		"ignite",
-		"ignited",
-		"ignites",
-		"ignition",
-		"ignore",
-		"ignoring",
-		"iii",
-		"illegitimate",
-		"immediately",
-		"imminent",
-		"immortality",
-		"impacts",
-		"impassioned",
-		"impatient",
-		"impede",
-		"impending",
-		"imperial",
-		"imperials",
-		"implantation",
-		"implied",
-		"implode",
-		"imploder",
-		"implores",
-		"imply",
-		"important",
-		"impressed",
-		"imprison",
-		"imprisoned",
-		"impromptu",
-		"improvises",
-		"inability",
-		"inaction",
-		"incapable",
-		"incident",
-		"incinerates",
-		"incinerating",
-		"include",
-		"includes",
-		"including",
-		"income",
-		"incompetence",
-		"incomplete",
-		"increases",
-		"incredulous",
-		"indeed",
-		"independent",
-		"indiana",
-		"indicate",
-		"indifferent",
-		"indistinctly",
-		"industrial",
-		"infamous",
-		"infant",
-		"infantryman",
-		"infiltrate",
-		"infiltrated",
-		"infiltrating",
-		"inflicting",
-		"influence",
-		"inform",
-		"information",
-		"informed",
-		"informing",
-		"informs",
-		"ingenuity",
-		"inhabitants",
-		"initially",
-		"initiate",
-		"injured",
-		"injures",
-		"injury",
-		"insanely",
-		"inside",
-		"insignificant",
-		"insisting",
-		"insists",
-		"inspecting",
-		"inspection",
-		"inspire",
-		"inspired",
-		"installation",
-		"installed",
-		"instantly",
-		"instead",
-		"instigates",
-		"instituted",
-		"instructed",
-		"instructions",
-		"instructs",
-		"instrument",
-		"insubordination",
-		"insult",
-		"insure",
-		"intelligence",
-		"intended",
-		"intending",
-		"intense",
-		"intensity",
-		"intent",
-		"intentions",
-		"intercepted",
-		"interceptor",
-		"interest",
-		"interfacing",
-		"interference",
-		"interior",
-		"internal",
-		"interpreter",
-		"interrogate",
-		"interrogated",
-		"interrupted",
-		"interrupts",
-		"intervene",
-		"intervenes",
-		"intervention",
-		"into",
-		"introduced",
-		"introduces",
-		"intruders",
-		"intuitive",
-		"investigate",
-		"invisible",
-		"invites",
-		"inviting",
-		"involuntary",
-		"involved",
-		"involvement",
-		"ion",
-		"irises",
-		"irony",
-		"island",
-		"islands",
-		"isolated",
-		"issue",
-		"italy",
-		"ithano",
-		"its",
-		"itself",
-		"iv-vi",
-		"jabba",
-		"jacket",
-		"jakku",
-		"jan",
-		"janson",
-		"january",
-		"japor",
-		"jar",
-		"jebel",
-		"jedha",
-		"jedi",
-		"jedi-in-hiding",
-		"jerjerrod",
-		"jetpack",
-		"jettisoned",
-		"jinn",
-		"jmel",
-		"joey",
-		"jogged",
-		"join",
-		"joins",
-		"jon",
-		"jones",
-		"joy",
-		"judgment",
-		"jukassa",
-		"jump",
-		"jumping",
-		"jumps",
-		"jundland",
-		"june",
-		"junk",
-		"junkers",
-		"just",
-		"justin",
-		"jyn",
-		"kafrene",
-		"kanata",
-		"kanjiklub",
-		"kashyyyk",
-		"katie",
-		"keep",
-		"keeping",
-		"kendal",
-		"kenobi",
-		"kessel",
-		"khetanna",
-		"ki-adi-mundi",
-		"kidnapped",
-		"kidnapping",
-		"kill",
-		"killed",
-		"killer",
-		"killing",
-		"kills",
-		"kilometers",
-		"kind",
-		"kingdom",
-		"kirkpatrick",
-		"kiss",
-		"kisses",
-		"kit",
-		"kneeling",
-		"knees",
-		"knew",
-		"knight",
-		"knights",
-		"knock",
-		"knocked",
-		"knocking",
-		"knocks",
-		"know",
-		"knowing",
-		"knowledge",
-		"known",
-		"knows",
-		"kolar",
-		"koon",
-		"krennic",
-		"ksar",
-		"ksour",
-		"kyber",
-		"kylo",
-		"lack",
-		"lacks",
-		"laid",
-		"lake",
-		"laments",
-		"lance",
-		"land",
-		"landed",
-		"landers",
-		"landing",
-		"lando",
-		"lands",
-		"landscape",
-		"large",
-		"larger",
-		"lars",
-		"laser",
-		"lashes",
-		"last",
-		"latches",
-		"late",
-		"lately",
-		"later",
-		"latter",
-		"laugh",
-		"laughs",
-		"launch",
-		"launches",
-		"launching",
-		"launderette",
-		"lava",
-		"lead",
-		"leader",
-		"leaders",
-		"leadership",
-		"leading",
-		"leads",
-		"leak",
-		"leaks",
-		"leaping",
-		"leaps",
-		"learn",
-		"learned",
-		"learning",
-		"learns",
-		"least",
-		"leave",
-		"leaves",
-		"leavesden",
-		"leaving",
-		"lectures",
-		"led",
-		"left",
-		"leg",
-		"legends",
-		"legion",
-		"legions",
-		"legs",
-		"leia",
-		"less",
-		"lesser",
-		"lesson",
-		"lessons",
-		"lest",
-		"let",
-		"level",
-		"levels",
-		"levitate",
-		"liability",
-		"liana",
-		"libertine",
-		"liberty",
-		"library",
-		"lied",
-		"lies",
-		"lieutenant",
-		"life",
-		"lifts",
-		"light",
-		"lightmaker",
-		"lightning",
-		"lights",
-		"lightsaber",
-		"lightsabers",
-		"lightspeed",
-		"like",
-		"likely",
-		"likes",
-		"lines",
-		"linger",
-		"lining",
-		"links",
-		"listed",
-		"listen",
-		"listens",
-		"literally",
-		"little",
-		"live",
-		"livid",
-		"living",
-		"lizard-monkey",
-		"load",
-		"loadlifters",
-		"local",
-		"locate",
-		"located",
-		"locates",
-		"locating",
-		"location",
-		"locations",
-		"lock",
-		"locked",
-		"locks",
-		"long",
-		"longer",
-		"look",
-		"looking",
-		"looks",
-		"loose",
-		"lor",
-		"lord",
-		"lorth",
-		"lose",
-		"loses",
-		"losing",
-		"loss",
-		"losses",
-		"lost",
-		"love",
-		"loved",
-		"lover",
-		"loves",
-		"lovey",
-		"low",
-		"lower",
-		"lowers",
-		"loyalties",
-		"loyalty",
-		"lucas",
-		"lucasfilm",
-		"lucrehulk-class",
-		"luke",
-		"luminara",
-		"lungs",
-		"lure",
-		"lured",
-		"lush",
-		"luxury",
-		"lying",
-		"lynne",
-		"lyra",
-		"mace",
-		"machinery",
-		"mad",
-		"made",
-		"magic",
-		"magnaguard",
-		"magnets",
-		"main",
-		"mainframe",
-		"mainly",
-		"maintained",
-		"maintains",
-		"major",
-		"majority",
-		"make",
-		"makes",
-		"making",
-		"malbus",
-		"man",
-		"man-eating",
-		"manage",
-		"manages",
-		"maneuver",
-		"maneuvers",
-		"manifestation",
-		"manner",
-		"mans",
-		"mantra",
-		"many",
-		"map",
-		"march",
-		"marines",
-		"marshy",
-		"mask",
-		"masquerade",
-		"mass",
-		"massa",
-		"massacre",
-		"massacres",
-		"massive",
-		"master",
-		"masters",
-		"match",
-		"matches",
-		"mate",
-		"matters",
-		"maul",
-		"max",
-		"maximillian",
-		"may",
-		"maz",
-		"mccallum",
-		"meal",
-		"mean",
-		"means",
-		"meant",
-		"meantime",
-		"meanwhile",
-		"mechanic",
-		"mechanical",
-		"mechanized",
-		"medals",
-		"medenine",
-		"media",
-		"medical",
-		"medicines",
-		"meditates",
-		"meditating",
-		"meditation",
-		"meet",
-		"meeting",
-		"meets",
-		"mega-class",
-		"melee",
-		"member",
-		"members",
-		"memorable",
-		"memory",
-		"men",
-		"menace",
-		"mental",
-		"mention",
-		"mentioned",
-		"mentioning",
-		"mentions",
-		"mercilessly",
-		"merge",
-		"merrick",
-		"mesmerized",
-		"message",
-		"met",
-		"metallic",
-		"meteorite",
-		"method",
-		"mid-air",
-		"middle",
-		"midi-chlorians",
-		"midst",
-		"might",
-		"military",
-		"millennium",
-		"million",
-		"mimban",
-		"mind",
-		"mine",
-		"mines",
-		"minimum",
-		"mining",
-		"minions",
-		"missed",
-		"misses",
-		"missile",
-		"missiles",
-		"missing",
-		"mission",
-		"mistake",
-		"mistaken",
-		"mistakenly",
-		"mistakes",
-		"mitaka",
-		"moaning",
-		"mobility",
-		"mocks",
-		"model",
-		"module",
-		"moff",
-		"moisture",
-		"molten",
-		"moment",
-		"momentarily",
-		"moments",
-		"momentum",
-		"mon",
-		"money",
-		"monitors",
-		"monster",
-		"monstrous",
-		"month",
-		"moon",
-		"moon-sized",
-		"moral",
-		"more",
-		"morning",
-		"mortally",
-		"mos",
-		"most",
-		"mother",
-		"mothma",
-		"motion",
-		"motionless",
-		"mount",
-		"mounting",
-		"mourn",
-		"mourning",
-		"mouth",
-		"move",
-		"moved",
-		"moves",
-		"movie",
-		"movies",
-		"much",
-		"muffled",
-		"multiple",
-		"mumbling",
-		"murder",
-		"murdered",
-		"murdering",
-		"music",
-		"musical",
-		"must",
-		"mustafar",
-		"musters",
-		"mutilated",
-		"mutiny",
-		"mygeeto",
-		"mynocks",
-		"mysterious",
-		"mysteriously",
-		"mystical",
-		"naboo",
-		"name",
-		"named",
-		"names",
-		"narrowly",
-		"natural",
-		"navy",
-		"near",
-		"nearby",
-		"nearest",
-		"nearly",
-		"nears",
-		"nebulon-b",
-		"necessarily",
-		"necessary",
-		"neck",
-		"need",
-		"needa",
-		"needed",
-		"needing",
-		"needs",
-		"negative",
-		"negatively",
-		"negotiator",
-		"neimoidia",
-		"neimoidian",
-		"neither",
-		"nephew",
-		"nerf",
-		"nest",
-		"net",
-		"netal",
-		"network",
-		"neutralize",
-		"never",
-		"nevertheless",
-		"new",
-		"newest",
-		"news",
-		"next",
-		"night",
-		"niima",
-		"nineteen",
-		"nominates",
-		"nomination",
-		"non-conformity",
-		"non-corporeal",
-		"nonetheless",
-		"nooo",
-		"not",
-		"notes",
-		"nothing",
-		"notice",
-		"noticed",
-		"notices",
-		"notified",
-		"notifies",
-		"noting",
-		"notorious",
-		"november",
-		"now",
-		"now-frozen",
-		"nower",
-		"nudo",
-		"number",
-		"numbers",
-		"numerous",
-		"nute",
-		"obi-wan",
-		"objective",
-		"obtain",
-		"obtained",
-		"occupied",
-		"occur",
-		"occurred",
-		"occurs",
-		"odds",
-		"off",
-		"off-screen",
-		"offense",
-		"offer",
-		"offered",
-		"offering",
-		"offers",
-		"office",
-		"officer",
-		"officers",
-		"old",
-		"older",
-		"onboard",
-		"once",
-		"oncoming",
-		"one",
-		"ongoing",
-		"only",
-		"onto",
-		"oola",
-		"oolin",
-		"open",
-		"opening",
-		"openly",
-		"opens",
-		"opera",
-		"operating",
-		"operation",
-		"operational",
-		"operatives",
-		"opportunity",
-		"opposition",
-		"option",
-		"options",
-		"opulence",
-		"orbital",
-		"orchestrated",
-		"ordeal",
-		"order",
-		"ordered",
-		"ordering",
-		"orders",
-		"organa",
-		"organic",
-		"organization",
-		"organs",
-		"originally",
-		"orson",
-		"oscillator",
-		"other",
-		"others",
-		"oung",
-		"our",
-		"out",
-		"outburst",
-		"outcrop",
-		"outer",
-		"outgoing",
-		"outnumbered",
-		"outpost",
-		"outraged",
-		"outside",
-		"outskirts",
-		"outweighs",
-		"over",
-		"overcome",
-		"overdue",
-		"overland",
-		"overlooking",
-		"overpower",
-		"overpowered",
-		"overpowers",
-		"overrules",
-		"oversee",
-		"overseer",
-		"overshoots",
-		"overtake",
-		"overtakes",
-		"overthrow",
-		"overwhelmed",
-		"owed",
-		"owen",
-		"owes",
-		"owing",
-		"own",
-		"owners",
-		"ozzel",
-		"packet",
-		"padawan",
-		"padawans",
-		"paige",
-		"pain",
-		"pair",
-		"palace",
-		"pale",
-		"pallid",
-		"palpatine",
-		"pamlo",
-		"panel",
-		"panic",
-		"parasitic",
-		"parental",
-		"parents",
-		"park",
-		"parsecs",
-		"part",
-		"parted",
-		"partial",
-		"partially",
-		"particular",
-		"parties",
-		"partisan",
-		"partisans",
-		"partly",
-		"partner",
-		"parts",
-		"party",
-		"pass",
-		"passage",
-		"passes",
-		"passion",
-		"passionate",
-		"past",
-		"path",
-		"pathway",
-		"patience",
-		"patient",
-		"patrol",
-		"pau",
-		"pause",
-		"paying",
-		"payment",
-		"peace",
-		"peacefully",
-		"peel",
-		"penance",
-		"pendant",
-		"penetrate",
-		"people",
-		"perceived",
-		"percentage",
-		"perform",
-		"performing",
-		"perished",
-		"permanently",
-		"permission",
-		"person",
-		"personal",
-		"personally",
-		"personnel",
-		"persuade",
-		"persuasion",
-		"persuasions",
-		"perturbed",
-		"petitions",
-		"phase",
-		"phasma",
-		"philiosophy",
-		"photography",
-		"phrase",
-		"physical",
-		"physically",
-		"pick",
-		"picked",
-		"picks",
-		"piece",
-		"pieces",
-		"piercing",
-		"piett",
-		"pilot",
-		"piloting",
-		"pilots",
-		"pistol",
-		"pit",
-		"pitiful",
-		"place",
-		"placed",
-		"places",
-		"placing",
-		"plagueis",
-		"plain",
-		"plan",
-		"planet",
-		"planetary",
-		"planets",
-		"plank",
-		"planned",
-		"plans",
-		"planting",
-		"plates",
-		"platform",
-		"platforms",
-		"play",
-		"playfully",
-		"plays",
-		"plaza",
-		"plea",
-		"pleading",
-		"pleads",
-		"pleas",
-		"pleased",
-		"pleasure",
-		"pledges",
-		"plo",
-		"plot",
-		"plunging",
-		"plutt",
-		"pod",
-		"podrace",
-		"poe",
-		"poggle",
-		"point",
-		"point-blank",
-		"point-defense",
-		"pokes",
-		"police",
-		"polis",
-		"political",
-		"port",
-		"portions",
-		"posing",
-		"position",
-		"possession",
-		"possessions",
-		"possible",
-		"potential",
-		"power",
-		"powered",
-		"powerful",
-		"powerless",
-		"powers",
-		"praised",
-		"praises",
-		"praying",
-		"prays",
-		"pregnant",
-		"prepare",
-		"prepares",
-		"preparing",
-		"prequel",
-		"presence",
-		"present",
-		"presented",
-		"presents",
-		"presumably",
-		"presumed",
-		"presumptuousness",
-		"pretext",
-		"prevent",
-		"prevents",
-		"previous",
-		"previously",
-		"price",
-		"pride",
-		"primarily",
-		"primary",
-		"prime",
-		"princess",
-		"principal",
-		"prior",
-		"prison",
-		"prisoner",
-		"prisoners",
-		"private",
-		"probably",
-		"probe",
-		"probing",
-		"problem",
-		"problems",
-		"proceeding",
-		"proceeds",
-		"process",
-		"proclaim",
-		"proclaimed",
-		"proclaims",
-		"procure",
-		"prodded",
-		"prodigious",
-		"producer",
-		"production",
-		"profiteers",
-		"profits",
-		"profundity",
-		"project",
-		"projected",
-		"projection",
-		"promise",
-		"promises",
-		"prompting",
-		"promptly",
-		"proper",
-		"property",
-		"propose",
-		"proposes",
-		"prosthetic",
-		"protect",
-		"protecting",
-		"protection",
-		"protests",
-		"protocol",
-		"proton",
-		"proud",
-		"proves",
-		"provide",
-		"pry",
-		"psychologically",
-		"public",
-		"pull",
-		"pulled",
-		"pulling",
-		"pulls",
-		"punches",
-		"punished",
-		"pursue",
-		"pursued",
-		"pursuers",
-		"pursues",
-		"pursuing",
-		"pursuit",
-		"push",
-		"pushes",
-		"pushing",
-		"put",
-		"putting",
-		"pyre",
-		"quadjumper",
-		"quarter",
-		"quarters",
-		"quarterstaff",
-		"queen",
-		"quest",
-		"question",
-		"questions",
-		"qui-gon",
-		"quick",
-		"quickly",
-		"quiet",
-		"quietly",
-		"quiggold",
-		"race",
-		"racer",
-		"racetrack",
-		"raddus",
-		"rage",
-		"rage-fueled",
-		"rages",
-		"raid",
-		"railing",
-		"rain",
-		"raise",
-		"raised",
-		"ralter",
-		"ram",
-		"ramp",
-		"rampages",
-		"rancor",
-		"range",
-		"rank",
-		"rants",
-		"rate",
-		"rather",
-		"rathtar",
-		"rathtars",
-		"ravaged",
-		"ray",
-		"raymus",
-		"re-activate",
-		"re-emergence",
-		"re-evaluated",
-		"re-evaluates",
-		"re-open",
-		"reach",
-		"reached",
-		"reaches",
-		"reaching",
-		"reacted",
-		"reaction",
-		"reactions",
-		"reactor",
-		"reacts",
-		"ready",
-		"real",
-		"realization",
-		"realize",
-		"realized",
-		"realizes",
-		"realizing",
-		"really",
-		"rear",
-		"rear-guard",
-		"reason",
-		"reassure",
-		"rebel",
-		"rebellion",
-		"rebels",
-		"rebo",
-		"rebuild",
-		"rebuilt",
-		"recalibrate",
-		"recalls",
-		"recaptured",
-		"receive",
-		"received",
-		"receives",
-		"receiving",
-		"recently-built",
-		"reckless",
-		"recklessness",
-		"recognizes",
-		"recognizing",
-		"reconnected",
-		"reconstructing",
-		"record",
-		"recording",
-		"recordings",
-		"recovered",
-		"recovers",
-		"recruit",
-		"recruiting",
-		"recycled",
-		"red",
-		"redeemed",
-		"redemption",
-		"reduced",
-		"reestablished",
-		"reference",
-		"referred",
-		"refined",
-		"reflection",
-		"reflective",
-		"refresh",
-		"refuse",
-		"refuses",
-		"refusing",
-		"regain",
-		"regains",
-		"regale",
-		"regime",
-		"regretful",
-		"regroup",
-		"reigns",
-		"reinforce",
-		"reinforcements",
-		"reject",
-		"rejects",
-		"rejoin",
-		"rejuvenation",
-		"relays",
-		"release",
-		"released",
-		"releases",
-		"relent",
-		"relented",
-		"relics",
-		"relief",
-		"relieves",
-		"reluctant",
-		"reluctantly",
-		"relying",
-		"remain",
-		"remainder",
-		"remaining",
-		"remains",
-		"remark",
-		"remarkable",
-		"remarking",
-		"remarks",
-		"remembering",
-		"remembers",
-		"reminding",
-		"reminds",
-		"remonstrates",
-		"remote",
-		"remove",
-		"removes",
-		"removing",
-		"ren",
-		"rendered",
-		"rendering",
-		"rendezvous",
-		"reneging",
-		"reorganized",
-		"repairs",
-		"repeatedly",
-		"repeating",
-		"repeats",
-		"repels",
-		"replacement",
-		"replies",
-		"report",
-		"reported",
-		"reporting",
-		"reports",
-		"represent",
-		"reprimands",
-		"reprogrammed",
-		"republic",
-		"repulses",
-		"reputed",
-		"request",
-		"requests",
-		"required",
-		"requires",
-		"rescue",
-		"rescued",
-		"rescues",
-		"rescuing",
-		"research",
-		"resided",
-		"resident",
-		"resides",
-		"resistance",
-		"resists",
-		"resolve",
-		"resolves",
-		"resources",
-		"respects",
-		"respirator",
-		"responds",
-		"response",
-		"responsibility",
-		"responsible",
-		"rest",
-		"restart",
-		"restore",
-		"restrains",
-		"restraint",
-		"restraints",
-		"result",
-		"resulting",
-		"results",
-		"retain",
-		"retorts",
-		"retract",
-		"retreat",
-		"retreats",
-		"retrieval",
-		"retrieve",
-		"retrieved",
-		"return",
-		"returned",
-		"returning",
-		"returns",
-		"reunion",
-		"reunite",
-		"reunited",
-		"reveal",
-		"revealed",
-		"revealing",
-		"reveals",
-		"revelations",
-		"revenge",
-		"revisits",
-		"reward",
-		"rey",
-		"rick",
-		"riddling",
-		"ride",
-		"riders",
-		"riding",
-		"rieekan",
-		"right",
-		"rigorous",
-		"rim",
-		"rims",
-		"ring",
-		"riot",
-		"rips",
-		"rise",
-		"rising",
-		"risky",
-		"rival",
-		"river",
-		"robert",
-		"rock",
-		"rock-carved",
-		"rogue",
-		"role",
-		"rolls",
-		"ron",
-		"rook",
-		"room",
-		"rose",
-		"rotten",
-		"rough",
-		"route",
-		"row",
-		"royal",
-		"ruminates",
-		"rumor",
-		"rumored",
-		"rumors",
-		"rumours",
-		"run",
-		"rune",
-		"running",
-		"runs",
-		"rupturing",
-		"rush",
-		"rushes",
-		"sabacc",
-		"saber",
-		"sack",
-		"sacrifice",
-		"sacrifices",
-		"sacrificing",
-		"sadness",
-		"saesee",
-		"safe",
-		"safekeeping",
-		"safely",
-		"safety",
-		"saga",
-		"sagged",
-		"said",
-		"sail",
-		"sails",
-		"salacious",
-		"sales",
-		"saleucami",
-		"salt",
-		"salvage",
-		"same",
-		"san",
-		"sand",
-		"sanity",
-		"sarlacc",
-		"satchel",
-		"satirical",
-		"satisfied",
-		"saturday",
-		"savareen",
-		"save",
-		"saved",
-		"saving",
-		"saw",
-		"saying",
-		"says",
-		"scanning",
-		"scared",
-		"scarif",
-		"scarred",
-		"scars",
-		"scatter",
-		"scavenger",
-		"scavenging",
-		"scene",
-		"scenes",
-		"schedule",
-		"schematics",
-		"scientist",
-		"scour",
-		"scout",
-		"scraps",
-		"scream",
-		"screaming",
-		"screams",
-		"screeching",
-		"script",
-		"scruffy-looking",
-		"scrumrat",
-		"scuffle",
-		"scuffles",
-		"sea",
-		"sealed",
-		"seals",
-		"search",
-		"searching",
-		"second",
-		"seconds",
-		"secrecy",
-		"secret",
-		"secretly",
-		"sector",
-		"secura",
-		"secure",
-		"securing",
-		"security",
-		"seduced",
-		"seduces",
-		"see",
-		"seeing",
-		"seek",
-		"seeking",
-		"seeks",
-		"seem",
-		"seeming",
-		"seems",
-		"seen",
-		"sees",
-		"seized",
-		"self",
-		"self-imposed",
-		"selfless",
-		"sell",
-		"selling",
-		"senate",
-		"senator",
-		"senators",
-		"send",
-		"sending",
-		"sends",
-		"senesca",
-		"sense",
-		"sensed",
-		"senses",
-		"sensing",
-		"sensors",
-		"sent",
-		"sentences",
-		"separate",
-		"separately",
-		"separates",
-		"separatist",
-		"separatists",
-		"september",
-		"serial",
-		"series",
-		"servant",
-		"serve",
-		"serves",
-		"services",
-		"serving",
-		"session",
-		"set",
-		"sets",
-		"setting",
-		"settlement",
-		"settles",
-		"several",
-		"severe",
-		"severed",
-		"severely",
-		"severing",
-		"severs",
-		"seville",
-		"shadows",
-		"shaft",
-		"shaken",
-		"shall",
-		"shame",
-		"share",
-		"shared",
-		"shares",
-		"sharing",
-		"shattered",
-		"shattering",
-		"she",
-		"sheev",
-		"shelter",
-		"shield",
-		"shields",
-		"shift",
-		"shifts",
-		"ship",
-		"shipbuilding",
-		"shipment",
-		"ships",
-		"shock",
-		"shocked",
-		"shocks",
-		"shoot",
-		"shooting",
-		"shoots",
-		"shore",
-		"short",
-		"shorter",
-		"shortly",
-		"shot",
-		"shots",
-		"should",
-		"shoulder",
-		"shouts",
-		"show",
-		"showed",
-		"showing",
-		"shows",
-		"shred",
-		"shut",
-		"shuts",
-		"shuttle",
-		"side",
-		"side-effects",
-		"sides",
-		"sidi",
-		"sidious",
-		"sidon",
-		"siege",
-		"sight",
-		"sighted",
-		"sign",
-		"signal",
-		"signature",
-		"signs",
-		"silenced",
-		"silencer",
-		"silent",
-		"silently",
-		"similar",
-		"simplistic",
-		"simply",
-		"simultaneously",
-		"since",
-		"single",
-		"singlehandedly",
-		"sinks",
-		"sister",
-		"sit",
-		"site",
-		"sith",
-		"sits",
-		"sitting",
-		"situation",
-		"six",
-		"sixty",
-		"size",
-		"sketch",
-		"ski",
-		"skiff",
-		"skiffs",
-		"skilled",
-		"skills",
-		"sky",
-		"skywalker",
-		"slack",
-		"slain",
-		"slake",
-		"slash",
-		"slashing",
-		"slaughter",
-		"slaughtered",
-		"slaughtering",
-		"slaughters",
-		"slave",
-		"slaying",
-		"sleep",
-		"sleeping",
-		"sleeve",
-		"sleight",
-		"slice",
-		"slicer",
-		"slices",
-		"slicing",
-		"slight",
-		"slowen",
-		"slowly",
-		"slug",
-		"small",
-		"smashes",
-		"smashing",
-		"smears",
-		"smiles",
-		"smiling",
-		"smoke",
-		"smoking",
-		"smuggler",
-		"smugglers",
-		"smuggling",
-		"snatches",
-		"sneak",
-		"snippet",
-		"snoke",
-		"snootles",
-		"snow",
-		"snowspeeder",
-		"snowspeeders",
-		"snowtroopers",
-		"snowy",
-		"so-called",
-		"society",
-		"solar",
-		"sold",
-		"soldiers",
-		"solemn",
-		"solo",
-		"solution",
-		"solved",
-		"some",
-		"someday",
-		"something",
-		"son",
-		"soon",
-		"sought",
-		"sound",
-		"source",
-		"sources",
-		"space",
-		"spain",
-		"speak",
-		"speaks",
-		"spearheading",
-		"special",
-		"speechless",
-		"speeder",
-		"speeders",
-		"speeds",
-		"sphyrna-class",
-		"spider",
-		"spielberg",
-		"spies",
-		"spikes",
-		"spin",
-		"spiraling",
-		"spirit",
-		"spirits",
-		"split",
-		"spot",
-		"spots",
-		"spotted",
-		"spread",
-		"spreading",
-		"spy",
-		"spying",
-		"squad",
-		"squadmate",
-		"squadron",
-		"squadrons",
-		"square",
-		"squeezing",
-		"squid",
-		"stability",
-		"stable",
-		"stables",
-		"staccato",
-		"staff",
-		"stage",
-		"staggers",
-		"staging",
-		"stairs",
-		"stalks",
-		"stalling",
-		"stampede",
-		"stand",
-		"stand-off",
-		"standard",
-		"standing",
-		"star",
-		"stardust",
-		"stares",
-		"starfighter",
-		"starfighters",
-		"staring",
-		"starkiller",
-		"stars",
-		"starship",
-		"starships",
-		"start",
-		"starting",
-		"starts",
-		"stashed",
-		"stasis-pod",
-		"stass",
-		"state",
-		"stated",
-		"statement",
-		"states",
-		"stating",
-		"station",
-		"stationed",
-		"stay",
-		"stays",
-		"steal",
-		"stealing",
-		"steals",
-		"stealth",
-		"stealthily",
-		"step",
-		"steps",
-		"steve",
-		"steven",
-		"still",
-		"stole",
-		"stolen",
-		"stone",
-		"stop",
-		"stopping",
-		"stops",
-		"stored",
-		"stories",
-		"storm",
-		"storms",
-		"stormtrooper",
-		"stormtroopers",
-		"stormy",
-		"story",
-		"stowaways",
-		"stows",
-		"strafing",
-		"straight",
-		"strangle",
-		"strangles",
-		"streak",
-		"streets",
-		"strength",
-		"strike",
-		"strikes",
-		"striking",
-		"stripping",
-		"strokes",
-		"strong",
-		"structural",
-		"structure",
-		"struggle",
-		"struggles",
-		"struggling",
-		"stuck-up",
-		"student",
-		"studio",
-		"studios",
-		"study",
-		"stun",
-		"stuns",
-		"stupor",
-		"sub-chamber",
-		"subdue",
-		"subjects",
-		"submerged",
-		"submission",
-		"submit",
-		"submits",
-		"subsequent",
-		"subsequently",
-		"substantial",
-		"subtle",
-		"subtly",
-		"succeeding",
-		"succeeds",
-		"success",
-		"successful",
-		"successfully",
-		"successor",
-		"succumb",
-		"such",
-		"sucked",
-		"suddenly",
-		"suffer",
-		"suffered",
-		"suffering",
-		"suffers",
-		"suggesting",
-		"suggests",
-		"suicide",
-		"sullust",
-		"summa-verminoth",
-		"summoned",
-		"summons",
-		"sun",
-		"sunlight",
-		"suns",
-		"sunset",
-		"super",
-		"superior",
-		"superlaser",
-		"superweapon",
-		"supplies",
-		"support",
-		"supremacy",
-		"supreme",
-		"sure",
-		"surely",
-		"surface",
-		"surgery",
-		"surname",
-		"surprise",
-		"surprised",
-		"surprises",
-		"surrender",
-		"surrendered",
-		"surrounded",
-		"surrounding",
-		"survey",
-		"surveys",
-		"survive",
-		"survived",
-		"survives",
-		"surviving",
-		"suspected",
-		"suspects",
-		"suspended",
-		"suspicious",
-		"sustains",
-		"swamp",
-		"swamping",
-		"sweat",
-		"sweep",
-		"sweeping",
-		"swept",
-		"swift",
-		"swiftly",
-		"swing",
-		"switch",
-		"swoop",
-		"sword",
-		"swordsmaster",
-		"sydney",
-		"sympathetic",
-		"sympathizer",
-		"syndicate",
-		"system",
-		"systems",
-		"table",
-		"tactical",
-		"tactics",
-		"take",
-		"taken",
-		"takes",
-		"taking",
-		"takodana",
-		"talkative",
-		"talking",
-		"talks",
-		"tambor",
-		"tangle",
-		"tank",
-		"tanks",
-		"tantive",
-		"tantrum",
-		"taps",
-		"tarfful",
-		"target",
-		"tarkin",
-		"task",
-		"tasked",
-		"tataouine",
-		"tatooine",
-		"tatooinian",
-		"taught",
-		"tauntaun",
-		"taunted",
-		"taunting",
-		"teach",
-		"teaching",
-		"team",
-		"teams",
-		"tearfully",
-		"tearing",
-		"tears",
-		"technician",
-		"technique",
-		"techno",
-		"teedo",
-		"teeth",
-		"tekka",
-		"telekinesis",
-		"telepathic",
-		"telepathically",
-		"tell",
-		"telling",
-		"tells",
-		"temiri",
-		"temper",
-		"temperatures",
-		"temple",
-		"temporary",
-		"tempt",
-		"tempted",
-		"tentacles",
-		"tenth",
-		"terminally-ill",
-		"termination",
-		"terrain",
-		"terrified",
-		"territories",
-		"terror",
-		"test",
-		"test-firing",
-		"testing",
-		"texts",
-		"than",
-		"thanks",
-		"that",
-		"the",
-		"theaters",
-		"theed",
-		"their",
-		"them",
-		"themselves",
-		"then",
-		"there",
-		"thereby",
-		"therefore",
-		"thermal",
-		"these",
-		"they",
-		"thief",
-		"thieves",
-		"thin",
-		"things",
-		"thinking",
-		"thinks",
-		"third",
-		"thire",
-		"thirst",
-		"thirsting",
-		"thirteen",
-		"thirty",
-		"thirty-four",
-		"this",
-		"those",
-		"though",
-		"thought",
-		"thousand",
-		"thousands",
-		"threat",
-		"threatened",
-		"threatening",
-		"threatens",
-		"three",
-		"threepio",
-		"throat",
-		"throne",
-		"through",
-		"throughout",
-		"throw",
-		"throwing",
-		"thrown",
-		"throws",
-		"thrusters",
-		"thrusting",
-		"thugs",
-		"thunderbolt",
-		"thunderous",
-		"thus",
-		"thwarted",
-		"tiaan",
-		"ticket",
-		"tico",
-		"tide",
-		"tie",
-		"ties",
-		"tighten",
-		"tightly",
-		"tiin",
-		"timberlake",
-		"time",
-		"times",
-		"tipped",
-		"titanic",
-		"tivik",
-		"tobias",
-		"together",
-		"told",
-		"tolerate",
-		"tolerated",
-		"toll",
-		"tomder",
-		"too",
-		"took",
-		"top",
-		"topple",
-		"topples",
-		"torn",
-		"torpedoes",
-		"torrent",
-		"torso",
-		"torture",
-		"torturing",
-		"torturous",
-		"tossing",
-		"touching",
-		"tow",
-		"toward",
-		"towards",
-		"tower",
-		"town",
-		"track",
-		"tracker",
-		"tracks",
-		"tractor",
-		"trade",
-		"trading",
-		"traffic",
-		"tragedy",
-		"train",
-		"trained",
-		"training",
-		"traitor",
-		"traitors",
-		"transfer",
-		"translator",
-		"transmission",
-		"transmissions",
-		"transmit",
-		"transmits",
-		"transmitted",
-		"transmitter",
-		"transport",
-		"transportation",
-		"transporting",
-		"transports",
-		"trap",
-		"trapdoor",
-		"trapped",
-		"traps",
-		"trash",
-		"travel",
-		"traveling",
-		"travels",
-		"traverses",
-		"tray",
-		"treasures",
-		"treatment",
-		"tree",
-		"trench",
-		"trenches",
-		"trial",
-		"trials",
-		"tribal",
-		"tribe",
-		"trick",
-		"tried",
-		"tries",
-		"triggers",
-		"trilogy",
-		"trio",
-		"trooper",
-		"troopers",
-		"troops",
-		"trophy",
-		"tropical",
-		"troubled",
-		"trough",
-		"true",
-		"truly",
-		"trust",
-		"truth",
-		"truthful",
-		"try",
-		"trying",
-		"tuanul",
-		"tug",
-		"tugging",
-		"tumbles",
-		"tunisia",
-		"turbolaser",
-		"turbolasers",
-		"turn",
-		"turned",
-		"turning",
-		"turns",
-		"twenty",
-		"twin",
-		"twins",
-		"twisted",
-		"twists",
-		"two",
-		"tynnra",
-		"tyranus",
-		"tythoni",
-		"u-wing",
-		"ultimate",
-		"ultimately",
-		"unable",
-		"unanesthesized",
-		"unassailable",
-		"unauthorized",
-		"unaware",
-		"unbeknownst",
-		"uncertain",
-		"uncle",
-		"unconscious",
-		"uncontrollably",
-		"uncontrolled",
-		"under",
-		"underbelly",
-		"underestimate",
-		"undergo",
-		"underneath",
-		"understands",
-		"undeveloped",
-		"undoing",
-		"unduli",
-		"unenthusiastic",
-		"unfortunate",
-		"unfortunately",
-		"unhappy",
-		"uniforms",
-		"union",
-		"unit",
-		"united",
-		"unkar",
-		"unknown",
-		"unleash",
-		"unleashes",
-		"unless",
-		"unlike",
-		"unnatural",
-		"unprecedented",
-		"unprocessed",
-		"unscathed",
-		"unsuccessful",
-		"until",
-		"untrained",
-		"unusually",
-		"upfront",
-		"upon",
-		"upper",
-		"upright",
-		"uprising",
-		"upset",
-		"upside",
-		"urge",
-		"urges",
-		"uscru",
-		"use",
-		"used",
-		"uses",
-		"ushered",
-		"using",
-		"utapau",
-		"utterly",
-		"vader",
-		"vague",
-		"vain",
-		"valid",
-		"valuable",
-		"vander",
-		"vandor",
-		"vanished",
-		"vanishes",
-		"varactyl",
-		"various",
-		"vasp",
-		"vaspar",
-		"vault",
-		"veers",
-		"vehicle",
-		"vehicles",
-		"venator-class",
-		"vent",
-		"verifying",
-		"very",
-		"vessel",
-		"vessels",
-		"via",
-		"vice",
-		"viceroy",
-		"vicinity",
-		"viciously",
-		"victims",
-		"victories",
-		"victorious",
-		"victory",
-		"video",
-		"view",
-		"vile",
-		"villa",
-		"village",
-		"villagers",
-		"violation",
-		"violent",
-		"violently",
-		"visible",
-		"vision",
-		"visions",
-		"visits",
-		"vital",
-		"vocabulator",
-		"voice",
-		"volatile",
-		"volcanic",
-		"volley",
-		"volunteer",
-		"vos",
-		"vows",
-		"vulnerability",
-		"vulnerable",
-		"wager",
-		"wait",
-		"waiting",
-		"waking",
-		"walker",
-		"walkers",
-		"walking",
-		"walks",
-		"wall",
-		"walls",
-		"wampa",
-		"wandering",
-		"wanders",
-		"want",
-		"wanted",
-		"wanting",
-		"wants",
-		"war",
-		"ward",
-		"warn",
-		"warned",
-		"warning",
-		"warns",
-		"warrick",
-		"warrior",
-		"wars",
-		"wary",
-		"was",
-		"waste",
-		"wastes",
-		"wat",
-		"watch",
-		"watches",
-		"watching",
-		"water",
-		"waterfall",
-		"waterworld",
-		"wave",
-		"way",
-		"ways",
-		"weak",
-		"weakened",
-		"wealth",
-		"wealthy",
-		"weapon",
-		"weapons",
-		"wearing",
-		"wedge",
-		"welcomed",
-		"welcomes",
-		"welcoming",
-		"well",
-		"went",
-		"were",
-		"wes",
-		"whacks",
-		"what",
-		"whatever",
-		"wheel",
-		"when",
-		"where",
-		"whereas",
-		"wherein",
-		"whether",
-		"which",
-		"while",
-		"whilst",
-		"whippendell",
-		"white",
-		"who",
-		"whole",
-		"whom",
-		"whose",
-		"why",
-		"wickedly",
-		"wicket",
-		"wide-awake",
-		"widowed",
-		"wielded",
-		"wielding",
-		"wields",
-		"wife",
-		"wild",
-		"wilhuff",
-		"will",
-		"willing",
-		"wily",
-		"win",
-		"window",
-		"windu",
-		"wing",
-		"wingman",
-		"wings",
-		"wins",
-		"wipe",
-		"wire",
-		"wisdom",
-		"wise",
-		"wishes",
-		"with",
-		"withdraws",
-		"within",
-		"without",
-		"witness",
-		"witnessed",
-		"witnesses",
-		"wobani",
-		"woman",
-		"won",
-		"wondering",
-		"wood",
-		"woods",
-		"wookiee",
-		"wookiees",
-		"word",
-		"words",
-		"work",
-		"working",
-		"works",
-		"world",
-		"worry",
-		"worst",
-		"would",
-		"wound",
-		"wounded",
-		"wounding",
-		"wounds",
-		"wrath",
-		"wreckage",
-		"wrecked",
-		"writhing",
-		"written",
-		"wroshyr",
-		"x-wing",
-		"x-wings",
-		"y-wing",
-		"y-wings",
-		"yacht",
-		"yavin",
-		"years",
-		"yelling",
-		"yellow",
-		"yells",
-		"yet",
-		"yoda",
-		"you",
-		"young",
-		"younger",
-		"younglings",
-		"youngster",
-		"your",
-		"zemeckis",
-		"zett",
-		"zev",
-		"zone",
-		"zuckuss",
-	},
-	"eff": {
-		"abacus",
-		"abdomen",
-		"abdominal",
-		"abide",
-		"abiding",
-		"ability",
-		"ablaze",
-		"able",
-		"abnormal",
-		"abrasion",
-		"abrasive",
-		"abreast",
-		"abridge",
-		"abroad",
-		"abruptly",
-		"absence",
-		"absentee",
-		"absently",
-		"absinthe",
-		"absolute",
-		"absolve",
-		"abstain",
-		"abstract",
-		"absurd",
-		"accent",
-		"acclaim",
-		"acclimate",
-		"accompany",
-		"account",
-		"accuracy",
-		"accurate",
-		"accustom",
-		"acetone",
-		"achiness",
-		"aching",
-		"acid",
-		"acorn",
-		"acquaint",
-		"acquire",
-		"acre",
-		"acrobat",
-		"acronym",
-		"acting",
-		"action",
-		"activate",
-		"activator",
-		"active",
-		"activism",
-		"activist",
-		"activity",
-		"actress",
-		"acts",
-		"acutely",
-		"acuteness",
-		"aeration",
-		"aerobics",
-		"aerosol",
-		"aerospace",
-		"afar",
-		"affair",
-		"affected",
-		"affecting",
-		"affection",
-		"affidavit",
-		"affiliate",
-		"affirm",
-		"affix",
-		"afflicted",
-		"affluent",
-		"afford",
-		"affront",
-		"aflame",
-		"afloat",
-		"aflutter",
-		"afoot",
-		"afraid",
-		"afterglow",
-		"afterlife",
-		"aftermath",
-		"aftermost",
-		"afternoon",
-		"aged",
-		"ageless",
-		"agency",
-		"agenda",
-		"agent",
-		"aggregate",
-		"aghast",
-		"agile",
-		"agility",
-		"aging",
-		"agnostic",
-		"agonize",
-		"agonizing",
-		"agony",
-		"agreeable",
-		"agreeably",
-		"agreed",
-		"agreeing",
-		"agreement",
-		"aground",
-		"ahead",
-		"ahoy",
-		"aide",
-		"aids",
-		"aim",
-		"ajar",
-		"alabaster",
-		"alarm",
-		"albatross",
-		"album",
-		"alfalfa",
-		"algebra",
-		"algorithm",
-		"alias",
-		"alibi",
-		"alienable",
-		"alienate",
-		"aliens",
-		"alike",
-		"alive",
-		"alkaline",
-		"alkalize",
-		"almanac",
-		"almighty",
-		"almost",
-		"aloe",
-		"aloft",
-		"aloha",
-		"alone",
-		"alongside",
-		"aloof",
-		"alphabet",
-		"alright",
-		"although",
-		"altitude",
-		"alto",
-		"aluminum",
-		"alumni",
-		"always",
-		"amaretto",
-		"amaze",
-		"amazingly",
-		"amber",
-		"ambiance",
-		"ambiguity",
-		"ambiguous",
-		"ambition",
-		"ambitious",
-		"ambulance",
-		"ambush",
-		"amendable",
-		"amendment",
-		"amends",
-		"amenity",
-		"amiable",
-		"amicably",
-		"amid",
-		"amigo",
-		"amino",
-		"amiss",
-		"ammonia",
-		"ammonium",
-		"amnesty",
-		"amniotic",
-		"among",
-		"amount",
-		"amperage",
-		"ample",
-		"amplifier",
-		"amplify",
-		"amply",
-		"amuck",
-		"amulet",
-		"amusable",
-		"amused",
-		"amusement",
-		"amuser",
-		"amusing",
-		"anaconda",
-		"anaerobic",
-		"anagram",
-		"anatomist",
-		"anatomy",
-		"anchor",
-		"anchovy",
-		"ancient",
-		"android",
-		"anemia",
-		"anemic",
-		"aneurism",
-		"anew",
-		"angelfish",
-		"angelic",
-		"anger",
-		"angled",
-		"angler",
-		"angles",
-		"angling",
-		"angrily",
-		"angriness",
-		"anguished",
-		"angular",
-		"animal",
-		"animate",
-		"animating",
-		"animation",
-		"animator",
-		"anime",
-		"animosity",
-		"ankle",
-		"annex",
-		"annotate",
-		"announcer",
-		"annoying",
-		"annually",
-		"annuity",
-		"anointer",
-		"another",
-		"answering",
-		"antacid",
-		"antarctic",
-		"anteater",
-		"antelope",
-		"antennae",
-		"anthem",
-		"anthill",
-		"anthology",
-		"antibody",
-		"antics",
-		"antidote",
-		"antihero",
-		"antiquely",
-		"antiques",
-		"antiquity",
-		"antirust",
-		"antitoxic",
-		"antitrust",
-		"antiviral",
-		"antivirus",
-		"antler",
-		"antonym",
-		"antsy",
-		"anvil",
-		"anybody",
-		"anyhow",
-		"anymore",
-		"anyone",
-		"anyplace",
-		"anything",
-		"anytime",
-		"anyway",
-		"anywhere",
-		"aorta",
-		"apache",
-		"apostle",
-		"appealing",
-		"appear",
-		"appease",
-		"appeasing",
-		"appendage",
-		"appendix",
-		"appetite",
-		"appetizer",
-		"applaud",
-		"applause",
-		"apple",
-		"appliance",
-		"applicant",
-		"applied",
-		"apply",
-		"appointee",
-		"appraisal",
-		"appraiser",
-		"apprehend",
-		"approach",
-		"approval",
-		"approve",
-		"apricot",
-		"april",
-		"apron",
-		"aptitude",
-		"aptly",
-		"aqua",
-		"aqueduct",
-		"arbitrary",
-		"arbitrate",
-		"ardently",
-		"area",
-		"arena",
-		"arguable",
-		"arguably",
-		"argue",
-		"arise",
-		"armadillo",
-		"armband",
-		"armchair",
-		"armed",
-		"armful",
-		"armhole",
-		"arming",
-		"armless",
-		"armoire",
-		"armored",
-		"armory",
-		"armrest",
-		"army",
-		"aroma",
-		"arose",
-		"around",
-		"arousal",
-		"arrange",
-		"array",
-		"arrest",
-		"arrival",
-		"arrive",
-		"arrogance",
-		"arrogant",
-		"arson",
-		"art",
-		"ascend",
-		"ascension",
-		"ascent",
-		"ascertain",
-		"ashamed",
-		"ashen",
-		"ashes",
-		"ashy",
-		"aside",
-		"askew",
-		"asleep",
-		"asparagus",
-		"aspect",
-		"aspirate",
-		"aspire",
-		"aspirin",
-		"astonish",
-		"astound",
-		"astride",
-		"astrology",
-		"astronaut",
-		"astronomy",
-		"astute",
-		"atlantic",
-		"atlas",
-		"atom",
-		"atonable",
-		"atop",
-		"atrium",
-		"atrocious",
-		"atrophy",
-		"attach",
-		"attain",
-		"attempt",
-		"attendant",
-		"attendee",
-		"attention",
-		"attentive",
-		"attest",
-		"attic",
-		"attire",
-		"attitude",
-		"attractor",
-		"attribute",
-		"atypical",
-		"auction",
-		"audacious",
-		"audacity",
-		"audible",
-		"audibly",
-		"audience",
-		"audio",
-		"audition",
-		"augmented",
-		"august",
-		"authentic",
-		"author",
-		"autism",
-		"autistic",
-		"autograph",
-		"automaker",
-		"automated",
-		"automatic",
-		"autopilot",
-		"available",
-		"avalanche",
-		"avatar",
-		"avenge",
-		"avenging",
-		"avenue",
-		"average",
-		"aversion",
-		"avert",
-		"aviation",
-		"aviator",
-		"avid",
-		"avoid",
-		"await",
-		"awaken",
-		"award",
-		"aware",
-		"awhile",
-		"awkward",
-		"awning",
-		"awoke",
-		"awry",
-		"axis",
-		"babble",
-		"babbling",
-		"babied",
-		"baboon",
-		"backache",
-		"backboard",
-		"backboned",
-		"backdrop",
-		"backed",
-		"backer",
-		"backfield",
-		"backfire",
-		"backhand",
-		"backing",
-		"backlands",
-		"backlash",
-		"backless",
-		"backlight",
-		"backlit",
-		"backlog",
-		"backpack",
-		"backpedal",
-		"backrest",
-		"backroom",
-		"backshift",
-		"backside",
-		"backslid",
-		"backspace",
-		"backspin",
-		"backstab",
-		"backstage",
-		"backtalk",
-		"backtrack",
-		"backup",
-		"backward",
-		"backwash",
-		"backwater",
-		"backyard",
-		"bacon",
-		"bacteria",
-		"bacterium",
-		"badass",
-		"badge",
-		"badland",
-		"badly",
-		"badness",
-		"baffle",
-		"baffling",
-		"bagel",
-		"bagful",
-		"baggage",
-		"bagged",
-		"baggie",
-		"bagginess",
-		"bagging",
-		"baggy",
-		"bagpipe",
-		"baguette",
-		"baked",
-		"bakery",
-		"bakeshop",
-		"baking",
-		"balance",
-		"balancing",
-		"balcony",
-		"balmy",
-		"balsamic",
-		"bamboo",
-		"banana",
-		"banish",
-		"banister",
-		"banjo",
-		"bankable",
-		"bankbook",
-		"banked",
-		"banker",
-		"banking",
-		"banknote",
-		"bankroll",
-		"banner",
-		"bannister",
-		"banshee",
-		"banter",
-		"barbecue",
-		"barbed",
-		"barbell",
-		"barber",
-		"barcode",
-		"barge",
-		"bargraph",
-		"barista",
-		"baritone",
-		"barley",
-		"barmaid",
-		"barman",
-		"barn",
-		"barometer",
-		"barrack",
-		"barracuda",
-		"barrel",
-		"barrette",
-		"barricade",
-		"barrier",
-		"barstool",
-		"bartender",
-		"barterer",
-		"bash",
-		"basically",
-		"basics",
-		"basil",
-		"basin",
-		"basis",
-		"basket",
-		"batboy",
-		"batch",
-		"bath",
-		"baton",
-		"bats",
-		"battalion",
-		"battered",
-		"battering",
-		"battery",
-		"batting",
-		"battle",
-		"bauble",
-		"bazooka",
-		"blabber",
-		"bladder",
-		"blade",
-		"blah",
-		"blame",
-		"blaming",
-		"blanching",
-		"blandness",
-		"blank",
-		"blaspheme",
-		"blasphemy",
-		"blast",
-		"blatancy",
-		"blatantly",
-		"blazer",
-		"blazing",
-		"bleach",
-		"bleak",
-		"bleep",
-		"blemish",
-		"blend",
-		"bless",
-		"blighted",
-		"blimp",
-		"bling",
-		"blinked",
-		"blinker",
-		"blinking",
-		"blinks",
-		"blip",
-		"blissful",
-		"blitz",
-		"blizzard",
-		"bloated",
-		"bloating",
-		"blob",
-		"blog",
-		"bloomers",
-		"blooming",
-		"blooper",
-		"blot",
-		"blouse",
-		"blubber",
-		"bluff",
-		"bluish",
-		"blunderer",
-		"blunt",
-		"blurb",
-		"blurred",
-		"blurry",
-		"blurt",
-		"blush",
-		"blustery",
-		"boaster",
-		"boastful",
-		"boasting",
-		"boat",
-		"bobbed",
-		"bobbing",
-		"bobble",
-		"bobcat",
-		"bobsled",
-		"bobtail",
-		"bodacious",
-		"body",
-		"bogged",
-		"boggle",
-		"bogus",
-		"boil",
-		"bok",
-		"bolster",
-		"bolt",
-		"bonanza",
-		"bonded",
-		"bonding",
-		"bondless",
-		"boned",
-		"bonehead",
-		"boneless",
-		"bonelike",
-		"boney",
-		"bonfire",
-		"bonnet",
-		"bonsai",
-		"bonus",
-		"bony",
-		"boogeyman",
-		"boogieman",
-		"book",
-		"boondocks",
-		"booted",
-		"booth",
-		"bootie",
-		"booting",
-		"bootlace",
-		"bootleg",
-		"boots",
-		"boozy",
-		"borax",
-		"boring",
-		"borough",
-		"borrower",
-		"borrowing",
-		"boss",
-		"botanical",
-		"botanist",
-		"botany",
-		"botch",
-		"both",
-		"bottle",
-		"bottling",
-		"bottom",
-		"bounce",
-		"bouncing",
-		"bouncy",
-		"bounding",
-		"boundless",
-		"bountiful",
-		"bovine",
-		"boxcar",
-		"boxer",
-		"boxing",
-		"boxlike",
-		"boxy",
-		"breach",
-		"breath",
-		"breeches",
-		"breeching",
-		"breeder",
-		"breeding",
-		"breeze",
-		"breezy",
-		"brethren",
-		"brewery",
-		"brewing",
-		"briar",
-		"bribe",
-		"brick",
-		"bride",
-		"bridged",
-		"brigade",
-		"bright",
-		"brilliant",
-		"brim",
-		"bring",
-		"brink",
-		"brisket",
-		"briskly",
-		"briskness",
-		"bristle",
-		"brittle",
-		"broadband",
-		"broadcast",
-		"broaden",
-		"broadly",
-		"broadness",
-		"broadside",
-		"broadways",
-		"broiler",
-		"broiling",
-		"broken",
-		"broker",
-		"bronchial",
-		"bronco",
-		"bronze",
-		"bronzing",
-		"brook",
-		"broom",
-		"brought",
-		"browbeat",
-		"brownnose",
-		"browse",
-		"browsing",
-		"bruising",
-		"brunch",
-		"brunette",
-		"brunt",
-		"brush",
-		"brussels",
-		"brute",
-		"brutishly",
-		"bubble",
-		"bubbling",
-		"bubbly",
-		"buccaneer",
-		"bucked",
-		"bucket",
-		"buckle",
-		"buckshot",
-		"buckskin",
-		"bucktooth",
-		"buckwheat",
-		"buddhism",
-		"buddhist",
-		"budding",
-		"buddy",
-		"budget",
-		"buffalo",
-		"buffed",
-		"buffer",
-		"buffing",
-		"buffoon",
-		"buggy",
-		"bulb",
-		"bulge",
-		"bulginess",
-		"bulgur",
-		"bulk",
-		"bulldog",
-		"bulldozer",
-		"bullfight",
-		"bullfrog",
-		"bullhorn",
-		"bullion",
-		"bullish",
-		"bullpen",
-		"bullring",
-		"bullseye",
-		"bullwhip",
-		"bully",
-		"bunch",
-		"bundle",
-		"bungee",
-		"bunion",
-		"bunkbed",
-		"bunkhouse",
-		"bunkmate",
-		"bunny",
-		"bunt",
-		"busboy",
-		"bush",
-		"busily",
-		"busload",
-		"bust",
-		"busybody",
-		"buzz",
-		"cabana",
-		"cabbage",
-		"cabbie",
-		"cabdriver",
-		"cable",
-		"caboose",
-		"cache",
-		"cackle",
-		"cacti",
-		"cactus",
-		"caddie",
-		"caddy",
-		"cadet",
-		"cadillac",
-		"cadmium",
-		"cage",
-		"cahoots",
-		"cake",
-		"calamari",
-		"calamity",
-		"calcium",
-		"calculate",
-		"calculus",
-		"caliber",
-		"calibrate",
-		"calm",
-		"caloric",
-		"calorie",
-		"calzone",
-		"camcorder",
-		"cameo",
-		"camera",
-		"camisole",
-		"camper",
-		"campfire",
-		"camping",
-		"campsite",
-		"campus",
-		"canal",
-		"canary",
-		"cancel",
-		"candied",
-		"candle",
-		"candy",
-		"cane",
-		"canine",
-		"canister",
-		"cannabis",
-		"canned",
-		"canning",
-		"cannon",
-		"cannot",
-		"canola",
-		"canon",
-		"canopener",
-		"canopy",
-		"canteen",
-		"canyon",
-		"capable",
-		"capably",
-		"capacity",
-		"cape",
-		"capillary",
-		"capital",
-		"capitol",
-		"capped",
-		"capricorn",
-		"capsize",
-		"capsule",
-		"caption",
-		"captivate",
-		"captive",
-		"captivity",
-		"capture",
-		"caramel",
-		"carat",
-		"caravan",
-		"carbon",
-		"cardboard",
-		"carded",
-		"cardiac",
-		"cardigan",
-		"cardinal",
-		"cardstock",
-		"carefully",
-		"caregiver",
-		"careless",
-		"caress",
-		"caretaker",
-		"cargo",
-		"caring",
-		"carless",
-		"carload",
-		"carmaker",
-		"carnage",
-		"carnation",
-		"carnival",
-		"carnivore",
-		"carol",
-		"carpenter",
-		"carpentry",
-		"carpool",
-		"carport",
-		"carried",
-		"carrot",
-		"carrousel",
-		"carry",
-		"cartel",
-		"cartload",
-		"carton",
-		"cartoon",
-		"cartridge",
-		"cartwheel",
-		"carve",
-		"carving",
-		"carwash",
-		"cascade",
-		"case",
-		"cash",
-		"casing",
-		"casino",
-		"casket",
-		"cassette",
-		"casually",
-		"casualty",
-		"catacomb",
-		"catalog",
-		"catalyst",
-		"catalyze",
-		"catapult",
-		"cataract",
-		"catatonic",
-		"catcall",
-		"catchable",
-		"catcher",
-		"catching",
-		"catchy",
-		"caterer",
-		"catering",
-		"catfight",
-		"catfish",
-		"cathedral",
-		"cathouse",
-		"catlike",
-		"catnap",
-		"catnip",
-		"catsup",
-		"cattail",
-		"cattishly",
-		"cattle",
-		"catty",
-		"catwalk",
-		"caucasian",
-		"caucus",
-		"causal",
-		"causation",
-		"cause",
-		"causing",
-		"cauterize",
-		"caution",
-		"cautious",
-		"cavalier",
-		"cavalry",
-		"caviar",
-		"cavity",
-		"cedar",
-		"celery",
-		"celestial",
-		"celibacy",
-		"celibate",
-		"celtic",
-		"cement",
-		"census",
-		"ceramics",
-		"ceremony",
-		"certainly",
-		"certainty",
-		"certified",
-		"certify",
-		"cesarean",
-		"cesspool",
-		"chafe",
-		"chaffing",
-		"chain",
-		"chair",
-		"chalice",
-		"challenge",
-		"chamber",
-		"chamomile",
-		"champion",
-		"chance",
-		"change",
-		"channel",
-		"chant",
-		"chaos",
-		"chaperone",
-		"chaplain",
-		"chapped",
-		"chaps",
-		"chapter",
-		"character",
-		"charbroil",
-		"charcoal",
-		"charger",
-		"charging",
-		"chariot",
-		"charity",
-		"charm",
-		"charred",
-		"charter",
-		"charting",
-		"chase",
-		"chasing",
-		"chaste",
-		"chastise",
-		"chastity",
-		"chatroom",
-		"chatter",
-		"chatting",
-		"chatty",
-		"cheating",
-		"cheddar",
-		"cheek",
-		"cheer",
-		"cheese",
-		"cheesy",
-		"chef",
-		"chemicals",
-		"chemist",
-		"chemo",
-		"cherisher",
-		"cherub",
-		"chess",
-		"chest",
-		"chevron",
-		"chevy",
-		"chewable",
-		"chewer",
-		"chewing",
-		"chewy",
-		"chief",
-		"chihuahua",
-		"childcare",
-		"childhood",
-		"childish",
-		"childless",
-		"childlike",
-		"chili",
-		"chill",
-		"chimp",
-		"chip",
-		"chirping",
-		"chirpy",
-		"chitchat",
-		"chivalry",
-		"chive",
-		"chloride",
-		"chlorine",
-		"choice",
-		"chokehold",
-		"choking",
-		"chomp",
-		"chooser",
-		"choosing",
-		"choosy",
-		"chop",
-		"chosen",
-		"chowder",
-		"chowtime",
-		"chrome",
-		"chubby",
-		"chuck",
-		"chug",
-		"chummy",
-		"chump",
-		"chunk",
-		"churn",
-		"chute",
-		"cider",
-		"cilantro",
-		"cinch",
-		"cinema",
-		"cinnamon",
-		"circle",
-		"circling",
-		"circular",
-		"circulate",
-		"circus",
-		"citable",
-		"citadel",
-		"citation",
-		"citizen",
-		"citric",
-		"citrus",
-		"city",
-		"civic",
-		"civil",
-		"clad",
-		"claim",
-		"clambake",
-		"clammy",
-		"clamor",
-		"clamp",
-		"clamshell",
-		"clang",
-		"clanking",
-		"clapped",
-		"clapper",
-		"clapping",
-		"clarify",
-		"clarinet",
-		"clarity",
-		"clash",
-		"clasp",
-		"class",
-		"clatter",
-		"clause",
-		"clavicle",
-		"claw",
-		"clay",
-		"clean",
-		"clear",
-		"cleat",
-		"cleaver",
-		"cleft",
-		"clench",
-		"clergyman",
-		"clerical",
-		"clerk",
-		"clever",
-		"clicker",
-		"client",
-		"climate",
-		"climatic",
-		"cling",
-		"clinic",
-		"clinking",
-		"clip",
-		"clique",
-		"cloak",
-		"clobber",
-		"clock",
-		"clone",
-		"cloning",
-		"closable",
-		"closure",
-		"clothes",
-		"clothing",
-		"cloud",
-		"clover",
-		"clubbed",
-		"clubbing",
-		"clubhouse",
-		"clump",
-		"clumsily",
-		"clumsy",
-		"clunky",
-		"clustered",
-		"clutch",
-		"clutter",
-		"coach",
-		"coagulant",
-		"coastal",
-		"coaster",
-		"coasting",
-		"coastland",
-		"coastline",
-		"coat",
-		"coauthor",
-		"cobalt",
-		"cobbler",
-		"cobweb",
-		"cocoa",
-		"coconut",
-		"cod",
-		"coeditor",
-		"coerce",
-		"coexist",
-		"coffee",
-		"cofounder",
-		"cognition",
-		"cognitive",
-		"cogwheel",
-		"coherence",
-		"coherent",
-		"cohesive",
-		"coil",
-		"coke",
-		"cola",
-		"cold",
-		"coleslaw",
-		"coliseum",
-		"collage",
-		"collapse",
-		"collar",
-		"collected",
-		"collector",
-		"collide",
-		"collie",
-		"collision",
-		"colonial",
-		"colonist",
-		"colonize",
-		"colony",
-		"colossal",
-		"colt",
-		"coma",
-		"come",
-		"comfort",
-		"comfy",
-		"comic",
-		"coming",
-		"comma",
-		"commence",
-		"commend",
-		"comment",
-		"commerce",
-		"commode",
-		"commodity",
-		"commodore",
-		"common",
-		"commotion",
-		"commute",
-		"commuting",
-		"compacted",
-		"compacter",
-		"compactly",
-		"compactor",
-		"companion",
-		"company",
-		"compare",
-		"compel",
-		"compile",
-		"comply",
-		"component",
-		"composed",
-		"composer",
-		"composite",
-		"compost",
-		"composure",
-		"compound",
-		"compress",
-		"comprised",
-		"computer",
-		"computing",
-		"comrade",
-		"concave",
-		"conceal",
-		"conceded",
-		"concept",
-		"concerned",
-		"concert",
-		"conch",
-		"concierge",
-		"concise",
-		"conclude",
-		"concrete",
-		"concur",
-		"condense",
-		"condiment",
-		"condition",
-		"condone",
-		"conducive",
-		"conductor",
-		"conduit",
-		"cone",
-		"confess",
-		"confetti",
-		"confidant",
-		"confident",
-		"confider",
-		"confiding",
-		"configure",
-		"confined",
-		"confining",
-		"confirm",
-		"conflict",
-		"conform",
-		"confound",
-		"confront",
-		"confused",
-		"confusing",
-		"confusion",
-		"congenial",
-		"congested",
-		"congrats",
-		"congress",
-		"conical",
-		"conjoined",
-		"conjure",
-		"conjuror",
-		"connected",
-		"connector",
-		"consensus",
-		"consent",
-		"console",
-		"consoling",
-		"consonant",
-		"constable",
-		"constant",
-		"constrain",
-		"constrict",
-		"construct",
-		"consult",
-		"consumer",
-		"consuming",
-		"contact",
-		"container",
-		"contempt",
-		"contend",
-		"contented",
-		"contently",
-		"contents",
-		"contest",
-		"context",
-		"contort",
-		"contour",
-		"contrite",
-		"control",
-		"contusion",
-		"convene",
-		"convent",
-		"copartner",
-		"cope",
-		"copied",
-		"copier",
-		"copilot",
-		"coping",
-		"copious",
-		"copper",
-		"copy",
-		"coral",
-		"cork",
-		"cornball",
-		"cornbread",
-		"corncob",
-		"cornea",
-		"corned",
-		"corner",
-		"cornfield",
-		"cornflake",
-		"cornhusk",
-		"cornmeal",
-		"cornstalk",
-		"corny",
-		"coronary",
-		"coroner",
-		"corporal",
-		"corporate",
-		"corral",
-		"correct",
-		"corridor",
-		"corrode",
-		"corroding",
-		"corrosive",
-		"corsage",
-		"corset",
-		"cortex",
-		"cosigner",
-		"cosmetics",
-		"cosmic",
-		"cosmos",
-		"cosponsor",
-		"cost",
-		"cottage",
-		"cotton",
-		"couch",
-		"cough",
-		"could",
-		"countable",
-		"countdown",
-		"counting",
-		"countless",
-		"country",
-		"county",
-		"courier",
-		"covenant",
-		"cover",
-		"coveted",
-		"coveting",
-		"coyness",
-		"cozily",
-		"coziness",
-		"cozy",
-		"crabbing",
-		"crabgrass",
-		"crablike",
-		"crabmeat",
-		"cradle",
-		"cradling",
-		"crafter",
-		"craftily",
-		"craftsman",
-		"craftwork",
-		"crafty",
-		"cramp",
-		"cranberry",
-		"crane",
-		"cranial",
-		"cranium",
-		"crank",
-		"crate",
-		"crave",
-		"craving",
-		"crawfish",
-		"crawlers",
-		"crawling",
-		"crayfish",
-		"crayon",
-		"crazed",
-		"crazily",
-		"craziness",
-		"crazy",
-		"creamed",
-		"creamer",
-		"creamlike",
-		"crease",
-		"creasing",
-		"creatable",
-		"create",
-		"creation",
-		"creative",
-		"creature",
-		"credible",
-		"credibly",
-		"credit",
-		"creed",
-		"creme",
-		"creole",
-		"crepe",
-		"crept",
-		"crescent",
-		"crested",
-		"cresting",
-		"crestless",
-		"crevice",
-		"crewless",
-		"crewman",
-		"crewmate",
-		"crib",
-		"cricket",
-		"cried",
-		"crier",
-		"crimp",
-		"crimson",
-		"cringe",
-		"cringing",
-		"crinkle",
-		"crinkly",
-		"crisped",
-		"crisping",
-		"crisply",
-		"crispness",
-		"crispy",
-		"criteria",
-		"critter",
-		"croak",
-		"crock",
-		"crook",
-		"croon",
-		"crop",
-		"cross",
-		"crouch",
-		"crouton",
-		"crowbar",
-		"crowd",
-		"crown",
-		"crucial",
-		"crudely",
-		"crudeness",
-		"cruelly",
-		"cruelness",
-		"cruelty",
-		"crumb",
-		"crummiest",
-		"crummy",
-		"crumpet",
-		"crumpled",
-		"cruncher",
-		"crunching",
-		"crunchy",
-		"crusader",
-		"crushable",
-		"crushed",
-		"crusher",
-		"crushing",
-		"crust",
-		"crux",
-		"crying",
-		"cryptic",
-		"crystal",
-		"cubbyhole",
-		"cube",
-		"cubical",
-		"cubicle",
-		"cucumber",
-		"cuddle",
-		"cuddly",
-		"cufflink",
-		"culinary",
-		"culminate",
-		"culpable",
-		"culprit",
-		"cultivate",
-		"cultural",
-		"culture",
-		"cupbearer",
-		"cupcake",
-		"cupid",
-		"cupped",
-		"cupping",
-		"curable",
-		"curator",
-		"curdle",
-		"cure",
-		"curfew",
-		"curing",
-		"curled",
-		"curler",
-		"curliness",
-		"curling",
-		"curly",
-		"curry",
-		"curse",
-		"cursive",
-		"cursor",
-		"curtain",
-		"curtly",
-		"curtsy",
-		"curvature",
-		"curve",
-		"curvy",
-		"cushy",
-		"cusp",
-		"cussed",
-		"custard",
-		"custodian",
-		"custody",
-		"customary",
-		"customer",
-		"customize",
-		"customs",
-		"cut",
-		"cycle",
-		"cyclic",
-		"cycling",
-		"cyclist",
-		"cylinder",
-		"cymbal",
-		"cytoplasm",
-		"cytoplast",
-		"dab",
-		"dad",
-		"daffodil",
-		"dagger",
-		"daily",
-		"daintily",
-		"dainty",
-		"dairy",
-		"daisy",
-		"dallying",
-		"dance",
-		"dancing",
-		"dandelion",
-		"dander",
-		"dandruff",
-		"dandy",
-		"danger",
-		"dangle",
-		"dangling",
-		"daredevil",
-		"dares",
-		"daringly",
-		"darkened",
-		"darkening",
-		"darkish",
-		"darkness",
-		"darkroom",
-		"darling",
-		"darn",
-		"dart",
-		"darwinism",
-		"dash",
-		"dastardly",
-		"data",
-		"datebook",
-		"dating",
-		"daughter",
-		"daunting",
-		"dawdler",
-		"dawn",
-		"daybed",
-		"daybreak",
-		"daycare",
-		"daydream",
-		"daylight",
-		"daylong",
-		"dayroom",
-		"daytime",
-		"dazzler",
-		"dazzling",
-		"deacon",
-		"deafening",
-		"deafness",
-		"dealer",
-		"dealing",
-		"dealmaker",
-		"dealt",
-		"dean",
-		"debatable",
-		"debate",
-		"debating",
-		"debit",
-		"debrief",
-		"debtless",
-		"debtor",
-		"debug",
-		"debunk",
-		"decade",
-		"decaf",
-		"decal",
-		"decathlon",
-		"decay",
-		"deceased",
-		"deceit",
-		"deceiver",
-		"deceiving",
-		"december",
-		"decency",
-		"decent",
-		"deception",
-		"deceptive",
-		"decibel",
-		"decidable",
-		"decimal",
-		"decimeter",
-		"decipher",
-		"deck",
-		"declared",
-		"decline",
-		"decode",
-		"decompose",
-		"decorated",
-		"decorator",
-		"decoy",
-		"decrease",
-		"decree",
-		"dedicate",
-		"dedicator",
-		"deduce",
-		"deduct",
-		"deed",
-		"deem",
-		"deepen",
-		"deeply",
-		"deepness",
-		"deface",
-		"defacing",
-		"defame",
-		"default",
-		"defeat",
-		"defection",
-		"defective",
-		"defendant",
-		"defender",
-		"defense",
-		"defensive",
-		"deferral",
-		"deferred",
-		"defiance",
-		"defiant",
-		"defile",
-		"defiling",
-		"define",
-		"definite",
-		"deflate",
-		"deflation",
-		"deflator",
-		"deflected",
-		"deflector",
-		"defog",
-		"deforest",
-		"defraud",
-		"defrost",
-		"deftly",
-		"defuse",
-		"defy",
-		"degraded",
-		"degrading",
-		"degrease",
-		"degree",
-		"dehydrate",
-		"deity",
-		"dejected",
-		"delay",
-		"delegate",
-		"delegator",
-		"delete",
-		"deletion",
-		"delicacy",
-		"delicate",
-		"delicious",
-		"delighted",
-		"delirious",
-		"delirium",
-		"deliverer",
-		"delivery",
-		"delouse",
-		"delta",
-		"deluge",
-		"delusion",
-		"deluxe",
-		"demanding",
-		"demeaning",
-		"demeanor",
-		"demise",
-		"democracy",
-		"democrat",
-		"demote",
-		"demotion",
-		"demystify",
-		"denatured",
-		"deniable",
-		"denial",
-		"denim",
-		"denote",
-		"dense",
-		"density",
-		"dental",
-		"dentist",
-		"denture",
-		"deny",
-		"deodorant",
-		"deodorize",
-		"departed",
-		"departure",
-		"depict",
-		"deplete",
-		"depletion",
-		"deplored",
-		"deploy",
-		"deport",
-		"depose",
-		"depraved",
-		"depravity",
-		"deprecate",
-		"depress",
-		"deprive",
-		"depth",
-		"deputize",
-		"deputy",
-		"derail",
-		"deranged",
-		"derby",
-		"derived",
-		"desecrate",
-		"deserve",
-		"deserving",
-		"designate",
-		"designed",
-		"designer",
-		"designing",
-		"deskbound",
-		"desktop",
-		"deskwork",
-		"desolate",
-		"despair",
-		"despise",
-		"despite",
-		"destiny",
-		"destitute",
-		"destruct",
-		"detached",
-		"detail",
-		"detection",
-		"detective",
-		"detector",
-		"detention",
-		"detergent",
-		"detest",
-		"detonate",
-		"detonator",
-		"detoxify",
-		"detract",
-		"deuce",
-		"devalue",
-		"deviancy",
-		"deviant",
-		"deviate",
-		"deviation",
-		"deviator",
-		"device",
-		"devious",
-		"devotedly",
-		"devotee",
-		"devotion",
-		"devourer",
-		"devouring",
-		"devoutly",
-		"dexterity",
-		"dexterous",
-		"diabetes",
-		"diabetic",
-		"diabolic",
-		"diagnoses",
-		"diagnosis",
-		"diagram",
-		"dial",
-		"diameter",
-		"diaper",
-		"diaphragm",
-		"diary",
-		"dice",
-		"dicing",
-		"dictate",
-		"dictation",
-		"dictator",
-		"difficult",
-		"diffused",
-		"diffuser",
-		"diffusion",
-		"diffusive",
-		"dig",
-		"dilation",
-		"diligence",
-		"diligent",
-		"dill",
-		"dilute",
-		"dime",
-		"diminish",
-		"dimly",
-		"dimmed",
-		"dimmer",
-		"dimness",
-		"dimple",
-		"diner",
-		"dingbat",
-		"dinghy",
-		"dinginess",
-		"dingo",
-		"dingy",
-		"dining",
-		"dinner",
-		"diocese",
-		"dioxide",
-		"diploma",
-		"dipped",
-		"dipper",
-		"dipping",
-		"directed",
-		"direction",
-		"directive",
-		"directly",
-		"directory",
-		"direness",
-		"dirtiness",
-		"disabled",
-		"disagree",
-		"disallow",
-		"disarm",
-		"disarray",
-		"disaster",
-		"disband",
-		"disbelief",
-		"disburse",
-		"discard",
-		"discern",
-		"discharge",
-		"disclose",
-		"discolor",
-		"discount",
-		"discourse",
-		"discover",
-		"discuss",
-		"disdain",
-		"disengage",
-		"disfigure",
-		"disgrace",
-		"dish",
-		"disinfect",
-		"disjoin",
-		"disk",
-		"dislike",
-		"disliking",
-		"dislocate",
-		"dislodge",
-		"disloyal",
-		"dismantle",
-		"dismay",
-		"dismiss",
-		"dismount",
-		"disobey",
-		"disorder",
-		"disown",
-		"disparate",
-		"disparity",
-		"dispatch",
-		"dispense",
-		"dispersal",
-		"dispersed",
-		"disperser",
-		"displace",
-		"display",
-		"displease",
-		"disposal",
-		"dispose",
-		"disprove",
-		"dispute",
-		"disregard",
-		"disrupt",
-		"dissuade",
-		"distance",
-		"distant",
-		"distaste",
-		"distill",
-		"distinct",
-		"distort",
-		"distract",
-		"distress",
-		"district",
-		"distrust",
-		"ditch",
-		"ditto",
-		"ditzy",
-		"dividable",
-		"divided",
-		"dividend",
-		"dividers",
-		"dividing",
-		"divinely",
-		"diving",
-		"divinity",
-		"divisible",
-		"divisibly",
-		"division",
-		"divisive",
-		"divorcee",
-		"dizziness",
-		"dizzy",
-		"doable",
-		"docile",
-		"dock",
-		"doctrine",
-		"document",
-		"dodge",
-		"dodgy",
-		"doily",
-		"doing",
-		"dole",
-		"dollar",
-		"dollhouse",
-		"dollop",
-		"dolly",
-		"dolphin",
-		"domain",
-		"domelike",
-		"domestic",
-		"dominion",
-		"dominoes",
-		"donated",
-		"donation",
-		"donator",
-		"donor",
-		"donut",
-		"doodle",
-		"doorbell",
-		"doorframe",
-		"doorknob",
-		"doorman",
-		"doormat",
-		"doornail",
-		"doorpost",
-		"doorstep",
-		"doorstop",
-		"doorway",
-		"doozy",
-		"dork",
-		"dormitory",
-		"dorsal",
-		"dosage",
-		"dose",
-		"dotted",
-		"doubling",
-		"douche",
-		"dove",
-		"down",
-		"dowry",
-		"doze",
-		"drab",
-		"dragging",
-		"dragonfly",
-		"dragonish",
-		"dragster",
-		"drainable",
-		"drainage",
-		"drained",
-		"drainer",
-		"drainpipe",
-		"dramatic",
-		"dramatize",
-		"drank",
-		"drapery",
-		"drastic",
-		"draw",
-		"dreaded",
-		"dreadful",
-		"dreadlock",
-		"dreamboat",
-		"dreamily",
-		"dreamland",
-		"dreamless",
-		"dreamlike",
-		"dreamt",
-		"dreamy",
-		"drearily",
-		"dreary",
-		"drench",
-		"dress",
-		"drew",
-		"dribble",
-		"dried",
-		"drier",
-		"drift",
-		"driller",
-		"drilling",
-		"drinkable",
-		"drinking",
-		"dripping",
-		"drippy",
-		"drivable",
-		"driven",
-		"driver",
-		"driveway",
-		"driving",
-		"drizzle",
-		"drizzly",
-		"drone",
-		"drool",
-		"droop",
-		"drop-down",
-		"dropbox",
-		"dropkick",
-		"droplet",
-		"dropout",
-		"dropper",
-		"drove",
-		"drown",
-		"drowsily",
-		"drudge",
-		"drum",
-		"dry",
-		"dubbed",
-		"dubiously",
-		"duchess",
-		"duckbill",
-		"ducking",
-		"duckling",
-		"ducktail",
-		"ducky",
-		"duct",
-		"dude",
-		"duffel",
-		"dugout",
-		"duh",
-		"duke",
-		"duller",
-		"dullness",
-		"duly",
-		"dumping",
-		"dumpling",
-		"dumpster",
-		"duo",
-		"dupe",
-		"duplex",
-		"duplicate",
-		"duplicity",
-		"durable",
-		"durably",
-		"duration",
-		"duress",
-		"during",
-		"dusk",
-		"dust",
-		"dutiful",
-		"duty",
-		"duvet",
-		"dwarf",
-		"dweeb",
-		"dwelled",
-		"dweller",
-		"dwelling",
-		"dwindle",
-		"dwindling",
-		"dynamic",
-		"dynamite",
-		"dynasty",
-		"dyslexia",
-		"dyslexic",
-		"each",
-		"eagle",
-		"earache",
-		"eardrum",
-		"earflap",
-		"earful",
-		"earlobe",
-		"early",
-		"earmark",
-		"earmuff",
-		"earphone",
-		"earpiece",
-		"earplugs",
-		"earring",
-		"earshot",
-		"earthen",
-		"earthlike",
-		"earthling",
-		"earthly",
-		"earthworm",
-		"earthy",
-		"earwig",
-		"easeful",
-		"easel",
-		"easiest",
-		"easily",
-		"easiness",
-		"easing",
-		"eastbound",
-		"eastcoast",
-		"easter",
-		"eastward",
-		"eatable",
-		"eaten",
-		"eatery",
-		"eating",
-		"eats",
-		"ebay",
-		"ebony",
-		"ebook",
-		"ecard",
-		"eccentric",
-		"echo",
-		"eclair",
-		"eclipse",
-		"ecologist",
-		"ecology",
-		"economic",
-		"economist",
-		"economy",
-		"ecosphere",
-		"ecosystem",
-		"edge",
-		"edginess",
-		"edging",
-		"edgy",
-		"edition",
-		"editor",
-		"educated",
-		"education",
-		"educator",
-		"eel",
-		"effective",
-		"effects",
-		"efficient",
-		"effort",
-		"eggbeater",
-		"egging",
-		"eggnog",
-		"eggplant",
-		"eggshell",
-		"egomaniac",
-		"egotism",
-		"egotistic",
-		"either",
-		"eject",
-		"elaborate",
-		"elastic",
-		"elated",
-		"elbow",
-		"eldercare",
-		"elderly",
-		"eldest",
-		"electable",
-		"election",
-		"elective",
-		"elephant",
-		"elevate",
-		"elevating",
-		"elevation",
-		"elevator",
-		"eleven",
-		"elf",
-		"eligible",
-		"eligibly",
-		"eliminate",
-		"elite",
-		"elitism",
-		"elixir",
-		"elk",
-		"ellipse",
-		"elliptic",
-		"elm",
-		"elongated",
-		"elope",
-		"eloquence",
-		"eloquent",
-		"elsewhere",
-		"elude",
-		"elusive",
-		"elves",
-		"email",
-		"embargo",
-		"embark",
-		"embassy",
-		"embattled",
-		"embellish",
-		"ember",
-		"embezzle",
-		"emblaze",
-		"emblem",
-		"embody",
-		"embolism",
-		"emboss",
-		"embroider",
-		"emcee",
-		"emerald",
-		"emergency",
-		"emission",
-		"emit",
-		"emote",
-		"emoticon",
-		"emotion",
-		"empathic",
-		"empathy",
-		"emperor",
-		"emphases",
-		"emphasis",
-		"emphasize",
-		"emphatic",
-		"empirical",
-		"employed",
-		"employee",
-		"employer",
-		"emporium",
-		"empower",
-		"emptier",
-		"emptiness",
-		"empty",
-		"emu",
-		"enable",
-		"enactment",
-		"enamel",
-		"enchanted",
-		"enchilada",
-		"encircle",
-		"enclose",
-		"enclosure",
-		"encode",
-		"encore",
-		"encounter",
-		"encourage",
-		"encroach",
-		"encrust",
-		"encrypt",
-		"endanger",
-		"endeared",
-		"endearing",
-		"ended",
-		"ending",
-		"endless",
-		"endnote",
-		"endocrine",
-		"endorphin",
-		"endorse",
-		"endowment",
-		"endpoint",
-		"endurable",
-		"endurance",
-		"enduring",
-		"energetic",
-		"energize",
-		"energy",
-		"enforced",
-		"enforcer",
-		"engaged",
-		"engaging",
-		"engine",
-		"engorge",
-		"engraved",
-		"engraver",
-		"engraving",
-		"engross",
-		"engulf",
-		"enhance",
-		"enigmatic",
-		"enjoyable",
-		"enjoyably",
-		"enjoyer",
-		"enjoying",
-		"enjoyment",
-		"enlarged",
-		"enlarging",
-		"enlighten",
-		"enlisted",
-		"enquirer",
-		"enrage",
-		"enrich",
-		"enroll",
-		"enslave",
-		"ensnare",
-		"ensure",
-		"entail",
-		"entangled",
-		"entering",
-		"entertain",
-		"enticing",
-		"entire",
-		"entitle",
-		"entity",
-		"entomb",
-		"entourage",
-		"entrap",
-		"entree",
-		"entrench",
-		"entrust",
-		"entryway",
-		"entwine",
-		"enunciate",
-		"envelope",
-		"enviable",
-		"enviably",
-		"envious",
-		"envision",
-		"envoy",
-		"envy",
-		"enzyme",
-		"epic",
-		"epidemic",
-		"epidermal",
-		"epidermis",
-		"epidural",
-		"epilepsy",
-		"epileptic",
-		"epilogue",
-		"epiphany",
-		"episode",
-		"equal",
-		"equate",
-		"equation",
-		"equator",
-		"equinox",
-		"equipment",
-		"equity",
-		"equivocal",
-		"eradicate",
-		"erasable",
-		"erased",
-		"eraser",
-		"erasure",
-		"ergonomic",
-		"errand",
-		"errant",
-		"erratic",
-		"error",
-		"erupt",
-		"escalate",
-		"escalator",
-		"escapable",
-		"escapade",
-		"escapist",
-		"escargot",
-		"eskimo",
-		"esophagus",
-		"espionage",
-		"espresso",
-		"esquire",
-		"essay",
-		"essence",
-		"essential",
-		"establish",
-		"estate",
-		"esteemed",
-		"estimate",
-		"estimator",
-		"estranged",
-		"estrogen",
-		"etching",
-		"eternal",
-		"eternity",
-		"ethanol",
-		"ether",
-		"ethically",
-		"ethics",
-		"euphemism",
-		"evacuate",
-		"evacuee",
-		"evade",
-		"evaluate",
-		"evaluator",
-		"evaporate",
-		"evasion",
-		"evasive",
-		"even",
-		"everglade",
-		"evergreen",
-		"everybody",
-		"everyday",
-		"everyone",
-		"evict",
-		"evidence",
-		"evident",
-		"evil",
-		"evoke",
-		"evolution",
-		"evolve",
-		"exact",
-		"exalted",
-		"example",
-		"excavate",
-		"excavator",
-		"exceeding",
-		"exception",
-		"excess",
-		"exchange",
-		"excitable",
-		"exciting",
-		"exclaim",
-		"exclude",
-		"excluding",
-		"exclusion",
-		"exclusive",
-		"excretion",
-		"excretory",
-		"excursion",
-		"excusable",
-		"excusably",
-		"excuse",
-		"exemplary",
-		"exemplify",
-		"exemption",
-		"exerciser",
-		"exert",
-		"exes",
-		"exfoliate",
-		"exhale",
-		"exhaust",
-		"exhume",
-		"exile",
-		"existing",
-		"exit",
-		"exodus",
-		"exonerate",
-		"exorcism",
-		"exorcist",
-		"expand",
-		"expanse",
-		"expansion",
-		"expansive",
-		"expectant",
-		"expedited",
-		"expediter",
-		"expel",
-		"expend",
-		"expenses",
-		"expensive",
-		"expert",
-		"expire",
-		"expiring",
-		"explain",
-		"expletive",
-		"explicit",
-		"explode",
-		"exploit",
-		"explore",
-		"exploring",
-		"exponent",
-		"exporter",
-		"exposable",
-		"expose",
-		"exposure",
-		"express",
-		"expulsion",
-		"exquisite",
-		"extended",
-		"extending",
-		"extent",
-		"extenuate",
-		"exterior",
-		"external",
-		"extinct",
-		"extortion",
-		"extradite",
-		"extras",
-		"extrovert",
-		"extrude",
-		"extruding",
-		"exuberant",
-		"fable",
-		"fabric",
-		"fabulous",
-		"facebook",
-		"facecloth",
-		"facedown",
-		"faceless",
-		"facelift",
-		"faceplate",
-		"faceted",
-		"facial",
-		"facility",
-		"facing",
-		"facsimile",
-		"faction",
-		"factoid",
-		"factor",
-		"factsheet",
-		"factual",
-		"faculty",
-		"fade",
-		"fading",
-		"failing",
-		"falcon",
-		"fall",
-		"false",
-		"falsify",
-		"fame",
-		"familiar",
-		"family",
-		"famine",
-		"famished",
-		"fanatic",
-		"fancied",
-		"fanciness",
-		"fancy",
-		"fanfare",
-		"fang",
-		"fanning",
-		"fantasize",
-		"fantastic",
-		"fantasy",
-		"fascism",
-		"fastball",
-		"faster",
-		"fasting",
-		"fastness",
-		"faucet",
-		"favorable",
-		"favorably",
-		"favored",
-		"favoring",
-		"favorite",
-		"fax",
-		"feast",
-		"federal",
-		"fedora",
-		"feeble",
-		"feed",
-		"feel",
-		"feisty",
-		"feline",
-		"felt-tip",
-		"feminine",
-		"feminism",
-		"feminist",
-		"feminize",
-		"femur",
-		"fence",
-		"fencing",
-		"fender",
-		"ferment",
-		"fernlike",
-		"ferocious",
-		"ferocity",
-		"ferret",
-		"ferris",
-		"ferry",
-		"fervor",
-		"fester",
-		"festival",
-		"festive",
-		"festivity",
-		"fetal",
-		"fetch",
-		"fever",
-		"fiber",
-		"fiction",
-		"fiddle",
-		"fiddling",
-		"fidelity",
-		"fidgeting",
-		"fidgety",
-		"fifteen",
-		"fifth",
-		"fiftieth",
-		"fifty",
-		"figment",
-		"figure",
-		"figurine",
-		"filing",
-		"filled",
-		"filler",
-		"filling",
-		"film",
-		"filter",
-		"filth",
-		"filtrate",
-		"finale",
-		"finalist",
-		"finalize",
-		"finally",
-		"finance",
-		"financial",
-		"finch",
-		"fineness",
-		"finer",
-		"finicky",
-		"finished",
-		"finisher",
-		"finishing",
-		"finite",
-		"finless",
-		"finlike",
-		"fiscally",
-		"fit",
-		"five",
-		"flaccid",
-		"flagman",
-		"flagpole",
-		"flagship",
-		"flagstick",
-		"flagstone",
-		"flail",
-		"flakily",
-		"flaky",
-		"flame",
-		"flammable",
-		"flanked",
-		"flanking",
-		"flannels",
-		"flap",
-		"flaring",
-		"flashback",
-		"flashbulb",
-		"flashcard",
-		"flashily",
-		"flashing",
-		"flashy",
-		"flask",
-		"flatbed",
-		"flatfoot",
-		"flatly",
-		"flatness",
-		"flatten",
-		"flattered",
-		"flatterer",
-		"flattery",
-		"flattop",
-		"flatware",
-		"flatworm",
-		"flavored",
-		"flavorful",
-		"flavoring",
-		"flaxseed",
-		"fled",
-		"fleshed",
-		"fleshy",
-		"flick",
-		"flier",
-		"flight",
-		"flinch",
-		"fling",
-		"flint",
-		"flip",
-		"flirt",
-		"float",
-		"flock",
-		"flogging",
-		"flop",
-		"floral",
-		"florist",
-		"floss",
-		"flounder",
-		"flyable",
-		"flyaway",
-		"flyer",
-		"flying",
-		"flyover",
-		"flypaper",
-		"foam",
-		"foe",
-		"fog",
-		"foil",
-		"folic",
-		"folk",
-		"follicle",
-		"follow",
-		"fondling",
-		"fondly",
-		"fondness",
-		"fondue",
-		"font",
-		"food",
-		"fool",
-		"footage",
-		"football",
-		"footbath",
-		"footboard",
-		"footer",
-		"footgear",
-		"foothill",
-		"foothold",
-		"footing",
-		"footless",
-		"footman",
-		"footnote",
-		"footpad",
-		"footpath",
-		"footprint",
-		"footrest",
-		"footsie",
-		"footsore",
-		"footwear",
-		"footwork",
-		"fossil",
-		"foster",
-		"founder",
-		"founding",
-		"fountain",
-		"fox",
-		"foyer",
-		"fraction",
-		"fracture",
-		"fragile",
-		"fragility",
-		"fragment",
-		"fragrance",
-		"fragrant",
-		"frail",
-		"frame",
-		"framing",
-		"frantic",
-		"fraternal",
-		"frayed",
-		"fraying",
-		"frays",
-		"freckled",
-		"freckles",
-		"freebase",
-		"freebee",
-		"freebie",
-		"freedom",
-		"freefall",
-		"freehand",
-		"freeing",
-		"freeload",
-		"freely",
-		"freemason",
-		"freeness",
-		"freestyle",
-		"freeware",
-		"freeway",
-		"freewill",
-		"freezable",
-		"freezing",
-		"freight",
-		"french",
-		"frenzied",
-		"frenzy",
-		"frequency",
-		"frequent",
-		"fresh",
-		"fretful",
-		"fretted",
-		"friction",
-		"friday",
-		"fridge",
-		"fried",
-		"friend",
-		"frighten",
-		"frightful",
-		"frigidity",
-		"frigidly",
-		"frill",
-		"fringe",
-		"frisbee",
-		"frisk",
-		"fritter",
-		"frivolous",
-		"frolic",
-		"from",
-		"front",
-		"frostbite",
-		"frosted",
-		"frostily",
-		"frosting",
-		"frostlike",
-		"frosty",
-		"froth",
-		"frown",
-		"frozen",
-		"fructose",
-		"frugality",
-		"frugally",
-		"fruit",
-		"frustrate",
-		"frying",
-		"gab",
-		"gaffe",
-		"gag",
-		"gainfully",
-		"gaining",
-		"gains",
-		"gala",
-		"gallantly",
-		"galleria",
-		"gallery",
-		"galley",
-		"gallon",
-		"gallows",
-		"gallstone",
-		"galore",
-		"galvanize",
-		"gambling",
-		"game",
-		"gaming",
-		"gamma",
-		"gander",
-		"gangly",
-		"gangrene",
-		"gangway",
-		"gap",
-		"garage",
-		"garbage",
-		"garden",
-		"gargle",
-		"garland",
-		"garlic",
-		"garment",
-		"garnet",
-		"garnish",
-		"garter",
-		"gas",
-		"gatherer",
-		"gathering",
-		"gating",
-		"gauging",
-		"gauntlet",
-		"gauze",
-		"gave",
-		"gawk",
-		"gazing",
-		"gear",
-		"gecko",
-		"geek",
-		"geiger",
-		"gem",
-		"gender",
-		"generic",
-		"generous",
-		"genetics",
-		"genre",
-		"gentile",
-		"gentleman",
-		"gently",
-		"gents",
-		"geography",
-		"geologic",
-		"geologist",
-		"geology",
-		"geometric",
-		"geometry",
-		"geranium",
-		"gerbil",
-		"geriatric",
-		"germicide",
-		"germinate",
-		"germless",
-		"germproof",
-		"gestate",
-		"gestation",
-		"gesture",
-		"getaway",
-		"getting",
-		"getup",
-		"giant",
-		"gibberish",
-		"giblet",
-		"giddily",
-		"giddiness",
-		"giddy",
-		"gift",
-		"gigabyte",
-		"gigahertz",
-		"gigantic",
-		"giggle",
-		"giggling",
-		"giggly",
-		"gigolo",
-		"gilled",
-		"gills",
-		"gimmick",
-		"girdle",
-		"giveaway",
-		"given",
-		"giver",
-		"giving",
-		"gizmo",
-		"gizzard",
-		"glacial",
-		"glacier",
-		"glade",
-		"gladiator",
-		"gladly",
-		"glamorous",
-		"glamour",
-		"glance",
-		"glancing",
-		"glandular",
-		"glare",
-		"glaring",
-		"glass",
-		"glaucoma",
-		"glazing",
-		"gleaming",
-		"gleeful",
-		"glider",
-		"gliding",
-		"glimmer",
-		"glimpse",
-		"glisten",
-		"glitch",
-		"glitter",
-		"glitzy",
-		"gloater",
-		"gloating",
-		"gloomily",
-		"gloomy",
-		"glorified",
-		"glorifier",
-		"glorify",
-		"glorious",
-		"glory",
-		"gloss",
-		"glove",
-		"glowing",
-		"glowworm",
-		"glucose",
-		"glue",
-		"gluten",
-		"glutinous",
-		"glutton",
-		"gnarly",
-		"gnat",
-		"goal",
-		"goatskin",
-		"goes",
-		"goggles",
-		"going",
-		"goldfish",
-		"goldmine",
-		"goldsmith",
-		"golf",
-		"goliath",
-		"gonad",
-		"gondola",
-		"gone",
-		"gong",
-		"good",
-		"gooey",
-		"goofball",
-		"goofiness",
-		"goofy",
-		"google",
-		"goon",
-		"gopher",
-		"gore",
-		"gorged",
-		"gorgeous",
-		"gory",
-		"gosling",
-		"gossip",
-		"gothic",
-		"gotten",
-		"gout",
-		"gown",
-		"grab",
-		"graceful",
-		"graceless",
-		"gracious",
-		"gradation",
-		"graded",
-		"grader",
-		"gradient",
-		"grading",
-		"gradually",
-		"graduate",
-		"graffiti",
-		"grafted",
-		"grafting",
-		"grain",
-		"granddad",
-		"grandkid",
-		"grandly",
-		"grandma",
-		"grandpa",
-		"grandson",
-		"granite",
-		"granny",
-		"granola",
-		"grant",
-		"granular",
-		"grape",
-		"graph",
-		"grapple",
-		"grappling",
-		"grasp",
-		"grass",
-		"gratified",
-		"gratify",
-		"grating",
-		"gratitude",
-		"gratuity",
-		"gravel",
-		"graveness",
-		"graves",
-		"graveyard",
-		"gravitate",
-		"gravity",
-		"gravy",
-		"gray",
-		"grazing",
-		"greasily",
-		"greedily",
-		"greedless",
-		"greedy",
-		"green",
-		"greeter",
-		"greeting",
-		"grew",
-		"greyhound",
-		"grid",
-		"grief",
-		"grievance",
-		"grieving",
-		"grievous",
-		"grill",
-		"grimace",
-		"grimacing",
-		"grime",
-		"griminess",
-		"grimy",
-		"grinch",
-		"grinning",
-		"grip",
-		"gristle",
-		"grit",
-		"groggily",
-		"groggy",
-		"groin",
-		"groom",
-		"groove",
-		"grooving",
-		"groovy",
-		"grope",
-		"ground",
-		"grouped",
-		"grout",
-		"grove",
-		"grower",
-		"growing",
-		"growl",
-		"grub",
-		"grudge",
-		"grudging",
-		"grueling",
-		"gruffly",
-		"grumble",
-		"grumbling",
-		"grumbly",
-		"grumpily",
-		"grunge",
-		"grunt",
-		"guacamole",
-		"guidable",
-		"guidance",
-		"guide",
-		"guiding",
-		"guileless",
-		"guise",
-		"gulf",
-		"gullible",
-		"gully",
-		"gulp",
-		"gumball",
-		"gumdrop",
-		"gumminess",
-		"gumming",
-		"gummy",
-		"gurgle",
-		"gurgling",
-		"guru",
-		"gush",
-		"gusto",
-		"gusty",
-		"gutless",
-		"guts",
-		"gutter",
-		"guy",
-		"guzzler",
-		"gyration",
-		"habitable",
-		"habitant",
-		"habitat",
-		"habitual",
-		"hacked",
-		"hacker",
-		"hacking",
-		"hacksaw",
-		"had",
-		"haggler",
-		"haiku",
-		"half",
-		"halogen",
-		"halt",
-		"halved",
-		"halves",
-		"hamburger",
-		"hamlet",
-		"hammock",
-		"hamper",
-		"hamster",
-		"hamstring",
-		"handbag",
-		"handball",
-		"handbook",
-		"handbrake",
-		"handcart",
-		"handclap",
-		"handclasp",
-		"handcraft",
-		"handcuff",
-		"handed",
-		"handful",
-		"handgrip",
-		"handgun",
-		"handheld",
-		"handiness",
-		"handiwork",
-		"handlebar",
-		"handled",
-		"handler",
-		"handling",
-		"handmade",
-		"handoff",
-		"handpick",
-		"handprint",
-		"handrail",
-		"handsaw",
-		"handset",
-		"handsfree",
-		"handshake",
-		"handstand",
-		"handwash",
-		"handwork",
-		"handwoven",
-		"handwrite",
-		"handyman",
-		"hangnail",
-		"hangout",
-		"hangover",
-		"hangup",
-		"hankering",
-		"hankie",
-		"hanky",
-		"haphazard",
-		"happening",
-		"happier",
-		"happiest",
-		"happily",
-		"happiness",
-		"happy",
-		"harbor",
-		"hardcopy",
-		"hardcore",
-		"hardcover",
-		"harddisk",
-		"hardened",
-		"hardener",
-		"hardening",
-		"hardhat",
-		"hardhead",
-		"hardiness",
-		"hardly",
-		"hardness",
-		"hardship",
-		"hardware",
-		"hardwired",
-		"hardwood",
-		"hardy",
-		"harmful",
-		"harmless",
-		"harmonica",
-		"harmonics",
-		"harmonize",
-		"harmony",
-		"harness",
-		"harpist",
-		"harsh",
-		"harvest",
-		"hash",
-		"hassle",
-		"haste",
-		"hastily",
-		"hastiness",
-		"hasty",
-		"hatbox",
-		"hatchback",
-		"hatchery",
-		"hatchet",
-		"hatching",
-		"hatchling",
-		"hate",
-		"hatless",
-		"hatred",
-		"haunt",
-		"haven",
-		"hazard",
-		"hazelnut",
-		"hazily",
-		"haziness",
-		"hazing",
-		"hazy",
-		"headache",
-		"headband",
-		"headboard",
-		"headcount",
-		"headdress",
-		"headed",
-		"header",
-		"headfirst",
-		"headgear",
-		"heading",
-		"headlamp",
-		"headless",
-		"headlock",
-		"headphone",
-		"headpiece",
-		"headrest",
-		"headroom",
-		"headscarf",
-		"headset",
-		"headsman",
-		"headstand",
-		"headstone",
-		"headway",
-		"headwear",
-		"heap",
-		"heat",
-		"heave",
-		"heavily",
-		"heaviness",
-		"heaving",
-		"hedge",
-		"hedging",
-		"heftiness",
-		"hefty",
-		"helium",
-		"helmet",
-		"helper",
-		"helpful",
-		"helping",
-		"helpless",
-		"helpline",
-		"hemlock",
-		"hemstitch",
-		"hence",
-		"henchman",
-		"henna",
-		"herald",
-		"herbal",
-		"herbicide",
-		"herbs",
-		"heritage",
-		"hermit",
-		"heroics",
-		"heroism",
-		"herring",
-		"herself",
-		"hertz",
-		"hesitancy",
-		"hesitant",
-		"hesitate",
-		"hexagon",
-		"hexagram",
-		"hubcap",
-		"huddle",
-		"huddling",
-		"huff",
-		"hug",
-		"hula",
-		"hulk",
-		"hull",
-		"human",
-		"humble",
-		"humbling",
-		"humbly",
-		"humid",
-		"humiliate",
-		"humility",
-		"humming",
-		"hummus",
-		"humongous",
-		"humorist",
-		"humorless",
-		"humorous",
-		"humpback",
-		"humped",
-		"humvee",
-		"hunchback",
-		"hundredth",
-		"hunger",
-		"hungrily",
-		"hungry",
-		"hunk",
-		"hunter",
-		"hunting",
-		"huntress",
-		"huntsman",
-		"hurdle",
-		"hurled",
-		"hurler",
-		"hurling",
-		"hurray",
-		"hurricane",
-		"hurried",
-		"hurry",
-		"hurt",
-		"husband",
-		"hush",
-		"husked",
-		"huskiness",
-		"hut",
-		"hybrid",
-		"hydrant",
-		"hydrated",
-		"hydration",
-		"hydrogen",
-		"hydroxide",
-		"hyperlink",
-		"hypertext",
-		"hyphen",
-		"hypnoses",
-		"hypnosis",
-		"hypnotic",
-		"hypnotism",
-		"hypnotist",
-		"hypnotize",
-		"hypocrisy",
-		"hypocrite",
-		"ibuprofen",
-		"ice",
-		"iciness",
-		"icing",
-		"icky",
-		"icon",
-		"icy",
-		"idealism",
-		"idealist",
-		"idealize",
-		"ideally",
-		"idealness",
-		"identical",
-		"identify",
-		"identity",
-		"ideology",
-		"idiocy",
-		"idiom",
-		"idly",
-		"igloo",
-		"ignition",
-		"ignore",
-		"iguana",
-		"illicitly",
-		"illusion",
-		"illusive",
-		"image",
-		"imaginary",
-		"imagines",
-		"imaging",
-		"imbecile",
-		"imitate",
-		"imitation",
-		"immature",
-		"immerse",
-		"immersion",
-		"imminent",
-		"immobile",
-		"immodest",
-		"immorally",
-		"immortal",
-		"immovable",
-		"immovably",
-		"immunity",
-		"immunize",
-		"impaired",
-		"impale",
-		"impart",
-		"impatient",
-		"impeach",
-		"impeding",
-		"impending",
-		"imperfect",
-		"imperial",
-		"impish",
-		"implant",
-		"implement",
-		"implicate",
-		"implicit",
-		"implode",
-		"implosion",
-		"implosive",
-		"imply",
-		"impolite",
-		"important",
-		"importer",
-		"impose",
-		"imposing",
-		"impotence",
-		"impotency",
-		"impotent",
-		"impound",
-		"imprecise",
-		"imprint",
-		"imprison",
-		"impromptu",
-		"improper",
-		"improve",
-		"improving",
-		"improvise",
-		"imprudent",
-		"impulse",
-		"impulsive",
-		"impure",
-		"impurity",
-		"iodine",
-		"iodize",
-		"ion",
-		"ipad",
-		"iphone",
-		"ipod",
-		"irate",
-		"irk",
-		"iron",
-		"irregular",
-		"irrigate",
-		"irritable",
-		"irritably",
-		"irritant",
-		"irritate",
-		"islamic",
-		"islamist",
-		"isolated",
-		"isolating",
-		"isolation",
-		"isotope",
-		"issue",
-		"issuing",
-		"italicize",
-		"italics",
-		"item",
-		"itinerary",
-		"itunes",
-		"ivory",
-		"ivy",
-		"jab",
-		"jackal",
-		"jacket",
-		"jackknife",
-		"jackpot",
-		"jailbird",
-		"jailbreak",
-		"jailer",
-		"jailhouse",
-		"jalapeno",
-		"jam",
-		"janitor",
-		"january",
-		"jargon",
-		"jarring",
-		"jasmine",
-		"jaundice",
-		"jaunt",
-		"java",
-		"jawed",
-		"jawless",
-		"jawline",
-		"jaws",
-		"jaybird",
-		"jaywalker",
-		"jazz",
-		"jeep",
-		"jeeringly",
-		"jellied",
-		"jelly",
-		"jersey",
-		"jester",
-		"jet",
-		"jiffy",
-		"jigsaw",
-		"jimmy",
-		"jingle",
-		"jingling",
-		"jinx",
-		"jitters",
-		"jittery",
-		"job",
-		"jockey",
-		"jockstrap",
-		"jogger",
-		"jogging",
-		"john",
-		"joining",
-		"jokester",
-		"jokingly",
-		"jolliness",
-		"jolly",
-		"jolt",
-		"jot",
-		"jovial",
-		"joyfully",
-		"joylessly",
-		"joyous",
-		"joyride",
-		"joystick",
-		"jubilance",
-		"jubilant",
-		"judge",
-		"judgingly",
-		"judicial",
-		"judiciary",
-		"judo",
-		"juggle",
-		"juggling",
-		"jugular",
-		"juice",
-		"juiciness",
-		"juicy",
-		"jujitsu",
-		"jukebox",
-		"july",
-		"jumble",
-		"jumbo",
-		"jump",
-		"junction",
-		"juncture",
-		"june",
-		"junior",
-		"juniper",
-		"junkie",
-		"junkman",
-		"junkyard",
-		"jurist",
-		"juror",
-		"jury",
-		"justice",
-		"justifier",
-		"justify",
-		"justly",
-		"justness",
-		"juvenile",
-		"kabob",
-		"kangaroo",
-		"karaoke",
-		"karate",
-		"karma",
-		"kebab",
-		"keenly",
-		"keenness",
-		"keep",
-		"keg",
-		"kelp",
-		"kennel",
-		"kept",
-		"kerchief",
-		"kerosene",
-		"kettle",
-		"kick",
-		"kiln",
-		"kilobyte",
-		"kilogram",
-		"kilometer",
-		"kilowatt",
-		"kilt",
-		"kimono",
-		"kindle",
-		"kindling",
-		"kindly",
-		"kindness",
-		"kindred",
-		"kinetic",
-		"kinfolk",
-		"king",
-		"kinship",
-		"kinsman",
-		"kinswoman",
-		"kissable",
-		"kisser",
-		"kissing",
-		"kitchen",
-		"kite",
-		"kitten",
-		"kitty",
-		"kiwi",
-		"kleenex",
-		"knapsack",
-		"knee",
-		"knelt",
-		"knickers",
-		"knoll",
-		"koala",
-		"kooky",
-		"kosher",
-		"krypton",
-		"kudos",
-		"kung",
-		"labored",
-		"laborer",
-		"laboring",
-		"laborious",
-		"labrador",
-		"ladder",
-		"ladies",
-		"ladle",
-		"ladybug",
-		"ladylike",
-		"lagged",
-		"lagging",
-		"lagoon",
-		"lair",
-		"lake",
-		"lance",
-		"landed",
-		"landfall",
-		"landfill",
-		"landing",
-		"landlady",
-		"landless",
-		"landline",
-		"landlord",
-		"landmark",
-		"landmass",
-		"landmine",
-		"landowner",
-		"landscape",
-		"landside",
-		"landslide",
-		"language",
-		"lankiness",
-		"lanky",
-		"lantern",
-		"lapdog",
-		"lapel",
-		"lapped",
-		"lapping",
-		"laptop",
-		"lard",
-		"large",
-		"lark",
-		"lash",
-		"lasso",
-		"last",
-		"latch",
-		"late",
-		"lather",
-		"latitude",
-		"latrine",
-		"latter",
-		"latticed",
-		"launch",
-		"launder",
-		"laundry",
-		"laurel",
-		"lavender",
-		"lavish",
-		"laxative",
-		"lazily",
-		"laziness",
-		"lazy",
-		"lecturer",
-		"left",
-		"legacy",
-		"legal",
-		"legend",
-		"legged",
-		"leggings",
-		"legible",
-		"legibly",
-		"legislate",
-		"lego",
-		"legroom",
-		"legume",
-		"legwarmer",
-		"legwork",
-		"lemon",
-		"lend",
-		"length",
-		"lens",
-		"lent",
-		"leotard",
-		"lesser",
-		"letdown",
-		"lethargic",
-		"lethargy",
-		"letter",
-		"lettuce",
-		"level",
-		"leverage",
-		"levers",
-		"levitate",
-		"levitator",
-		"liability",
-		"liable",
-		"liberty",
-		"librarian",
-		"library",
-		"licking",
-		"licorice",
-		"lid",
-		"life",
-		"lifter",
-		"lifting",
-		"liftoff",
-		"ligament",
-		"likely",
-		"likeness",
-		"likewise",
-		"liking",
-		"lilac",
-		"lilly",
-		"lily",
-		"limb",
-		"limeade",
-		"limelight",
-		"limes",
-		"limit",
-		"limping",
-		"limpness",
-		"line",
-		"lingo",
-		"linguini",
-		"linguist",
-		"lining",
-		"linked",
-		"linoleum",
-		"linseed",
-		"lint",
-		"lion",
-		"lip",
-		"liquefy",
-		"liqueur",
-		"liquid",
-		"lisp",
-		"list",
-		"litigate",
-		"litigator",
-		"litmus",
-		"litter",
-		"little",
-		"livable",
-		"lived",
-		"lively",
-		"liver",
-		"livestock",
-		"lividly",
-		"living",
-		"lizard",
-		"lubricant",
-		"lubricate",
-		"lucid",
-		"luckily",
-		"luckiness",
-		"luckless",
-		"lucrative",
-		"ludicrous",
-		"lugged",
-		"lukewarm",
-		"lullaby",
-		"lumber",
-		"luminance",
-		"luminous",
-		"lumpiness",
-		"lumping",
-		"lumpish",
-		"lunacy",
-		"lunar",
-		"lunchbox",
-		"luncheon",
-		"lunchroom",
-		"lunchtime",
-		"lung",
-		"lurch",
-		"lure",
-		"luridness",
-		"lurk",
-		"lushly",
-		"lushness",
-		"luster",
-		"lustfully",
-		"lustily",
-		"lustiness",
-		"lustrous",
-		"lusty",
-		"luxurious",
-		"luxury",
-		"lying",
-		"lyrically",
-		"lyricism",
-		"lyricist",
-		"lyrics",
-		"macarena",
-		"macaroni",
-		"macaw",
-		"mace",
-		"machine",
-		"machinist",
-		"magazine",
-		"magenta",
-		"maggot",
-		"magical",
-		"magician",
-		"magma",
-		"magnesium",
-		"magnetic",
-		"magnetism",
-		"magnetize",
-		"magnifier",
-		"magnify",
-		"magnitude",
-		"magnolia",
-		"mahogany",
-		"maimed",
-		"majestic",
-		"majesty",
-		"majorette",
-		"majority",
-		"makeover",
-		"maker",
-		"makeshift",
-		"making",
-		"malformed",
-		"malt",
-		"mama",
-		"mammal",
-		"mammary",
-		"mammogram",
-		"manager",
-		"managing",
-		"manatee",
-		"mandarin",
-		"mandate",
-		"mandatory",
-		"mandolin",
-		"manger",
-		"mangle",
-		"mango",
-		"mangy",
-		"manhandle",
-		"manhole",
-		"manhood",
-		"manhunt",
-		"manicotti",
-		"manicure",
-		"manifesto",
-		"manila",
-		"mankind",
-		"manlike",
-		"manliness",
-		"manly",
-		"manmade",
-		"manned",
-		"mannish",
-		"manor",
-		"manpower",
-		"mantis",
-		"mantra",
-		"manual",
-		"many",
-		"map",
-		"marathon",
-		"marauding",
-		"marbled",
-		"marbles",
-		"marbling",
-		"march",
-		"mardi",
-		"margarine",
-		"margarita",
-		"margin",
-		"marigold",
-		"marina",
-		"marine",
-		"marital",
-		"maritime",
-		"marlin",
-		"marmalade",
-		"maroon",
-		"married",
-		"marrow",
-		"marry",
-		"marshland",
-		"marshy",
-		"marsupial",
-		"marvelous",
-		"marxism",
-		"mascot",
-		"masculine",
-		"mashed",
-		"mashing",
-		"massager",
-		"masses",
-		"massive",
-		"mastiff",
-		"matador",
-		"matchbook",
-		"matchbox",
-		"matcher",
-		"matching",
-		"matchless",
-		"material",
-		"maternal",
-		"maternity",
-		"math",
-		"mating",
-		"matriarch",
-		"matrimony",
-		"matrix",
-		"matron",
-		"matted",
-		"matter",
-		"maturely",
-		"maturing",
-		"maturity",
-		"mauve",
-		"maverick",
-		"maximize",
-		"maximum",
-		"maybe",
-		"mayday",
-		"mayflower",
-		"moaner",
-		"moaning",
-		"mobile",
-		"mobility",
-		"mobilize",
-		"mobster",
-		"mocha",
-		"mocker",
-		"mockup",
-		"modified",
-		"modify",
-		"modular",
-		"modulator",
-		"module",
-		"moisten",
-		"moistness",
-		"moisture",
-		"molar",
-		"molasses",
-		"mold",
-		"molecular",
-		"molecule",
-		"molehill",
-		"mollusk",
-		"mom",
-		"monastery",
-		"monday",
-		"monetary",
-		"monetize",
-		"moneybags",
-		"moneyless",
-		"moneywise",
-		"mongoose",
-		"mongrel",
-		"monitor",
-		"monkhood",
-		"monogamy",
-		"monogram",
-		"monologue",
-		"monopoly",
-		"monorail",
-		"monotone",
-		"monotype",
-		"monoxide",
-		"monsieur",
-		"monsoon",
-		"monstrous",
-		"monthly",
-		"monument",
-		"moocher",
-		"moodiness",
-		"moody",
-		"mooing",
-		"moonbeam",
-		"mooned",
-		"moonlight",
-		"moonlike",
-		"moonlit",
-		"moonrise",
-		"moonscape",
-		"moonshine",
-		"moonstone",
-		"moonwalk",
-		"mop",
-		"morale",
-		"morality",
-		"morally",
-		"morbidity",
-		"morbidly",
-		"morphine",
-		"morphing",
-		"morse",
-		"mortality",
-		"mortally",
-		"mortician",
-		"mortified",
-		"mortify",
-		"mortuary",
-		"mosaic",
-		"mossy",
-		"most",
-		"mothball",
-		"mothproof",
-		"motion",
-		"motivate",
-		"motivator",
-		"motive",
-		"motocross",
-		"motor",
-		"motto",
-		"mountable",
-		"mountain",
-		"mounted",
-		"mounting",
-		"mourner",
-		"mournful",
-		"mouse",
-		"mousiness",
-		"moustache",
-		"mousy",
-		"mouth",
-		"movable",
-		"move",
-		"movie",
-		"moving",
-		"mower",
-		"mowing",
-		"much",
-		"muck",
-		"mud",
-		"mug",
-		"mulberry",
-		"mulch",
-		"mule",
-		"mulled",
-		"mullets",
-		"multiple",
-		"multiply",
-		"multitask",
-		"multitude",
-		"mumble",
-		"mumbling",
-		"mumbo",
-		"mummified",
-		"mummify",
-		"mummy",
-		"mumps",
-		"munchkin",
-		"mundane",
-		"municipal",
-		"muppet",
-		"mural",
-		"murkiness",
-		"murky",
-		"murmuring",
-		"muscular",
-		"museum",
-		"mushily",
-		"mushiness",
-		"mushroom",
-		"mushy",
-		"music",
-		"musket",
-		"muskiness",
-		"musky",
-		"mustang",
-		"mustard",
-		"muster",
-		"mustiness",
-		"musty",
-		"mutable",
-		"mutate",
-		"mutation",
-		"mute",
-		"mutilated",
-		"mutilator",
-		"mutiny",
-		"mutt",
-		"mutual",
-		"muzzle",
-		"myself",
-		"myspace",
-		"mystified",
-		"mystify",
-		"myth",
-		"nacho",
-		"nag",
-		"nail",
-		"name",
-		"naming",
-		"nanny",
-		"nanometer",
-		"nape",
-		"napkin",
-		"napped",
-		"napping",
-		"nappy",
-		"narrow",
-		"nastily",
-		"nastiness",
-		"national",
-		"native",
-		"nativity",
-		"natural",
-		"nature",
-		"naturist",
-		"nautical",
-		"navigate",
-		"navigator",
-		"navy",
-		"nearby",
-		"nearest",
-		"nearly",
-		"nearness",
-		"neatly",
-		"neatness",
-		"nebula",
-		"nebulizer",
-		"nectar",
-		"negate",
-		"negation",
-		"negative",
-		"neglector",
-		"negligee",
-		"negligent",
-		"negotiate",
-		"nemeses",
-		"nemesis",
-		"neon",
-		"nephew",
-		"nerd",
-		"nervous",
-		"nervy",
-		"nest",
-		"net",
-		"neurology",
-		"neuron",
-		"neurosis",
-		"neurotic",
-		"neuter",
-		"neutron",
-		"never",
-		"next",
-		"nibble",
-		"nickname",
-		"nicotine",
-		"niece",
-		"nifty",
-		"nimble",
-		"nimbly",
-		"nineteen",
-		"ninetieth",
-		"ninja",
-		"nintendo",
-		"ninth",
-		"nuclear",
-		"nuclei",
-		"nucleus",
-		"nugget",
-		"nullify",
-		"number",
-		"numbing",
-		"numbly",
-		"numbness",
-		"numeral",
-		"numerate",
-		"numerator",
-		"numeric",
-		"numerous",
-		"nuptials",
-		"nursery",
-		"nursing",
-		"nurture",
-		"nutcase",
-		"nutlike",
-		"nutmeg",
-		"nutrient",
-		"nutshell",
-		"nuttiness",
-		"nutty",
-		"nuzzle",
-		"nylon",
-		"oaf",
-		"oak",
-		"oasis",
-		"oat",
-		"obedience",
-		"obedient",
-		"obituary",
-		"object",
-		"obligate",
-		"obliged",
-		"oblivion",
-		"oblivious",
-		"oblong",
-		"obnoxious",
-		"oboe",
-		"obscure",
-		"obscurity",
-		"observant",
-		"observer",
-		"observing",
-		"obsessed",
-		"obsession",
-		"obsessive",
-		"obsolete",
-		"obstacle",
-		"obstinate",
-		"obstruct",
-		"obtain",
-		"obtrusive",
-		"obtuse",
-		"obvious",
-		"occultist",
-		"occupancy",
-		"occupant",
-		"occupier",
-		"occupy",
-		"ocean",
-		"ocelot",
-		"octagon",
-		"octane",
-		"october",
-		"octopus",
-		"ogle",
-		"oil",
-		"oink",
-		"ointment",
-		"okay",
-		"old",
-		"olive",
-		"olympics",
-		"omega",
-		"omen",
-		"ominous",
-		"omission",
-		"omit",
-		"omnivore",
-		"onboard",
-		"oncoming",
-		"ongoing",
-		"onion",
-		"online",
-		"onlooker",
-		"only",
-		"onscreen",
-		"onset",
-		"onshore",
-		"onslaught",
-		"onstage",
-		"onto",
-		"onward",
-		"onyx",
-		"oops",
-		"ooze",
-		"oozy",
-		"opacity",
-		"opal",
-		"open",
-		"operable",
-		"operate",
-		"operating",
-		"operation",
-		"operative",
-		"operator",
-		"opium",
-		"opossum",
-		"opponent",
-		"oppose",
-		"opposing",
-		"opposite",
-		"oppressed",
-		"oppressor",
-		"opt",
-		"opulently",
-		"osmosis",
-		"other",
-		"otter",
-		"ouch",
-		"ought",
-		"ounce",
-		"outage",
-		"outback",
-		"outbid",
-		"outboard",
-		"outbound",
-		"outbreak",
-		"outburst",
-		"outcast",
-		"outclass",
-		"outcome",
-		"outdated",
-		"outdoors",
-		"outer",
-		"outfield",
-		"outfit",
-		"outflank",
-		"outgoing",
-		"outgrow",
-		"outhouse",
-		"outing",
-		"outlast",
-		"outlet",
-		"outline",
-		"outlook",
-		"outlying",
-		"outmatch",
-		"outmost",
-		"outnumber",
-		"outplayed",
-		"outpost",
-		"outpour",
-		"output",
-		"outrage",
-		"outrank",
-		"outreach",
-		"outright",
-		"outscore",
-		"outsell",
-		"outshine",
-		"outshoot",
-		"outsider",
-		"outskirts",
-		"outsmart",
-		"outsource",
-		"outspoken",
-		"outtakes",
-		"outthink",
-		"outward",
-		"outweigh",
-		"outwit",
-		"oval",
-		"ovary",
-		"oven",
-		"overact",
-		"overall",
-		"overarch",
-		"overbid",
-		"overbill",
-		"overbite",
-		"overblown",
-		"overboard",
-		"overbook",
-		"overbuilt",
-		"overcast",
-		"overcoat",
-		"overcome",
-		"overcook",
-		"overcrowd",
-		"overdraft",
-		"overdrawn",
-		"overdress",
-		"overdrive",
-		"overdue",
-		"overeager",
-		"overeater",
-		"overexert",
-		"overfed",
-		"overfeed",
-		"overfill",
-		"overflow",
-		"overfull",
-		"overgrown",
-		"overhand",
-		"overhang",
-		"overhaul",
-		"overhead",
-		"overhear",
-		"overheat",
-		"overhung",
-		"overjoyed",
-		"overkill",
-		"overlabor",
-		"overlaid",
-		"overlap",
-		"overlay",
-		"overload",
-		"overlook",
-		"overlord",
-		"overlying",
-		"overnight",
-		"overpass",
-		"overpay",
-		"overplant",
-		"overplay",
-		"overpower",
-		"overprice",
-		"overrate",
-		"overreach",
-		"overreact",
-		"override",
-		"overripe",
-		"overrule",
-		"overrun",
-		"overshoot",
-		"overshot",
-		"oversight",
-		"oversized",
-		"oversleep",
-		"oversold",
-		"overspend",
-		"overstate",
-		"overstay",
-		"overstep",
-		"overstock",
-		"overstuff",
-		"oversweet",
-		"overtake",
-		"overthrow",
-		"overtime",
-		"overtly",
-		"overtone",
-		"overture",
-		"overturn",
-		"overuse",
-		"overvalue",
-		"overview",
-		"overwrite",
-		"owl",
-		"oxford",
-		"oxidant",
-		"oxidation",
-		"oxidize",
-		"oxidizing",
-		"oxygen",
-		"oxymoron",
-		"oyster",
-		"ozone",
-		"paced",
-		"pacemaker",
-		"pacific",
-		"pacifier",
-		"pacifism",
-		"pacifist",
-		"pacify",
-		"padded",
-		"padding",
-		"paddle",
-		"paddling",
-		"padlock",
-		"pagan",
-		"pager",
-		"paging",
-		"pajamas",
-		"palace",
-		"palatable",
-		"palm",
-		"palpable",
-		"palpitate",
-		"paltry",
-		"pampered",
-		"pamperer",
-		"pampers",
-		"pamphlet",
-		"panama",
-		"pancake",
-		"pancreas",
-		"panda",
-		"pandemic",
-		"pang",
-		"panhandle",
-		"panic",
-		"panning",
-		"panorama",
-		"panoramic",
-		"panther",
-		"pantomime",
-		"pantry",
-		"pants",
-		"pantyhose",
-		"paparazzi",
-		"papaya",
-		"paper",
-		"paprika",
-		"papyrus",
-		"parabola",
-		"parachute",
-		"parade",
-		"paradox",
-		"paragraph",
-		"parakeet",
-		"paralegal",
-		"paralyses",
-		"paralysis",
-		"paralyze",
-		"paramedic",
-		"parameter",
-		"paramount",
-		"parasail",
-		"parasite",
-		"parasitic",
-		"parcel",
-		"parched",
-		"parchment",
-		"pardon",
-		"parish",
-		"parka",
-		"parking",
-		"parkway",
-		"parlor",
-		"parmesan",
-		"parole",
-		"parrot",
-		"parsley",
-		"parsnip",
-		"partake",
-		"parted",
-		"parting",
-		"partition",
-		"partly",
-		"partner",
-		"partridge",
-		"party",
-		"passable",
-		"passably",
-		"passage",
-		"passcode",
-		"passenger",
-		"passerby",
-		"passing",
-		"passion",
-		"passive",
-		"passivism",
-		"passover",
-		"passport",
-		"password",
-		"pasta",
-		"pasted",
-		"pastel",
-		"pastime",
-		"pastor",
-		"pastrami",
-		"pasture",
-		"pasty",
-		"patchwork",
-		"patchy",
-		"paternal",
-		"paternity",
-		"path",
-		"patience",
-		"patient",
-		"patio",
-		"patriarch",
-		"patriot",
-		"patrol",
-		"patronage",
-		"patronize",
-		"pauper",
-		"pavement",
-		"paver",
-		"pavestone",
-		"pavilion",
-		"paving",
-		"pawing",
-		"payable",
-		"payback",
-		"paycheck",
-		"payday",
-		"payee",
-		"payer",
-		"paying",
-		"payment",
-		"payphone",
-		"payroll",
-		"pebble",
-		"pebbly",
-		"pecan",
-		"pectin",
-		"peculiar",
-		"peddling",
-		"pediatric",
-		"pedicure",
-		"pedigree",
-		"pedometer",
-		"pegboard",
-		"pelican",
-		"pellet",
-		"pelt",
-		"pelvis",
-		"penalize",
-		"penalty",
-		"pencil",
-		"pendant",
-		"pending",
-		"penholder",
-		"penknife",
-		"pennant",
-		"penniless",
-		"penny",
-		"penpal",
-		"pension",
-		"pentagon",
-		"pentagram",
-		"pep",
-		"perceive",
-		"percent",
-		"perch",
-		"percolate",
-		"perennial",
-		"perfected",
-		"perfectly",
-		"perfume",
-		"periscope",
-		"perish",
-		"perjurer",
-		"perjury",
-		"perkiness",
-		"perky",
-		"perm",
-		"peroxide",
-		"perpetual",
-		"perplexed",
-		"persecute",
-		"persevere",
-		"persuaded",
-		"persuader",
-		"pesky",
-		"peso",
-		"pessimism",
-		"pessimist",
-		"pester",
-		"pesticide",
-		"petal",
-		"petite",
-		"petition",
-		"petri",
-		"petroleum",
-		"petted",
-		"petticoat",
-		"pettiness",
-		"petty",
-		"petunia",
-		"phantom",
-		"phobia",
-		"phoenix",
-		"phonebook",
-		"phoney",
-		"phonics",
-		"phoniness",
-		"phony",
-		"phosphate",
-		"photo",
-		"phrase",
-		"phrasing",
-		"placard",
-		"placate",
-		"placidly",
-		"plank",
-		"planner",
-		"plant",
-		"plasma",
-		"plaster",
-		"plastic",
-		"plated",
-		"platform",
-		"plating",
-		"platinum",
-		"platonic",
-		"platter",
-		"platypus",
-		"plausible",
-		"plausibly",
-		"playable",
-		"playback",
-		"player",
-		"playful",
-		"playgroup",
-		"playhouse",
-		"playing",
-		"playlist",
-		"playmaker",
-		"playmate",
-		"playoff",
-		"playpen",
-		"playroom",
-		"playset",
-		"plaything",
-		"playtime",
-		"plaza",
-		"pleading",
-		"pleat",
-		"pledge",
-		"plentiful",
-		"plenty",
-		"plethora",
-		"plexiglas",
-		"pliable",
-		"plod",
-		"plop",
-		"plot",
-		"plow",
-		"ploy",
-		"pluck",
-		"plug",
-		"plunder",
-		"plunging",
-		"plural",
-		"plus",
-		"plutonium",
-		"plywood",
-		"poach",
-		"pod",
-		"poem",
-		"poet",
-		"pogo",
-		"pointed",
-		"pointer",
-		"pointing",
-		"pointless",
-		"pointy",
-		"poise",
-		"poison",
-		"poker",
-		"poking",
-		"polar",
-		"police",
-		"policy",
-		"polio",
-		"polish",
-		"politely",
-		"polka",
-		"polo",
-		"polyester",
-		"polygon",
-		"polygraph",
-		"polymer",
-		"poncho",
-		"pond",
-		"pony",
-		"popcorn",
-		"pope",
-		"poplar",
-		"popper",
-		"poppy",
-		"popsicle",
-		"populace",
-		"popular",
-		"populate",
-		"porcupine",
-		"pork",
-		"porous",
-		"porridge",
-		"portable",
-		"portal",
-		"portfolio",
-		"porthole",
-		"portion",
-		"portly",
-		"portside",
-		"poser",
-		"posh",
-		"posing",
-		"possible",
-		"possibly",
-		"possum",
-		"postage",
-		"postal",
-		"postbox",
-		"postcard",
-		"posted",
-		"poster",
-		"posting",
-		"postnasal",
-		"posture",
-		"postwar",
-		"pouch",
-		"pounce",
-		"pouncing",
-		"pound",
-		"pouring",
-		"pout",
-		"powdered",
-		"powdering",
-		"powdery",
-		"power",
-		"powwow",
-		"pox",
-		"praising",
-		"prance",
-		"prancing",
-		"pranker",
-		"prankish",
-		"prankster",
-		"prayer",
-		"praying",
-		"preacher",
-		"preaching",
-		"preachy",
-		"preamble",
-		"precinct",
-		"precise",
-		"precision",
-		"precook",
-		"precut",
-		"predator",
-		"predefine",
-		"predict",
-		"preface",
-		"prefix",
-		"preflight",
-		"preformed",
-		"pregame",
-		"pregnancy",
-		"pregnant",
-		"preheated",
-		"prelaunch",
-		"prelaw",
-		"prelude",
-		"premiere",
-		"premises",
-		"premium",
-		"prenatal",
-		"preoccupy",
-		"preorder",
-		"prepaid",
-		"prepay",
-		"preplan",
-		"preppy",
-		"preschool",
-		"prescribe",
-		"preseason",
-		"preset",
-		"preshow",
-		"president",
-		"presoak",
-		"press",
-		"presume",
-		"presuming",
-		"preteen",
-		"pretended",
-		"pretender",
-		"pretense",
-		"pretext",
-		"pretty",
-		"pretzel",
-		"prevail",
-		"prevalent",
-		"prevent",
-		"preview",
-		"previous",
-		"prewar",
-		"prewashed",
-		"prideful",
-		"pried",
-		"primal",
-		"primarily",
-		"primary",
-		"primate",
-		"primer",
-		"primp",
-		"princess",
-		"print",
-		"prior",
-		"prism",
-		"prison",
-		"prissy",
-		"pristine",
-		"privacy",
-		"private",
-		"privatize",
-		"prize",
-		"proactive",
-		"probable",
-		"probably",
-		"probation",
-		"probe",
-		"probing",
-		"probiotic",
-		"problem",
-		"procedure",
-		"process",
-		"proclaim",
-		"procreate",
-		"procurer",
-		"prodigal",
-		"prodigy",
-		"produce",
-		"product",
-		"profane",
-		"profanity",
-		"professed",
-		"professor",
-		"profile",
-		"profound",
-		"profusely",
-		"progeny",
-		"prognosis",
-		"program",
-		"progress",
-		"projector",
-		"prologue",
-		"prolonged",
-		"promenade",
-		"prominent",
-		"promoter",
-		"promotion",
-		"prompter",
-		"promptly",
-		"prone",
-		"prong",
-		"pronounce",
-		"pronto",
-		"proofing",
-		"proofread",
-		"proofs",
-		"propeller",
-		"properly",
-		"property",
-		"proponent",
-		"proposal",
-		"propose",
-		"props",
-		"prorate",
-		"protector",
-		"protegee",
-		"proton",
-		"prototype",
-		"protozoan",
-		"protract",
-		"protrude",
-		"proud",
-		"provable",
-		"proved",
-		"proven",
-		"provided",
-		"provider",
-		"providing",
-		"province",
-		"proving",
-		"provoke",
-		"provoking",
-		"provolone",
-		"prowess",
-		"prowler",
-		"prowling",
-		"proximity",
-		"proxy",
-		"prozac",
-		"prude",
-		"prudishly",
-		"prune",
-		"pruning",
-		"pry",
-		"psychic",
-		"public",
-		"publisher",
-		"pucker",
-		"pueblo",
-		"pug",
-		"pull",
-		"pulmonary",
-		"pulp",
-		"pulsate",
-		"pulse",
-		"pulverize",
-		"puma",
-		"pumice",
-		"pummel",
-		"punch",
-		"punctual",
-		"punctuate",
-		"punctured",
-		"pungent",
-		"punisher",
-		"punk",
-		"pupil",
-		"puppet",
-		"puppy",
-		"purchase",
-		"pureblood",
-		"purebred",
-		"purely",
-		"pureness",
-		"purgatory",
-		"purge",
-		"purging",
-		"purifier",
-		"purify",
-		"purist",
-		"puritan",
-		"purity",
-		"purple",
-		"purplish",
-		"purposely",
-		"purr",
-		"purse",
-		"pursuable",
-		"pursuant",
-		"pursuit",
-		"purveyor",
-		"pushcart",
-		"pushchair",
-		"pusher",
-		"pushiness",
-		"pushing",
-		"pushover",
-		"pushpin",
-		"pushup",
-		"pushy",
-		"putdown",
-		"putt",
-		"puzzle",
-		"puzzling",
-		"pyramid",
-		"pyromania",
-		"python",
-		"quack",
-		"quadrant",
-		"quail",
-		"quaintly",
-		"quake",
-		"quaking",
-		"qualified",
-		"qualifier",
-		"qualify",
-		"quality",
-		"qualm",
-		"quantum",
-		"quarrel",
-		"quarry",
-		"quartered",
-		"quarterly",
-		"quarters",
-		"quartet",
-		"quench",
-		"query",
-		"quicken",
-		"quickly",
-		"quickness",
-		"quicksand",
-		"quickstep",
-		"quiet",
-		"quill",
-		"quilt",
-		"quintet",
-		"quintuple",
-		"quirk",
-		"quit",
-		"quiver",
-		"quizzical",
-		"quotable",
-		"quotation",
-		"quote",
-		"rabid",
-		"race",
-		"racing",
-		"racism",
-		"rack",
-		"racoon",
-		"radar",
-		"radial",
-		"radiance",
-		"radiantly",
-		"radiated",
-		"radiation",
-		"radiator",
-		"radio",
-		"radish",
-		"raffle",
-		"raft",
-		"rage",
-		"ragged",
-		"raging",
-		"ragweed",
-		"raider",
-		"railcar",
-		"railing",
-		"railroad",
-		"railway",
-		"raisin",
-		"rake",
-		"raking",
-		"rally",
-		"ramble",
-		"rambling",
-		"ramp",
-		"ramrod",
-		"ranch",
-		"rancidity",
-		"random",
-		"ranged",
-		"ranger",
-		"ranging",
-		"ranked",
-		"ranking",
-		"ransack",
-		"ranting",
-		"rants",
-		"rare",
-		"rarity",
-		"rascal",
-		"rash",
-		"rasping",
-		"ravage",
-		"raven",
-		"ravine",
-		"raving",
-		"ravioli",
-		"ravishing",
-		"reabsorb",
-		"reach",
-		"reacquire",
-		"reaction",
-		"reactive",
-		"reactor",
-		"reaffirm",
-		"ream",
-		"reanalyze",
-		"reappear",
-		"reapply",
-		"reappoint",
-		"reapprove",
-		"rearrange",
-		"rearview",
-		"reason",
-		"reassign",
-		"reassure",
-		"reattach",
-		"reawake",
-		"rebalance",
-		"rebate",
-		"rebel",
-		"rebirth",
-		"reboot",
-		"reborn",
-		"rebound",
-		"rebuff",
-		"rebuild",
-		"rebuilt",
-		"reburial",
-		"rebuttal",
-		"recall",
-		"recant",
-		"recapture",
-		"recast",
-		"recede",
-		"recent",
-		"recess",
-		"recharger",
-		"recipient",
-		"recital",
-		"recite",
-		"reckless",
-		"reclaim",
-		"recliner",
-		"reclining",
-		"recluse",
-		"reclusive",
-		"recognize",
-		"recoil",
-		"recollect",
-		"recolor",
-		"reconcile",
-		"reconfirm",
-		"reconvene",
-		"recopy",
-		"record",
-		"recount",
-		"recoup",
-		"recovery",
-		"recreate",
-		"rectal",
-		"rectangle",
-		"rectified",
-		"rectify",
-		"recycled",
-		"recycler",
-		"recycling",
-		"reemerge",
-		"reenact",
-		"reenter",
-		"reentry",
-		"reexamine",
-		"referable",
-		"referee",
-		"reference",
-		"refill",
-		"refinance",
-		"refined",
-		"refinery",
-		"refining",
-		"refinish",
-		"reflected",
-		"reflector",
-		"reflex",
-		"reflux",
-		"refocus",
-		"refold",
-		"reforest",
-		"reformat",
-		"reformed",
-		"reformer",
-		"reformist",
-		"refract",
-		"refrain",
-		"refreeze",
-		"refresh",
-		"refried",
-		"refueling",
-		"refund",
-		"refurbish",
-		"refurnish",
-		"refusal",
-		"refuse",
-		"refusing",
-		"refutable",
-		"refute",
-		"regain",
-		"regalia",
-		"regally",
-		"reggae",
-		"regime",
-		"region",
-		"register",
-		"registrar",
-		"registry",
-		"regress",
-		"regretful",
-		"regroup",
-		"regular",
-		"regulate",
-		"regulator",
-		"rehab",
-		"reheat",
-		"rehire",
-		"rehydrate",
-		"reimburse",
-		"reissue",
-		"reiterate",
-		"rejoice",
-		"rejoicing",
-		"rejoin",
-		"rekindle",
-		"relapse",
-		"relapsing",
-		"relatable",
-		"related",
-		"relation",
-		"relative",
-		"relax",
-		"relay",
-		"relearn",
-		"release",
-		"relenting",
-		"reliable",
-		"reliably",
-		"reliance",
-		"reliant",
-		"relic",
-		"relieve",
-		"relieving",
-		"relight",
-		"relish",
-		"relive",
-		"reload",
-		"relocate",
-		"relock",
-		"reluctant",
-		"rely",
-		"remake",
-		"remark",
-		"remarry",
-		"rematch",
-		"remedial",
-		"remedy",
-		"remember",
-		"reminder",
-		"remindful",
-		"remission",
-		"remix",
-		"remnant",
-		"remodeler",
-		"remold",
-		"remorse",
-		"remote",
-		"removable",
-		"removal",
-		"removed",
-		"remover",
-		"removing",
-		"rename",
-		"renderer",
-		"rendering",
-		"rendition",
-		"renegade",
-		"renewable",
-		"renewably",
-		"renewal",
-		"renewed",
-		"renounce",
-		"renovate",
-		"renovator",
-		"rentable",
-		"rental",
-		"rented",
-		"renter",
-		"reoccupy",
-		"reoccur",
-		"reopen",
-		"reorder",
-		"repackage",
-		"repacking",
-		"repaint",
-		"repair",
-		"repave",
-		"repaying",
-		"repayment",
-		"repeal",
-		"repeated",
-		"repeater",
-		"repent",
-		"rephrase",
-		"replace",
-		"replay",
-		"replica",
-		"reply",
-		"reporter",
-		"repose",
-		"repossess",
-		"repost",
-		"repressed",
-		"reprimand",
-		"reprint",
-		"reprise",
-		"reproach",
-		"reprocess",
-		"reproduce",
-		"reprogram",
-		"reps",
-		"reptile",
-		"reptilian",
-		"repugnant",
-		"repulsion",
-		"repulsive",
-		"repurpose",
-		"reputable",
-		"reputably",
-		"request",
-		"require",
-		"requisite",
-		"reroute",
-		"rerun",
-		"resale",
-		"resample",
-		"rescuer",
-		"reseal",
-		"research",
-		"reselect",
-		"reseller",
-		"resemble",
-		"resend",
-		"resent",
-		"reset",
-		"reshape",
-		"reshoot",
-		"reshuffle",
-		"residence",
-		"residency",
-		"resident",
-		"residual",
-		"residue",
-		"resigned",
-		"resilient",
-		"resistant",
-		"resisting",
-		"resize",
-		"resolute",
-		"resolved",
-		"resonant",
-		"resonate",
-		"resort",
-		"resource",
-		"respect",
-		"resubmit",
-		"result",
-		"resume",
-		"resupply",
-		"resurface",
-		"resurrect",
-		"retail",
-		"retainer",
-		"retaining",
-		"retake",
-		"retaliate",
-		"retention",
-		"rethink",
-		"retinal",
-		"retired",
-		"retiree",
-		"retiring",
-		"retold",
-		"retool",
-		"retorted",
-		"retouch",
-		"retrace",
-		"retract",
-		"retrain",
-		"retread",
-		"retreat",
-		"retrial",
-		"retrieval",
-		"retriever",
-		"retry",
-		"return",
-		"retying",
-		"retype",
-		"reunion",
-		"reunite",
-		"reusable",
-		"reuse",
-		"reveal",
-		"reveler",
-		"revenge",
-		"revenue",
-		"reverb",
-		"revered",
-		"reverence",
-		"reverend",
-		"reversal",
-		"reverse",
-		"reversing",
-		"reversion",
-		"revert",
-		"revisable",
-		"revise",
-		"revision",
-		"revisit",
-		"revivable",
-		"revival",
-		"reviver",
-		"reviving",
-		"revocable",
-		"revoke",
-		"revolt",
-		"revolver",
-		"revolving",
-		"reward",
-		"rewash",
-		"rewind",
-		"rewire",
-		"reword",
-		"rework",
-		"rewrap",
-		"rewrite",
-		"rhyme",
-		"ribbon",
-		"ribcage",
-		"rice",
-		"riches",
-		"richly",
-		"richness",
-		"rickety",
-		"ricotta",
-		"riddance",
-		"ridden",
-		"ride",
-		"riding",
-		"rifling",
-		"rift",
-		"rigging",
-		"rigid",
-		"rigor",
-		"rimless",
-		"rimmed",
-		"rind",
-		"rink",
-		"rinse",
-		"rinsing",
-		"riot",
-		"ripcord",
-		"ripeness",
-		"ripening",
-		"ripping",
-		"ripple",
-		"rippling",
-		"riptide",
-		"rise",
-		"rising",
-		"risk",
-		"risotto",
-		"ritalin",
-		"ritzy",
-		"rival",
-		"riverbank",
-		"riverbed",
-		"riverboat",
-		"riverside",
-		"riveter",
-		"riveting",
-		"roamer",
-		"roaming",
-		"roast",
-		"robbing",
-		"robe",
-		"robin",
-		"robotics",
-		"robust",
-		"rockband",
-		"rocker",
-		"rocket",
-		"rockfish",
-		"rockiness",
-		"rocking",
-		"rocklike",
-		"rockslide",
-		"rockstar",
-		"rocky",
-		"rogue",
-		"roman",
-		"romp",
-		"rope",
-		"roping",
-		"roster",
-		"rosy",
-		"rotten",
-		"rotting",
-		"rotunda",
-		"roulette",
-		"rounding",
-		"roundish",
-		"roundness",
-		"roundup",
-		"roundworm",
-		"routine",
-		"routing",
-		"rover",
-		"roving",
-		"royal",
-		"rubbed",
-		"rubber",
-		"rubbing",
-		"rubble",
-		"rubdown",
-		"ruby",
-		"ruckus",
-		"rudder",
-		"rug",
-		"ruined",
-		"rule",
-		"rumble",
-		"rumbling",
-		"rummage",
-		"rumor",
-		"runaround",
-		"rundown",
-		"runner",
-		"running",
-		"runny",
-		"runt",
-		"runway",
-		"rupture",
-		"rural",
-		"ruse",
-		"rush",
-		"rust",
-		"rut",
-		"sabbath",
-		"sabotage",
-		"sacrament",
-		"sacred",
-		"sacrifice",
-		"sadden",
-		"saddlebag",
-		"saddled",
-		"saddling",
-		"sadly",
-		"sadness",
-		"safari",
-		"safeguard",
-		"safehouse",
-		"safely",
-		"safeness",
-		"saffron",
-		"saga",
-		"sage",
-		"sagging",
-		"saggy",
-		"said",
-		"saint",
-		"sake",
-		"salad",
-		"salami",
-		"salaried",
-		"salary",
-		"saline",
-		"salon",
-		"saloon",
-		"salsa",
-		"salt",
-		"salutary",
-		"salute",
-		"salvage",
-		"salvaging",
-		"salvation",
-		"same",
-		"sample",
-		"sampling",
-		"sanction",
-		"sanctity",
-		"sanctuary",
-		"sandal",
-		"sandbag",
-		"sandbank",
-		"sandbar",
-		"sandblast",
-		"sandbox",
-		"sanded",
-		"sandfish",
-		"sanding",
-		"sandlot",
-		"sandpaper",
-		"sandpit",
-		"sandstone",
-		"sandstorm",
-		"sandworm",
-		"sandy",
-		"sanitary",
-		"sanitizer",
-		"sank",
-		"santa",
-		"sapling",
-		"sappiness",
-		"sappy",
-		"sarcasm",
-		"sarcastic",
-		"sardine",
-		"sash",
-		"sasquatch",
-		"sassy",
-		"satchel",
-		"satiable",
-		"satin",
-		"satirical",
-		"satisfied",
-		"satisfy",
-		"saturate",
-		"saturday",
-		"sauciness",
-		"saucy",
-		"sauna",
-		"savage",
-		"savanna",
-		"saved",
-		"savings",
-		"savior",
-		"savor",
-		"saxophone",
-		"say",
-		"scabbed",
-		"scabby",
-		"scalded",
-		"scalding",
-		"scale",
-		"scaling",
-		"scallion",
-		"scallop",
-		"scalping",
-		"scam",
-		"scandal",
-		"scanner",
-		"scanning",
-		"scant",
-		"scapegoat",
-		"scarce",
-		"scarcity",
-		"scarecrow",
-		"scared",
-		"scarf",
-		"scarily",
-		"scariness",
-		"scarring",
-		"scary",
-		"scavenger",
-		"scenic",
-		"schedule",
-		"schematic",
-		"scheme",
-		"scheming",
-		"schilling",
-		"schnapps",
-		"scholar",
-		"science",
-		"scientist",
-		"scion",
-		"scoff",
-		"scolding",
-		"scone",
-		"scoop",
-		"scooter",
-		"scope",
-		"scorch",
-		"scorebook",
-		"scorecard",
-		"scored",
-		"scoreless",
-		"scorer",
-		"scoring",
-		"scorn",
-		"scorpion",
-		"scotch",
-		"scoundrel",
-		"scoured",
-		"scouring",
-		"scouting",
-		"scouts",
-		"scowling",
-		"scrabble",
-		"scraggly",
-		"scrambled",
-		"scrambler",
-		"scrap",
-		"scratch",
-		"scrawny",
-		"screen",
-		"scribble",
-		"scribe",
-		"scribing",
-		"scrimmage",
-		"script",
-		"scroll",
-		"scrooge",
-		"scrounger",
-		"scrubbed",
-		"scrubber",
-		"scruffy",
-		"scrunch",
-		"scrutiny",
-		"scuba",
-		"scuff",
-		"sculptor",
-		"sculpture",
-		"scurvy",
-		"scuttle",
-		"secluded",
-		"secluding",
-		"seclusion",
-		"second",
-		"secrecy",
-		"secret",
-		"sectional",
-		"sector",
-		"secular",
-		"securely",
-		"security",
-		"sedan",
-		"sedate",
-		"sedation",
-		"sedative",
-		"sediment",
-		"seduce",
-		"seducing",
-		"segment",
-		"seismic",
-		"seizing",
-		"seldom",
-		"selected",
-		"selection",
-		"selective",
-		"selector",
-		"self",
-		"seltzer",
-		"semantic",
-		"semester",
-		"semicolon",
-		"semifinal",
-		"seminar",
-		"semisoft",
-		"semisweet",
-		"senate",
-		"senator",
-		"send",
-		"senior",
-		"senorita",
-		"sensation",
-		"sensitive",
-		"sensitize",
-		"sensually",
-		"sensuous",
-		"sepia",
-		"september",
-		"septic",
-		"septum",
-		"sequel",
-		"sequence",
-		"sequester",
-		"series",
-		"sermon",
-		"serotonin",
-		"serpent",
-		"serrated",
-		"serve",
-		"service",
-		"serving",
-		"sesame",
-		"sessions",
-		"setback",
-		"setting",
-		"settle",
-		"settling",
-		"setup",
-		"sevenfold",
-		"seventeen",
-		"seventh",
-		"seventy",
-		"severity",
-		"shabby",
-		"shack",
-		"shaded",
-		"shadily",
-		"shadiness",
-		"shading",
-		"shadow",
-		"shady",
-		"shaft",
-		"shakable",
-		"shakily",
-		"shakiness",
-		"shaking",
-		"shaky",
-		"shale",
-		"shallot",
-		"shallow",
-		"shame",
-		"shampoo",
-		"shamrock",
-		"shank",
-		"shanty",
-		"shape",
-		"shaping",
-		"share",
-		"sharpener",
-		"sharper",
-		"sharpie",
-		"sharply",
-		"sharpness",
-		"shawl",
-		"sheath",
-		"shed",
-		"sheep",
-		"sheet",
-		"shelf",
-		"shell",
-		"shelter",
-		"shelve",
-		"shelving",
-		"sherry",
-		"shield",
-		"shifter",
-		"shifting",
-		"shiftless",
-		"shifty",
-		"shimmer",
-		"shimmy",
-		"shindig",
-		"shine",
-		"shingle",
-		"shininess",
-		"shining",
-		"shiny",
-		"ship",
-		"shirt",
-		"shivering",
-		"shock",
-		"shone",
-		"shoplift",
-		"shopper",
-		"shopping",
-		"shoptalk",
-		"shore",
-		"shortage",
-		"shortcake",
-		"shortcut",
-		"shorten",
-		"shorter",
-		"shorthand",
-		"shortlist",
-		"shortly",
-		"shortness",
-		"shorts",
-		"shortwave",
-		"shorty",
-		"shout",
-		"shove",
-		"showbiz",
-		"showcase",
-		"showdown",
-		"shower",
-		"showgirl",
-		"showing",
-		"showman",
-		"shown",
-		"showoff",
-		"showpiece",
-		"showplace",
-		"showroom",
-		"showy",
-		"shrank",
-		"shrapnel",
-		"shredder",
-		"shredding",
-		"shrewdly",
-		"shriek",
-		"shrill",
-		"shrimp",
-		"shrine",
-		"shrink",
-		"shrivel",
-		"shrouded",
-		"shrubbery",
-		"shrubs",
-		"shrug",
-		"shrunk",
-		"shucking",
-		"shudder",
-		"shuffle",
-		"shuffling",
-		"shun",
-		"shush",
-		"shut",
-		"shy",
-		"siamese",
-		"siberian",
-		"sibling",
-		"siding",
-		"sierra",
-		"siesta",
-		"sift",
-		"sighing",
-		"silenced",
-		"silencer",
-		"silent",
-		"silica",
-		"silicon",
-		"silk",
-		"silliness",
-		"silly",
-		"silo",
-		"silt",
-		"silver",
-		"similarly",
-		"simile",
-		"simmering",
-		"simple",
-		"simplify",
-		"simply",
-		"sincere",
-		"sincerity",
-		"singer",
-		"singing",
-		"single",
-		"singular",
-		"sinister",
-		"sinless",
-		"sinner",
-		"sinuous",
-		"sip",
-		"siren",
-		"sister",
-		"sitcom",
-		"sitter",
-		"sitting",
-		"situated",
-		"situation",
-		"sixfold",
-		"sixteen",
-		"sixth",
-		"sixties",
-		"sixtieth",
-		"sixtyfold",
-		"sizable",
-		"sizably",
-		"size",
-		"sizing",
-		"sizzle",
-		"sizzling",
-		"skater",
-		"skating",
-		"skedaddle",
-		"skeletal",
-		"skeleton",
-		"skeptic",
-		"sketch",
-		"skewed",
-		"skewer",
-		"skid",
-		"skied",
-		"skier",
-		"skies",
-		"skiing",
-		"skilled",
-		"skillet",
-		"skillful",
-		"skimmed",
-		"skimmer",
-		"skimming",
-		"skimpily",
-		"skincare",
-		"skinhead",
-		"skinless",
-		"skinning",
-		"skinny",
-		"skintight",
-		"skipper",
-		"skipping",
-		"skirmish",
-		"skirt",
-		"skittle",
-		"skydiver",
-		"skylight",
-		"skyline",
-		"skype",
-		"skyrocket",
-		"skyward",
-		"slab",
-		"slacked",
-		"slacker",
-		"slacking",
-		"slackness",
-		"slacks",
-		"slain",
-		"slam",
-		"slander",
-		"slang",
-		"slapping",
-		"slapstick",
-		"slashed",
-		"slashing",
-		"slate",
-		"slather",
-		"slaw",
-		"sled",
-		"sleek",
-		"sleep",
-		"sleet",
-		"sleeve",
-		"slept",
-		"sliceable",
-		"sliced",
-		"slicer",
-		"slicing",
-		"slick",
-		"slider",
-		"slideshow",
-		"sliding",
-		"slighted",
-		"slighting",
-		"slightly",
-		"slimness",
-		"slimy",
-		"slinging",
-		"slingshot",
-		"slinky",
-		"slip",
-		"slit",
-		"sliver",
-		"slobbery",
-		"slogan",
-		"sloped",
-		"sloping",
-		"sloppily",
-		"sloppy",
-		"slot",
-		"slouching",
-		"slouchy",
-		"sludge",
-		"slug",
-		"slum",
-		"slurp",
-		"slush",
-		"sly",
-		"small",
-		"smartly",
-		"smartness",
-		"smasher",
-		"smashing",
-		"smashup",
-		"smell",
-		"smelting",
-		"smile",
-		"smilingly",
-		"smirk",
-		"smite",
-		"smith",
-		"smitten",
-		"smock",
-		"smog",
-		"smoked",
-		"smokeless",
-		"smokiness",
-		"smoking",
-		"smoky",
-		"smolder",
-		"smooth",
-		"smother",
-		"smudge",
-		"smudgy",
-		"smuggler",
-		"smuggling",
-		"smugly",
-		"smugness",
-		"snack",
-		"snagged",
-		"snaking",
-		"snap",
-		"snare",
-		"snarl",
-		"snazzy",
-		"sneak",
-		"sneer",
-		"sneeze",
-		"sneezing",
-		"snide",
-		"sniff",
-		"snippet",
-		"snipping",
-		"snitch",
-		"snooper",
-		"snooze",
-		"snore",
-		"snoring",
-		"snorkel",
-		"snort",
-		"snout",
-		"snowbird",
-		"snowboard",
-		"snowbound",
-		"snowcap",
-		"snowdrift",
-		"snowdrop",
-		"snowfall",
-		"snowfield",
-		"snowflake",
-		"snowiness",
-		"snowless",
-		"snowman",
-		"snowplow",
-		"snowshoe",
-		"snowstorm",
-		"snowsuit",
-		"snowy",
-		"snub",
-		"snuff",
-		"snuggle",
-		"snugly",
-		"snugness",
-		"speak",
-		"spearfish",
-		"spearhead",
-		"spearman",
-		"spearmint",
-		"species",
-		"specimen",
-		"specked",
-		"speckled",
-		"specks",
-		"spectacle",
-		"spectator",
-		"spectrum",
-		"speculate",
-		"speech",
-		"speed",
-		"spellbind",
-		"speller",
-		"spelling",
-		"spendable",
-		"spender",
-		"spending",
-		"spent",
-		"spew",
-		"sphere",
-		"spherical",
-		"sphinx",
-		"spider",
-		"spied",
-		"spiffy",
-		"spill",
-		"spilt",
-		"spinach",
-		"spinal",
-		"spindle",
-		"spinner",
-		"spinning",
-		"spinout",
-		"spinster",
-		"spiny",
-		"spiral",
-		"spirited",
-		"spiritism",
-		"spirits",
-		"spiritual",
-		"splashed",
-		"splashing",
-		"splashy",
-		"splatter",
-		"spleen",
-		"splendid",
-		"splendor",
-		"splice",
-		"splicing",
-		"splinter",
-		"splotchy",
-		"splurge",
-		"spoilage",
-		"spoiled",
-		"spoiler",
-		"spoiling",
-		"spoils",
-		"spoken",
-		"spokesman",
-		"sponge",
-		"spongy",
-		"sponsor",
-		"spoof",
-		"spookily",
-		"spooky",
-		"spool",
-		"spoon",
-		"spore",
-		"sporting",
-		"sports",
-		"sporty",
-		"spotless",
-		"spotlight",
-		"spotted",
-		"spotter",
-		"spotting",
-		"spotty",
-		"spousal",
-		"spouse",
-		"spout",
-		"sprain",
-		"sprang",
-		"sprawl",
-		"spray",
-		"spree",
-		"sprig",
-		"spring",
-		"sprinkled",
-		"sprinkler",
-		"sprint",
-		"sprite",
-		"sprout",
-		"spruce",
-		"sprung",
-		"spry",
-		"spud",
-		"spur",
-		"sputter",
-		"spyglass",
-		"squabble",
-		"squad",
-		"squall",
-		"squander",
-		"squash",
-		"squatted",
-		"squatter",
-		"squatting",
-		"squeak",
-		"squealer",
-		"squealing",
-		"squeamish",
-		"squeegee",
-		"squeeze",
-		"squeezing",
-		"squid",
-		"squiggle",
-		"squiggly",
-		"squint",
-		"squire",
-		"squirt",
-		"squishier",
-		"squishy",
-		"stability",
-		"stabilize",
-		"stable",
-		"stack",
-		"stadium",
-		"staff",
-		"stage",
-		"staging",
-		"stagnant",
-		"stagnate",
-		"stainable",
-		"stained",
-		"staining",
-		"stainless",
-		"stalemate",
-		"staleness",
-		"stalling",
-		"stallion",
-		"stamina",
-		"stammer",
-		"stamp",
-		"stand",
-		"stank",
-		"staple",
-		"stapling",
-		"starboard",
-		"starch",
-		"stardom",
-		"stardust",
-		"starfish",
-		"stargazer",
-		"staring",
-		"stark",
-		"starless",
-		"starlet",
-		"starlight",
-		"starlit",
-		"starring",
-		"starry",
-		"starship",
-		"starter",
-		"starting",
-		"startle",
-		"startling",
-		"startup",
-		"starved",
-		"starving",
-		"stash",
-		"state",
-		"static",
-		"statistic",
-		"statue",
-		"stature",
-		"status",
-		"statute",
-		"statutory",
-		"staunch",
-		"stays",
-		"steadfast",
-		"steadier",
-		"steadily",
-		"steadying",
-		"steam",
-		"steed",
-		"steep",
-		"steerable",
-		"steering",
-		"steersman",
-		"stegosaur",
-		"stellar",
-		"stem",
-		"stench",
-		"stencil",
-		"step",
-		"stereo",
-		"sterile",
-		"sterility",
-		"sterilize",
-		"sterling",
-		"sternness",
-		"sternum",
-		"stew",
-		"stick",
-		"stiffen",
-		"stiffly",
-		"stiffness",
-		"stifle",
-		"stifling",
-		"stillness",
-		"stilt",
-		"stimulant",
-		"stimulate",
-		"stimuli",
-		"stimulus",
-		"stinger",
-		"stingily",
-		"stinging",
-		"stingray",
-		"stingy",
-		"stinking",
-		"stinky",
-		"stipend",
-		"stipulate",
-		"stir",
-		"stitch",
-		"stock",
-		"stoic",
-		"stoke",
-		"stole",
-		"stomp",
-		"stonewall",
-		"stoneware",
-		"stonework",
-		"stoning",
-		"stony",
-		"stood",
-		"stooge",
-		"stool",
-		"stoop",
-		"stoplight",
-		"stoppable",
-		"stoppage",
-		"stopped",
-		"stopper",
-		"stopping",
-		"stopwatch",
-		"storable",
-		"storage",
-		"storeroom",
-		"storewide",
-		"storm",
-		"stout",
-		"stove",
-		"stowaway",
-		"stowing",
-		"straddle",
-		"straggler",
-		"strained",
-		"strainer",
-		"straining",
-		"strangely",
-		"stranger",
-		"strangle",
-		"strategic",
-		"strategy",
-		"stratus",
-		"straw",
-		"stray",
-		"streak",
-		"stream",
-		"street",
-		"strength",
-		"strenuous",
-		"strep",
-		"stress",
-		"stretch",
-		"strewn",
-		"stricken",
-		"strict",
-		"stride",
-		"strife",
-		"strike",
-		"striking",
-		"strive",
-		"striving",
-		"strobe",
-		"strode",
-		"stroller",
-		"strongbox",
-		"strongly",
-		"strongman",
-		"struck",
-		"structure",
-		"strudel",
-		"struggle",
-		"strum",
-		"strung",
-		"strut",
-		"stubbed",
-		"stubble",
-		"stubbly",
-		"stubborn",
-		"stucco",
-		"stuck",
-		"student",
-		"studied",
-		"studio",
-		"study",
-		"stuffed",
-		"stuffing",
-		"stuffy",
-		"stumble",
-		"stumbling",
-		"stump",
-		"stung",
-		"stunned",
-		"stunner",
-		"stunning",
-		"stunt",
-		"stupor",
-		"sturdily",
-		"sturdy",
-		"styling",
-		"stylishly",
-		"stylist",
-		"stylized",
-		"stylus",
-		"suave",
-		"subarctic",
-		"subatomic",
-		"subdivide",
-		"subdued",
-		"subduing",
-		"subfloor",
-		"subgroup",
-		"subheader",
-		"subject",
-		"sublease",
-		"sublet",
-		"sublevel",
-		"sublime",
-		"submarine",
-		"submerge",
-		"submersed",
-		"submitter",
-		"subpanel",
-		"subpar",
-		"subplot",
-		"subprime",
-		"subscribe",
-		"subscript",
-		"subsector",
-		"subside",
-		"subsiding",
-		"subsidize",
-		"subsidy",
-		"subsoil",
-		"subsonic",
-		"substance",
-		"subsystem",
-		"subtext",
-		"subtitle",
-		"subtly",
-		"subtotal",
-		"subtract",
-		"subtype",
-		"suburb",
-		"subway",
-		"subwoofer",
-		"subzero",
-		"succulent",
-		"such",
-		"suction",
-		"sudden",
-		"sudoku",
-		"suds",
-		"sufferer",
-		"suffering",
-		"suffice",
-		"suffix",
-		"suffocate",
-		"suffrage",
-		"sugar",
-		"suggest",
-		"suing",
-		"suitable",
-		"suitably",
-		"suitcase",
-		"suitor",
-		"sulfate",
-		"sulfide",
-		"sulfite",
-		"sulfur",
-		"sulk",
-		"sullen",
-		"sulphate",
-		"sulphuric",
-		"sultry",
-		"superbowl",
-		"superglue",
-		"superhero",
-		"superior",
-		"superjet",
-		"superman",
-		"supermom",
-		"supernova",
-		"supervise",
-		"supper",
-		"supplier",
-		"supply",
-		"support",
-		"supremacy",
-		"supreme",
-		"surcharge",
-		"surely",
-		"sureness",
-		"surface",
-		"surfacing",
-		"surfboard",
-		"surfer",
-		"surgery",
-		"surgical",
-		"surging",
-		"surname",
-		"surpass",
-		"surplus",
-		"surprise",
-		"surreal",
-		"surrender",
-		"surrogate",
-		"surround",
-		"survey",
-		"survival",
-		"survive",
-		"surviving",
-		"survivor",
-		"sushi",
-		"suspect",
-		"suspend",
-		"suspense",
-		"sustained",
-		"sustainer",
-		"swab",
-		"swaddling",
-		"swagger",
-		"swampland",
-		"swan",
-		"swapping",
-		"swarm",
-		"sway",
-		"swear",
-		"sweat",
-		"sweep",
-		"swell",
-		"swept",
-		"swerve",
-		"swifter",
-		"swiftly",
-		"swiftness",
-		"swimmable",
-		"swimmer",
-		"swimming",
-		"swimsuit",
-		"swimwear",
-		"swinger",
-		"swinging",
-		"swipe",
-		"swirl",
-		"switch",
-		"swivel",
-		"swizzle",
-		"swooned",
-		"swoop",
-		"swoosh",
-		"swore",
-		"sworn",
-		"swung",
-		"sycamore",
-		"sympathy",
-		"symphonic",
-		"symphony",
-		"symptom",
-		"synapse",
-		"syndrome",
-		"synergy",
-		"synopses",
-		"synopsis",
-		"synthesis",
-		"synthetic",
-		"syrup",
-		"system",
-		"t-shirt",
-		"tabasco",
-		"tabby",
-		"tableful",
-		"tables",
-		"tablet",
-		"tableware",
-		"tabloid",
-		"tackiness",
-		"tacking",
-		"tackle",
-		"tackling",
-		"tacky",
-		"taco",
-		"tactful",
-		"tactical",
-		"tactics",
-		"tactile",
-		"tactless",
-		"tadpole",
-		"taekwondo",
-		"tag",
-		"tainted",
-		"take",
-		"taking",
-		"talcum",
-		"talisman",
-		"tall",
-		"talon",
-		"tamale",
-		"tameness",
-		"tamer",
-		"tamper",
-		"tank",
-		"tanned",
-		"tannery",
-		"tanning",
-		"tantrum",
-		"tapeless",
-		"tapered",
-		"tapering",
-		"tapestry",
-		"tapioca",
-		"tapping",
-		"taps",
-		"tarantula",
-		"target",
-		"tarmac",
-		"tarnish",
-		"tarot",
-		"tartar",
-		"tartly",
-		"tartness",
-		"task",
-		"tassel",
-		"taste",
-		"tastiness",
-		"tasting",
-		"tasty",
-		"tattered",
-		"tattle",
-		"tattling",
-		"tattoo",
-		"taunt",
-		"tavern",
-		"thank",
-		"that",
-		"thaw",
-		"theater",
-		"theatrics",
-		"thee",
-		"theft",
-		"theme",
-		"theology",
-		"theorize",
-		"thermal",
-		"thermos",
-		"thesaurus",
-		"these",
-		"thesis",
-		"thespian",
-		"thicken",
-		"thicket",
-		"thickness",
-		"thieving",
-		"thievish",
-		"thigh",
-		"thimble",
-		"thing",
-		"think",
-		"thinly",
-		"thinner",
-		"thinness",
-		"thinning",
-		"thirstily",
-		"thirsting",
-		"thirsty",
-		"thirteen",
-		"thirty",
-		"thong",
-		"thorn",
-		"those",
-		"thousand",
-		"thrash",
-		"thread",
-		"threaten",
-		"threefold",
-		"thrift",
-		"thrill",
-		"thrive",
-		"thriving",
-		"throat",
-		"throbbing",
-		"throng",
-		"throttle",
-		"throwaway",
-		"throwback",
-		"thrower",
-		"throwing",
-		"thud",
-		"thumb",
-		"thumping",
-		"thursday",
-		"thus",
-		"thwarting",
-		"thyself",
-		"tiara",
-		"tibia",
-		"tidal",
-		"tidbit",
-		"tidiness",
-		"tidings",
-		"tidy",
-		"tiger",
-		"tighten",
-		"tightly",
-		"tightness",
-		"tightrope",
-		"tightwad",
-		"tigress",
-		"tile",
-		"tiling",
-		"till",
-		"tilt",
-		"timid",
-		"timing",
-		"timothy",
-		"tinderbox",
-		"tinfoil",
-		"tingle",
-		"tingling",
-		"tingly",
-		"tinker",
-		"tinkling",
-		"tinsel",
-		"tinsmith",
-		"tint",
-		"tinwork",
-		"tiny",
-		"tipoff",
-		"tipped",
-		"tipper",
-		"tipping",
-		"tiptoeing",
-		"tiptop",
-		"tiring",
-		"tissue",
-		"trace",
-		"tracing",
-		"track",
-		"traction",
-		"tractor",
-		"trade",
-		"trading",
-		"tradition",
-		"traffic",
-		"tragedy",
-		"trailing",
-		"trailside",
-		"train",
-		"traitor",
-		"trance",
-		"tranquil",
-		"transfer",
-		"transform",
-		"translate",
-		"transpire",
-		"transport",
-		"transpose",
-		"trapdoor",
-		"trapeze",
-		"trapezoid",
-		"trapped",
-		"trapper",
-		"trapping",
-		"traps",
-		"trash",
-		"travel",
-		"traverse",
-		"travesty",
-		"tray",
-		"treachery",
-		"treading",
-		"treadmill",
-		"treason",
-		"treat",
-		"treble",
-		"tree",
-		"trekker",
-		"tremble",
-		"trembling",
-		"tremor",
-		"trench",
-		"trend",
-		"trespass",
-		"triage",
-		"trial",
-		"triangle",
-		"tribesman",
-		"tribunal",
-		"tribune",
-		"tributary",
-		"tribute",
-		"triceps",
-		"trickery",
-		"trickily",
-		"tricking",
-		"trickle",
-		"trickster",
-		"tricky",
-		"tricolor",
-		"tricycle",
-		"trident",
-		"tried",
-		"trifle",
-		"trifocals",
-		"trillion",
-		"trilogy",
-		"trimester",
-		"trimmer",
-		"trimming",
-		"trimness",
-		"trinity",
-		"trio",
-		"tripod",
-		"tripping",
-		"triumph",
-		"trivial",
-		"trodden",
-		"trolling",
-		"trombone",
-		"trophy",
-		"tropical",
-		"tropics",
-		"trouble",
-		"troubling",
-		"trough",
-		"trousers",
-		"trout",
-		"trowel",
-		"truce",
-		"truck",
-		"truffle",
-		"trump",
-		"trunks",
-		"trustable",
-		"trustee",
-		"trustful",
-		"trusting",
-		"trustless",
-		"truth",
-		"try",
-		"tubby",
-		"tubeless",
-		"tubular",
-		"tucking",
-		"tuesday",
-		"tug",
-		"tuition",
-		"tulip",
-		"tumble",
-		"tumbling",
-		"tummy",
-		"turban",
-		"turbine",
-		"turbofan",
-		"turbojet",
-		"turbulent",
-		"turf",
-		"turkey",
-		"turmoil",
-		"turret",
-		"turtle",
-		"tusk",
-		"tutor",
-		"tutu",
-		"tux",
-		"tweak",
-		"tweed",
-		"tweet",
-		"tweezers",
-		"twelve",
-		"twentieth",
-		"twenty",
-		"twerp",
-		"twice",
-		"twiddle",
-		"twiddling",
-		"twig",
-		"twilight",
-		"twine",
-		"twins",
-		"twirl",
-		"twistable",
-		"twisted",
-		"twister",
-		"twisting",
-		"twisty",
-		"twitch",
-		"twitter",
-		"tycoon",
-		"tying",
-		"tyke",
-		"udder",
-		"ultimate",
-		"ultimatum",
-		"ultra",
-		"umbilical",
-		"umbrella",
-		"umpire",
-		"unabashed",
-		"unable",
-		"unadorned",
-		"unadvised",
-		"unafraid",
-		"unaired",
-		"unaligned",
-		"unaltered",
-		"unarmored",
-		"unashamed",
-		"unaudited",
-		"unawake",
-		"unaware",
-		"unbaked",
-		"unbalance",
-		"unbeaten",
-		"unbend",
-		"unbent",
-		"unbiased",
-		"unbitten",
-		"unblended",
-		"unblessed",
-		"unblock",
-		"unbolted",
-		"unbounded",
-		"unboxed",
-		"unbraided",
-		"unbridle",
-		"unbroken",
-		"unbuckled",
-		"unbundle",
-		"unburned",
-		"unbutton",
-		"uncanny",
-		"uncapped",
-		"uncaring",
-		"uncertain",
-		"unchain",
-		"unchanged",
-		"uncharted",
-		"uncheck",
-		"uncivil",
-		"unclad",
-		"unclaimed",
-		"unclamped",
-		"unclasp",
-		"uncle",
-		"unclip",
-		"uncloak",
-		"unclog",
-		"unclothed",
-		"uncoated",
-		"uncoiled",
-		"uncolored",
-		"uncombed",
-		"uncommon",
-		"uncooked",
-		"uncork",
-		"uncorrupt",
-		"uncounted",
-		"uncouple",
-		"uncouth",
-		"uncover",
-		"uncross",
-		"uncrown",
-		"uncrushed",
-		"uncured",
-		"uncurious",
-		"uncurled",
-		"uncut",
-		"undamaged",
-		"undated",
-		"undaunted",
-		"undead",
-		"undecided",
-		"undefined",
-		"underage",
-		"underarm",
-		"undercoat",
-		"undercook",
-		"undercut",
-		"underdog",
-		"underdone",
-		"underfed",
-		"underfeed",
-		"underfoot",
-		"undergo",
-		"undergrad",
-		"underhand",
-		"underline",
-		"underling",
-		"undermine",
-		"undermost",
-		"underpaid",
-		"underpass",
-		"underpay",
-		"underrate",
-		"undertake",
-		"undertone",
-		"undertook",
-		"undertow",
-		"underuse",
-		"underwear",
-		"underwent",
-		"underwire",
-		"undesired",
-		"undiluted",
-		"undivided",
-		"undocked",
-		"undoing",
-		"undone",
-		"undrafted",
-		"undress",
-		"undrilled",
-		"undusted",
-		"undying",
-		"unearned",
-		"unearth",
-		"unease",
-		"uneasily",
-		"uneasy",
-		"uneatable",
-		"uneaten",
-		"unedited",
-		"unelected",
-		"unending",
-		"unengaged",
-		"unenvied",
-		"unequal",
-		"unethical",
-		"uneven",
-		"unexpired",
-		"unexposed",
-		"unfailing",
-		"unfair",
-		"unfasten",
-		"unfazed",
-		"unfeeling",
-		"unfiled",
-		"unfilled",
-		"unfitted",
-		"unfitting",
-		"unfixable",
-		"unfixed",
-		"unflawed",
-		"unfocused",
-		"unfold",
-		"unfounded",
-		"unframed",
-		"unfreeze",
-		"unfrosted",
-		"unfrozen",
-		"unfunded",
-		"unglazed",
-		"ungloved",
-		"unglue",
-		"ungodly",
-		"ungraded",
-		"ungreased",
-		"unguarded",
-		"unguided",
-		"unhappily",
-		"unhappy",
-		"unharmed",
-		"unhealthy",
-		"unheard",
-		"unhearing",
-		"unheated",
-		"unhelpful",
-		"unhidden",
-		"unhinge",
-		"unhitched",
-		"unholy",
-		"unhook",
-		"unicorn",
-		"unicycle",
-		"unified",
-		"unifier",
-		"uniformed",
-		"uniformly",
-		"unify",
-		"unimpeded",
-		"uninjured",
-		"uninstall",
-		"uninsured",
-		"uninvited",
-		"union",
-		"uniquely",
-		"unisexual",
-		"unison",
-		"unissued",
-		"unit",
-		"universal",
-		"universe",
-		"unjustly",
-		"unkempt",
-		"unkind",
-		"unknotted",
-		"unknowing",
-		"unknown",
-		"unlaced",
-		"unlatch",
-		"unlawful",
-		"unleaded",
-		"unlearned",
-		"unleash",
-		"unless",
-		"unleveled",
-		"unlighted",
-		"unlikable",
-		"unlimited",
-		"unlined",
-		"unlinked",
-		"unlisted",
-		"unlit",
-		"unlivable",
-		"unloaded",
-		"unloader",
-		"unlocked",
-		"unlocking",
-		"unlovable",
-		"unloved",
-		"unlovely",
-		"unloving",
-		"unluckily",
-		"unlucky",
-		"unmade",
-		"unmanaged",
-		"unmanned",
-		"unmapped",
-		"unmarked",
-		"unmasked",
-		"unmasking",
-		"unmatched",
-		"unmindful",
-		"unmixable",
-		"unmixed",
-		"unmolded",
-		"unmoral",
-		"unmovable",
-		"unmoved",
-		"unmoving",
-		"unnamable",
-		"unnamed",
-		"unnatural",
-		"unneeded",
-		"unnerve",
-		"unnerving",
-		"unnoticed",
-		"unopened",
-		"unopposed",
-		"unpack",
-		"unpadded",
-		"unpaid",
-		"unpainted",
-		"unpaired",
-		"unpaved",
-		"unpeeled",
-		"unpicked",
-		"unpiloted",
-		"unpinned",
-		"unplanned",
-		"unplanted",
-		"unpleased",
-		"unpledged",
-		"unplowed",
-		"unplug",
-		"unpopular",
-		"unproven",
-		"unquote",
-		"unranked",
-		"unrated",
-		"unraveled",
-		"unreached",
-		"unread",
-		"unreal",
-		"unreeling",
-		"unrefined",
-		"unrelated",
-		"unrented",
-		"unrest",
-		"unretired",
-		"unrevised",
-		"unrigged",
-		"unripe",
-		"unrivaled",
-		"unroasted",
-		"unrobed",
-		"unroll",
-		"unruffled",
-		"unruly",
-		"unrushed",
-		"unsaddle",
-		"unsafe",
-		"unsaid",
-		"unsalted",
-		"unsaved",
-		"unsavory",
-		"unscathed",
-		"unscented",
-		"unscrew",
-		"unsealed",
-		"unseated",
-		"unsecured",
-		"unseeing",
-		"unseemly",
-		"unseen",
-		"unselect",
-		"unselfish",
-		"unsent",
-		"unsettled",
-		"unshackle",
-		"unshaken",
-		"unshaved",
-		"unshaven",
-		"unsheathe",
-		"unshipped",
-		"unsightly",
-		"unsigned",
-		"unskilled",
-		"unsliced",
-		"unsmooth",
-		"unsnap",
-		"unsocial",
-		"unsoiled",
-		"unsold",
-		"unsolved",
-		"unsorted",
-		"unspoiled",
-		"unspoken",
-		"unstable",
-		"unstaffed",
-		"unstamped",
-		"unsteady",
-		"unsterile",
-		"unstirred",
-		"unstitch",
-		"unstopped",
-		"unstuck",
-		"unstuffed",
-		"unstylish",
-		"unsubtle",
-		"unsubtly",
-		"unsuited",
-		"unsure",
-		"unsworn",
-		"untagged",
-		"untainted",
-		"untaken",
-		"untamed",
-		"untangled",
-		"untapped",
-		"untaxed",
-		"unthawed",
-		"unthread",
-		"untidy",
-		"untie",
-		"until",
-		"untimed",
-		"untimely",
-		"untitled",
-		"untoasted",
-		"untold",
-		"untouched",
-		"untracked",
-		"untrained",
-		"untreated",
-		"untried",
-		"untrimmed",
-		"untrue",
-		"untruth",
-		"unturned",
-		"untwist",
-		"untying",
-		"unusable",
-		"unused",
-		"unusual",
-		"unvalued",
-		"unvaried",
-		"unvarying",
-		"unveiled",
-		"unveiling",
-		"unvented",
-		"unviable",
-		"unvisited",
-		"unvocal",
-		"unwanted",
-		"unwarlike",
-		"unwary",
-		"unwashed",
-		"unwatched",
-		"unweave",
-		"unwed",
-		"unwelcome",
-		"unwell",
-		"unwieldy",
-		"unwilling",
-		"unwind",
-		"unwired",
-		"unwitting",
-		"unwomanly",
-		"unworldly",
-		"unworn",
-		"unworried",
-		"unworthy",
-		"unwound",
-		"unwoven",
-		"unwrapped",
-		"unwritten",
-		"unzip",
-		"upbeat",
-		"upchuck",
-		"upcoming",
-		"upcountry",
-		"update",
-		"upfront",
-		"upgrade",
-		"upheaval",
-		"upheld",
-		"uphill",
-		"uphold",
-		"uplifted",
-		"uplifting",
-		"upload",
-		"upon",
-		"upper",
-		"upright",
-		"uprising",
-		"upriver",
-		"uproar",
-		"uproot",
-		"upscale",
-		"upside",
-		"upstage",
-		"upstairs",
-		"upstart",
-		"upstate",
-		"upstream",
-		"upstroke",
-		"upswing",
-		"uptake",
-		"uptight",
-		"uptown",
-		"upturned",
-		"upward",
-		"upwind",
-		"uranium",
-		"urban",
-		"urchin",
-		"urethane",
-		"urgency",
-		"urgent",
-		"urging",
-		"urologist",
-		"urology",
-		"usable",
-		"usage",
-		"useable",
-		"used",
-		"uselessly",
-		"user",
-		"usher",
-		"usual",
-		"utensil",
-		"utility",
-		"utilize",
-		"utmost",
-		"utopia",
-		"utter",
-		"vacancy",
-		"vacant",
-		"vacate",
-		"vacation",
-		"vagabond",
-		"vagrancy",
-		"vagrantly",
-		"vaguely",
-		"vagueness",
-		"valiant",
-		"valid",
-		"valium",
-		"valley",
-		"valuables",
-		"value",
-		"vanilla",
-		"vanish",
-		"vanity",
-		"vanquish",
-		"vantage",
-		"vaporizer",
-		"variable",
-		"variably",
-		"varied",
-		"variety",
-		"various",
-		"varmint",
-		"varnish",
-		"varsity",
-		"varying",
-		"vascular",
-		"vaseline",
-		"vastly",
-		"vastness",
-		"veal",
-		"vegan",
-		"veggie",
-		"vehicular",
-		"velcro",
-		"velocity",
-		"velvet",
-		"vendetta",
-		"vending",
-		"vendor",
-		"veneering",
-		"vengeful",
-		"venomous",
-		"ventricle",
-		"venture",
-		"venue",
-		"venus",
-		"verbalize",
-		"verbally",
-		"verbose",
-		"verdict",
-		"verify",
-		"verse",
-		"version",
-		"versus",
-		"vertebrae",
-		"vertical",
-		"vertigo",
-		"very",
-		"vessel",
-		"vest",
-		"veteran",
-		"veto",
-		"vexingly",
-		"viability",
-		"viable",
-		"vibes",
-		"vice",
-		"vicinity",
-		"victory",
-		"video",
-		"viewable",
-		"viewer",
-		"viewing",
-		"viewless",
-		"viewpoint",
-		"vigorous",
-		"village",
-		"villain",
-		"vindicate",
-		"vineyard",
-		"vintage",
-		"violate",
-		"violation",
-		"violator",
-		"violet",
-		"violin",
-		"viper",
-		"viral",
-		"virtual",
-		"virtuous",
-		"virus",
-		"visa",
-		"viscosity",
-		"viscous",
-		"viselike",
-		"visible",
-		"visibly",
-		"vision",
-		"visiting",
-		"visitor",
-		"visor",
-		"vista",
-		"vitality",
-		"vitalize",
-		"vitally",
-		"vitamins",
-		"vivacious",
-		"vividly",
-		"vividness",
-		"vixen",
-		"vocalist",
-		"vocalize",
-		"vocally",
-		"vocation",
-		"voice",
-		"voicing",
-		"void",
-		"volatile",
-		"volley",
-		"voltage",
-		"volumes",
-		"voter",
-		"voting",
-		"voucher",
-		"vowed",
-		"vowel",
-		"voyage",
-		"wackiness",
-		"wad",
-		"wafer",
-		"waffle",
-		"waged",
-		"wager",
-		"wages",
-		"waggle",
-		"wagon",
-		"wake",
-		"waking",
-		"walk",
-		"walmart",
-		"walnut",
-		"walrus",
-		"waltz",
-		"wand",
-		"wannabe",
-		"wanted",
-		"wanting",
-		"wasabi",
-		"washable",
-		"washbasin",
-		"washboard",
-		"washbowl",
-		"washcloth",
-		"washday",
-		"washed",
-		"washer",
-		"washhouse",
-		"washing",
-		"washout",
-		"washroom",
-		"washstand",
-		"washtub",
-		"wasp",
-		"wasting",
-		"watch",
-		"water",
-		"waviness",
-		"waving",
-		"wavy",
-		"whacking",
-		"whacky",
-		"wham",
-		"wharf",
-		"wheat",
-		"whenever",
-		"whiff",
-		"whimsical",
-		"whinny",
-		"whiny",
-		"whisking",
-		"whoever",
-		"whole",
-		"whomever",
-		"whoopee",
-		"whooping",
-		"whoops",
-		"why",
-		"wick",
-		"widely",
-		"widen",
-		"widget",
-		"widow",
-		"width",
-		"wieldable",
-		"wielder",
-		"wife",
-		"wifi",
-		"wikipedia",
-		"wildcard",
-		"wildcat",
-		"wilder",
-		"wildfire",
-		"wildfowl",
-		"wildland",
-		"wildlife",
-		"wildly",
-		"wildness",
-		"willed",
-		"willfully",
-		"willing",
-		"willow",
-		"willpower",
-		"wilt",
-		"wimp",
-		"wince",
-		"wincing",
-		"wind",
-		"wing",
-		"winking",
-		"winner",
-		"winnings",
-		"winter",
-		"wipe",
-		"wired",
-		"wireless",
-		"wiring",
-		"wiry",
-		"wisdom",
-		"wise",
-		"wish",
-		"wisplike",
-		"wispy",
-		"wistful",
-		"wizard",
-		"wobble",
-		"wobbling",
-		"wobbly",
-		"wok",
-		"wolf",
-		"wolverine",
-		"womanhood",
-		"womankind",
-		"womanless",
-		"womanlike",
-		"womanly",
-		"womb",
-		"woof",
-		"wooing",
-		"wool",
-		"woozy",
-		"word",
-		"work",
-		"worried",
-		"worrier",
-		"worrisome",
-		"worry",
-		"worsening",
-		"worshiper",
-		"worst",
-		"wound",
-		"woven",
-		"wow",
-		"wrangle",
-		"wrath",
-		"wreath",
-		"wreckage",
-		"wrecker",
-		"wrecking",
-		"wrench",
-		"wriggle",
-		"wriggly",
-		"wrinkle",
-		"wrinkly",
-		"wrist",
-		"writing",
-		"written",
-		"wrongdoer",
-		"wronged",
-		"wrongful",
-		"wrongly",
-		"wrongness",
-		"wrought",
-		"xbox",
-		"xerox",
-		"yahoo",
-		"yam",
-		"yanking",
-		"yapping",
-		"yard",
-		"yarn",
-		"yeah",
-		"yearbook",
-		"yearling",
-		"yearly",
-		"yearning",
-		"yeast",
-		"yelling",
-		"yelp",
-		"yen",
-		"yesterday",
-		"yiddish",
-		"yield",
-		"yin",
-		"yippee",
-		"yo-yo",
-		"yodel",
-		"yoga",
-		"yogurt",
-		"yonder",
-		"yoyo",
-		"yummy",
-		"zap",
-		"zealous",
-		"zebra",
-		"zen",
-		"zeppelin",
-		"zero",
-		"zestfully",
-		"zesty",
-		"zigzagged",
-		"zipfile",
-		"zipping",
-		"zippy",
-		"zips",
-		"zit",
-		"zodiac",
-		"zombie",
-		"zone",
-		"zoning",
-		"zookeeper",
-		"zoologist",
-		"zoology",
-		"zoom",
-	},
-	"memorable": {
-		"acid",
-		"acorn",
-		"acre",
-		"acts",
-		"afar",
-		"affix",
-		"aged",
-		"agent",
-		"agile",
-		"aging",
-		"agony",
-		"ahead",
-		"aide",
-		"aids",
-		"aim",
-		"ajar",
-		"alarm",
-		"alias",
-		"alibi",
-		"alien",
-		"alike",
-		"alive",
-		"aloe",
-		"aloft",
-		"aloha",
-		"alone",
-		"amend",
-		"amino",
-		"ample",
-		"amuse",
-		"angel",
-		"anger",
-		"angle",
-		"ankle",
-		"apple",
-		"april",
-		"apron",
-		"aqua",
-		"area",
-		"arena",
-		"argue",
-		"arise",
-		"armed",
-		"armor",
-		"army",
-		"aroma",
-		"array",
-		"arson",
-		"art",
-		"ashen",
-		"ashes",
-		"atlas",
-		"atom",
-		"attic",
-		"audio",
-		"avert",
-		"avoid",
-		"awake",
-		"award",
-		"awoke",
-		"axis",
-		"bacon",
-		"badge",
-		"bagel",
-		"baggy",
-		"baked",
-		"baker",
-		"balmy",
-		"banjo",
-		"barge",
-		"barn",
-		"bash",
-		"basil",
-		"bask",
-		"batch",
-		"bath",
-		"baton",
-		"bats",
-		"blade",
-		"blank",
-		"blast",
-		"blaze",
-		"bleak",
-		"blend",
-		"bless",
-		"blimp",
-		"blink",
-		"bloat",
-		"blob",
-		"blog",
-		"blot",
-		"blunt",
-		"blurt",
-		"blush",
-		"boast",
-		"boat",
-		"body",
-		"boil",
-		"bok",
-		"bolt",
-		"boned",
-		"boney",
-		"bonus",
-		"bony",
-		"book",
-		"booth",
-		"boots",
-		"boss",
-		"botch",
-		"both",
-		"boxer",
-		"breed",
-		"bribe",
-		"brick",
-		"bride",
-		"brim",
-		"bring",
-		"brink",
-		"brisk",
-		"broad",
-		"broil",
-		"broke",
-		"brook",
-		"broom",
-		"brush",
-		"buck",
-		"bud",
-		"buggy",
-		"bulge",
-		"bulk",
-		"bully",
-		"bunch",
-		"bunny",
-		"bunt",
-		"bush",
-		"bust",
-		"busy",
-		"buzz",
-		"cable",
-		"cache",
-		"cadet",
-		"cage",
-		"cake",
-		"calm",
-		"cameo",
-		"canal",
-		"candy",
-		"cane",
-		"canon",
-		"cape",
-		"card",
-		"cargo",
-		"carol",
-		"carry",
-		"carve",
-		"case",
-		"cash",
-		"cause",
-		"cedar",
-		"chain",
-		"chair",
-		"chant",
-		"chaos",
-		"charm",
-		"chase",
-		"cheek",
-		"cheer",
-		"chef",
-		"chess",
-		"chest",
-		"chew",
-		"chief",
-		"chili",
-		"chill",
-		"chip",
-		"chomp",
-		"chop",
-		"chow",
-		"chuck",
-		"chump",
-		"chunk",
-		"churn",
-		"chute",
-		"cider",
-		"cinch",
-		"city",
-		"civic",
-		"civil",
-		"clad",
-		"claim",
-		"clamp",
-		"clap",
-		"clash",
-		"clasp",
-		"class",
-		"claw",
-		"clay",
-		"clean",
-		"clear",
-		"cleat",
-		"cleft",
-		"clerk",
-		"click",
-		"cling",
-		"clink",
-		"clip",
-		"cloak",
-		"clock",
-		"clone",
-		"cloth",
-		"cloud",
-		"clump",
-		"coach",
-		"coast",
-		"coat",
-		"cod",
-		"coil",
-		"coke",
-		"cola",
-		"cold",
-		"colt",
-		"coma",
-		"come",
-		"comic",
-		"comma",
-		"cone",
-		"cope",
-		"copy",
-		"coral",
-		"cork",
-		"cost",
-		"cot",
-		"couch",
-		"cough",
-		"cover",
-		"cozy",
-		"craft",
-		"cramp",
-		"crane",
-		"crank",
-		"crate",
-		"crave",
-		"crawl",
-		"crazy",
-		"creme",
-		"crepe",
-		"crept",
-		"crib",
-		"cried",
-		"crisp",
-		"crook",
-		"crop",
-		"cross",
-		"crowd",
-		"crown",
-		"crumb",
-		"crush",
-		"crust",
-		"cub",
-		"cult",
-		"cupid",
-		"cure",
-		"curl",
-		"curry",
-		"curse",
-		"curve",
-		"curvy",
-		"cushy",
-		"cut",
-		"cycle",
-		"dab",
-		"dad",
-		"daily",
-		"dairy",
-		"daisy",
-		"dance",
-		"dandy",
-		"darn",
-		"dart",
-		"dash",
-		"data",
-		"date",
-		"dawn",
-		"deaf",
-		"deal",
-		"dean",
-		"debit",
-		"debt",
-		"debug",
-		"decaf",
-		"decal",
-		"decay",
-		"deck",
-		"decor",
-		"decoy",
-		"deed",
-		"delay",
-		"denim",
-		"dense",
-		"dent",
-		"depth",
-		"derby",
-		"desk",
-		"dial",
-		"diary",
-		"dice",
-		"dig",
-		"dill",
-		"dime",
-		"dimly",
-		"diner",
-		"dingy",
-		"disco",
-		"dish",
-		"disk",
-		"ditch",
-		"ditzy",
-		"dizzy",
-		"dock",
-		"dodge",
-		"doing",
-		"doll",
-		"dome",
-		"donor",
-		"donut",
-		"dose",
-		"dot",
-		"dove",
-		"down",
-		"dowry",
-		"doze",
-		"drab",
-		"drama",
-		"drank",
-		"draw",
-		"dress",
-		"dried",
-		"drift",
-		"drill",
-		"drive",
-		"drone",
-		"droop",
-		"drove",
-		"drown",
-		"drum",
-		"dry",
-		"duck",
-		"duct",
-		"dude",
-		"dug",
-		"duke",
-		"duo",
-		"dusk",
-		"dust",
-		"duty",
-		"dwarf",
-		"dwell",
-		"eagle",
-		"early",
-		"earth",
-		"easel",
-		"east",
-		"eaten",
-		"eats",
-		"ebay",
-		"ebony",
-		"ebook",
-		"echo",
-		"edge",
-		"eel",
-		"eject",
-		"elbow",
-		"elder",
-		"elf",
-		"elk",
-		"elm",
-		"elope",
-		"elude",
-		"elves",
-		"email",
-		"emit",
-		"empty",
-		"emu",
-		"enter",
-		"entry",
-		"envoy",
-		"equal",
-		"erase",
-		"error",
-		"erupt",
-		"essay",
-		"etch",
-		"evade",
-		"even",
-		"evict",
-		"evil",
-		"evoke",
-		"exact",
-		"exit",
-		"fable",
-		"faced",
-		"fact",
-		"fade",
-		"fall",
-		"false",
-		"fancy",
-		"fang",
-		"fax",
-		"feast",
-		"feed",
-		"femur",
-		"fence",
-		"fend",
-		"ferry",
-		"fetal",
-		"fetch",
-		"fever",
-		"fiber",
-		"fifth",
-		"fifty",
-		"film",
-		"filth",
-		"final",
-		"finch",
-		"fit",
-		"five",
-		"flag",
-		"flaky",
-		"flame",
-		"flap",
-		"flask",
-		"fled",
-		"flick",
-		"fling",
-		"flint",
-		"flip",
-		"flirt",
-		"float",
-		"flock",
-		"flop",
-		"floss",
-		"flyer",
-		"foam",
-		"foe",
-		"fog",
-		"foil",
-		"folic",
-		"folk",
-		"food",
-		"fool",
-		"found",
-		"fox",
-		"foyer",
-		"frail",
-		"frame",
-		"fray",
-		"fresh",
-		"fried",
-		"frill",
-		"frisk",
-		"from",
-		"front",
-		"frost",
-		"froth",
-		"frown",
-		"froze",
-		"fruit",
-		"gag",
-		"gains",
-		"gala",
-		"game",
-		"gap",
-		"gas",
-		"gave",
-		"gear",
-		"gecko",
-		"geek",
-		"gem",
-		"genre",
-		"gift",
-		"gig",
-		"gills",
-		"given",
-		"giver",
-		"glad",
-		"glass",
-		"glide",
-		"gloss",
-		"glove",
-		"glow",
-		"glue",
-		"goal",
-		"going",
-		"golf",
-		"gong",
-		"good",
-		"gooey",
-		"goofy",
-		"gore",
-		"gown",
-		"grab",
-		"grain",
-		"grant",
-		"grape",
-		"graph",
-		"grasp",
-		"grass",
-		"grave",
-		"gravy",
-		"gray",
-		"green",
-		"greet",
-		"grew",
-		"grid",
-		"grief",
-		"grill",
-		"grip",
-		"grit",
-		"groom",
-		"grope",
-		"growl",
-		"grub",
-		"grunt",
-		"guide",
-		"gulf",
-		"gulp",
-		"gummy",
-		"guru",
-		"gush",
-		"gut",
-		"guy",
-		"habit",
-		"half",
-		"halo",
-		"halt",
-		"happy",
-		"harm",
-		"hash",
-		"hasty",
-		"hatch",
-		"hate",
-		"haven",
-		"hazel",
-		"hazy",
-		"heap",
-		"heat",
-		"heave",
-		"hedge",
-		"hefty",
-		"help",
-		"herbs",
-		"hers",
-		"hub",
-		"hug",
-		"hula",
-		"hull",
-		"human",
-		"humid",
-		"hump",
-		"hung",
-		"hunk",
-		"hunt",
-		"hurry",
-		"hurt",
-		"hush",
-		"hut",
-		"ice",
-		"icing",
-		"icon",
-		"icy",
-		"igloo",
-		"image",
-		"ion",
-		"iron",
-		"islam",
-		"issue",
-		"item",
-		"ivory",
-		"ivy",
-		"jab",
-		"jam",
-		"jaws",
-		"jazz",
-		"jeep",
-		"jelly",
-		"jet",
-		"jiffy",
-		"job",
-		"jog",
-		"jolly",
-		"jolt",
-		"jot",
-		"joy",
-		"judge",
-		"juice",
-		"juicy",
-		"july",
-		"jumbo",
-		"jump",
-		"junky",
-		"juror",
-		"jury",
-		"keep",
-		"keg",
-		"kept",
-		"kick",
-		"kilt",
-		"king",
-		"kite",
-		"kitty",
-		"kiwi",
-		"knee",
-		"knelt",
-		"koala",
-		"kung",
-		"ladle",
-		"lady",
-		"lair",
-		"lake",
-		"lance",
-		"land",
-		"lapel",
-		"large",
-		"lash",
-		"lasso",
-		"last",
-		"latch",
-		"late",
-		"lazy",
-		"left",
-		"legal",
-		"lemon",
-		"lend",
-		"lens",
-		"lent",
-		"level",
-		"lever",
-		"lid",
-		"life",
-		"lift",
-		"lilac",
-		"lily",
-		"limb",
-		"limes",
-		"line",
-		"lint",
-		"lion",
-		"lip",
-		"list",
-		"lived",
-		"liver",
-		"lunar",
-		"lunch",
-		"lung",
-		"lurch",
-		"lure",
-		"lurk",
-		"lying",
-		"lyric",
-		"mace",
-		"maker",
-		"malt",
-		"mama",
-		"mango",
-		"manor",
-		"many",
-		"map",
-		"march",
-		"mardi",
-		"marry",
-		"mash",
-		"match",
-		"mate",
-		"math",
-		"moan",
-		"mocha",
-		"moist",
-		"mold",
-		"mom",
-		"moody",
-		"mop",
-		"morse",
-		"most",
-		"motor",
-		"motto",
-		"mount",
-		"mouse",
-		"mousy",
-		"mouth",
-		"move",
-		"movie",
-		"mower",
-		"mud",
-		"mug",
-		"mulch",
-		"mule",
-		"mull",
-		"mumbo",
-		"mummy",
-		"mural",
-		"muse",
-		"music",
-		"musky",
-		"mute",
-		"nacho",
-		"nag",
-		"nail",
-		"name",
-		"nanny",
-		"nap",
-		"navy",
-		"near",
-		"neat",
-		"neon",
-		"nerd",
-		"nest",
-		"net",
-		"next",
-		"niece",
-		"ninth",
-		"nutty",
-		"oak",
-		"oasis",
-		"oat",
-		"ocean",
-		"oil",
-		"old",
-		"olive",
-		"omen",
-		"onion",
-		"only",
-		"ooze",
-		"opal",
-		"open",
-		"opera",
-		"opt",
-		"otter",
-		"ouch",
-		"ounce",
-		"outer",
-		"oval",
-		"oven",
-		"owl",
-		"ozone",
-		"pace",
-		"pagan",
-		"pager",
-		"palm",
-		"panda",
-		"panic",
-		"pants",
-		"panty",
-		"paper",
-		"park",
-		"party",
-		"pasta",
-		"patch",
-		"path",
-		"patio",
-		"payer",
-		"pecan",
-		"penny",
-		"pep",
-		"perch",
-		"perky",
-		"perm",
-		"pest",
-		"petal",
-		"petri",
-		"petty",
-		"photo",
-		"plank",
-		"plant",
-		"plaza",
-		"plead",
-		"plot",
-		"plow",
-		"pluck",
-		"plug",
-		"plus",
-		"poach",
-		"pod",
-		"poem",
-		"poet",
-		"pogo",
-		"point",
-		"poise",
-		"poker",
-		"polar",
-		"polio",
-		"polka",
-		"polo",
-		"pond",
-		"pony",
-		"poppy",
-		"pork",
-		"poser",
-		"pouch",
-		"pound",
-		"pout",
-		"power",
-		"prank",
-		"press",
-		"print",
-		"prior",
-		"prism",
-		"prize",
-		"probe",
-		"prong",
-		"proof",
-		"props",
-		"prude",
-		"prune",
-		"pry",
-		"pug",
-		"pull",
-		"pulp",
-		"pulse",
-		"puma",
-		"punch",
-		"punk",
-		"pupil",
-		"puppy",
-		"purr",
-		"purse",
-		"push",
-		"putt",
-		"quack",
-		"quake",
-		"query",
-		"quiet",
-		"quill",
-		"quilt",
-		"quit",
-		"quota",
-		"quote",
-		"rabid",
-		"race",
-		"rack",
-		"radar",
-		"radio",
-		"raft",
-		"rage",
-		"raid",
-		"rail",
-		"rake",
-		"rally",
-		"ramp",
-		"ranch",
-		"range",
-		"rank",
-		"rant",
-		"rash",
-		"raven",
-		"reach",
-		"react",
-		"ream",
-		"rebel",
-		"recap",
-		"relax",
-		"relay",
-		"relic",
-		"remix",
-		"repay",
-		"repel",
-		"reply",
-		"rerun",
-		"reset",
-		"rhyme",
-		"rice",
-		"rich",
-		"ride",
-		"rigid",
-		"rigor",
-		"rinse",
-		"riot",
-		"ripen",
-		"rise",
-		"risk",
-		"ritzy",
-		"rival",
-		"river",
-		"roast",
-		"robe",
-		"robin",
-		"rock",
-		"rogue",
-		"roman",
-		"romp",
-		"rope",
-		"rover",
-		"royal",
-		"ruby",
-		"rug",
-		"ruin",
-		"rule",
-		"runny",
-		"rush",
-		"rust",
-		"rut",
-		"sadly",
-		"sage",
-		"said",
-		"saint",
-		"salad",
-		"salon",
-		"salsa",
-		"salt",
-		"same",
-		"sandy",
-		"santa",
-		"satin",
-		"sauna",
-		"saved",
-		"savor",
-		"sax",
-		"say",
-		"scale",
-		"scam",
-		"scan",
-		"scare",
-		"scarf",
-		"scary",
-		"scoff",
-		"scold",
-		"scoop",
-		"scoot",
-		"scope",
-		"score",
-		"scorn",
-		"scout",
-		"scowl",
-		"scrap",
-		"scrub",
-		"scuba",
-		"scuff",
-		"sect",
-		"sedan",
-		"self",
-		"send",
-		"sepia",
-		"serve",
-		"set",
-		"seven",
-		"shack",
-		"shade",
-		"shady",
-		"shaft",
-		"shaky",
-		"sham",
-		"shape",
-		"share",
-		"sharp",
-		"shed",
-		"sheep",
-		"sheet",
-		"shelf",
-		"shell",
-		"shine",
-		"shiny",
-		"ship",
-		"shirt",
-		"shock",
-		"shop",
-		"shore",
-		"shout",
-		"shove",
-		"shown",
-		"showy",
-		"shred",
-		"shrug",
-		"shun",
-		"shush",
-		"shut",
-		"shy",
-		"sift",
-		"silk",
-		"silly",
-		"silo",
-		"sip",
-		"siren",
-		"sixth",
-		"size",
-		"skate",
-		"skew",
-		"skid",
-		"skier",
-		"skies",
-		"skip",
-		"skirt",
-		"skit",
-		"sky",
-		"slab",
-		"slack",
-		"slain",
-		"slam",
-		"slang",
-		"slash",
-		"slate",
-		"slaw",
-		"sled",
-		"sleek",
-		"sleep",
-		"sleet",
-		"slept",
-		"slice",
-		"slick",
-		"slimy",
-		"sling",
-		"slip",
-		"slit",
-		"slob",
-		"slot",
-		"slug",
-		"slum",
-		"slurp",
-		"slush",
-		"small",
-		"smash",
-		"smell",
-		"smile",
-		"smirk",
-		"smog",
-		"snack",
-		"snap",
-		"snare",
-		"snarl",
-		"sneak",
-		"sneer",
-		"sniff",
-		"snore",
-		"snort",
-		"snout",
-		"snowy",
-		"snub",
-		"snuff",
-		"speak",
-		"speed",
-		"spend",
-		"spent",
-		"spew",
-		"spied",
-		"spill",
-		"spiny",
-		"spoil",
-		"spoke",
-		"spoof",
-		"spool",
-		"spoon",
-		"sport",
-		"spot",
-		"spout",
-		"spray",
-		"spree",
-		"spur",
-		"squad",
-		"squat",
-		"squid",
-		"stack",
-		"staff",
-		"stage",
-		"stain",
-		"stall",
-		"stamp",
-		"stand",
-		"stank",
-		"stark",
-		"start",
-		"stash",
-		"state",
-		"stays",
-		"steam",
-		"steep",
-		"stem",
-		"step",
-		"stew",
-		"stick",
-		"sting",
-		"stir",
-		"stock",
-		"stole",
-		"stomp",
-		"stony",
-		"stood",
-		"stool",
-		"stoop",
-		"stop",
-		"storm",
-		"stout",
-		"stove",
-		"straw",
-		"stray",
-		"strut",
-		"stuck",
-		"stud",
-		"stuff",
-		"stump",
-		"stung",
-		"stunt",
-		"suds",
-		"sugar",
-		"sulk",
-		"surf",
-		"sushi",
-		"swab",
-		"swan",
-		"swarm",
-		"sway",
-		"swear",
-		"sweat",
-		"sweep",
-		"swell",
-		"swept",
-		"swim",
-		"swing",
-		"swipe",
-		"swirl",
-		"swoop",
-		"swore",
-		"syrup",
-		"tacky",
-		"taco",
-		"tag",
-		"take",
-		"tall",
-		"talon",
-		"tamer",
-		"tank",
-		"taper",
-		"taps",
-		"tarot",
-		"tart",
-		"task",
-		"taste",
-		"tasty",
-		"taunt",
-		"thank",
-		"thaw",
-		"theft",
-		"theme",
-		"thigh",
-		"thing",
-		"think",
-		"thong",
-		"thorn",
-		"those",
-		"throb",
-		"thud",
-		"thumb",
-		"thump",
-		"thus",
-		"tiara",
-		"tidal",
-		"tidy",
-		"tiger",
-		"tile",
-		"tilt",
-		"tint",
-		"tiny",
-		"trace",
-		"track",
-		"trade",
-		"train",
-		"trait",
-		"trap",
-		"trash",
-		"tray",
-		"treat",
-		"tree",
-		"trek",
-		"trend",
-		"trial",
-		"tribe",
-		"trick",
-		"trio",
-		"trout",
-		"truce",
-		"truck",
-		"trump",
-		"trunk",
-		"try",
-		"tug",
-		"tulip",
-		"tummy",
-		"turf",
-		"tusk",
-		"tutor",
-		"tutu",
-		"tux",
-		"tweak",
-		"tweet",
-		"twice",
-		"twine",
-		"twins",
-		"twirl",
-		"twist",
-		"uncle",
-		"uncut",
-		"undo",
-		"unify",
-		"union",
-		"unit",
-		"untie",
-		"upon",
-		"upper",
-		"urban",
-		"used",
-		"user",
-		"usher",
-		"utter",
-		"value",
-		"vapor",
-		"vegan",
-		"venue",
-		"verse",
-		"vest",
-		"veto",
-		"vice",
-		"video",
-		"view",
-		"viral",
-		"virus",
-		"visa",
-		"visor",
-		"vixen",
-		"vocal",
-		"voice",
-		"void",
-		"volt",
-		"voter",
-		"vowel",
-		"wad",
-		"wafer",
-		"wager",
-		"wages",
-		"wagon",
-		"wake",
-		"walk",
-		"wand",
-		"wasp",
-		"watch",
-		"water",
-		"wavy",
-		"wheat",
-		"whiff",
-		"whole",
-		"whoop",
-		"wick",
-		"widen",
-		"widow",
-		"width",
-		"wife",
-		"wifi",
-		"wilt",
-		"wimp",
-		"wind",
-		"wing",
-		"wink",
-		"wipe",
-		"wired",
-		"wiry",
-		"wise",
-		"wish",
-		"wispy",
-		"wok",
-		"wolf",
-		"womb",
-		"wool",
-		"woozy",
-		"word",
-		"work",
-		"worry",
-		"wound",
-		"woven",
-		"wrath",
-		"wreck",
-		"wrist",
-		"xerox",
-		"yahoo",
-		"yam",
-		"yard",
-		"year",
-		"yeast",
-		"yelp",
-		"yield",
-		"yo-yo",
-		"yodel",
-		"yoga",
-		"yoyo",
-		"yummy",
-		"zebra",
-		"zero",
-		"zesty",
-		"zippy",
-		"zone",
-		"zoom",
-	},
-}